@@ -0,0 +1,183 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"backend/config"
+	"backend/helper"
+	"backend/service"
+)
+
+// commandUsage 하위 명령 사용법
+const commandUsage = `사용법:
+  miniflix                          API 서버 실행
+  miniflix catalog import [옵션] 파일  카탈로그 가져오기 (CSV, JSON, TMDB 응답 형식)
+  miniflix catalog export [옵션]      카탈로그 내보내기 (CSV, JSON)
+`
+
+// runCommand 서버 대신 하위 명령 실행 후 종료 코드 반환
+func runCommand(cfg *config.Config, args []string, stdout, stderr io.Writer) int {
+	if len(args) >= 2 && args[0] == "catalog" {
+		switch args[1] {
+		case "import":
+			return runCatalogImport(cfg, args[2:], stdout, stderr)
+		case "export":
+			return runCatalogExport(cfg, args[2:], stdout, stderr)
+		}
+	}
+	fmt.Fprint(stderr, commandUsage)
+	return 2
+}
+
+// runCatalogImport 카탈로그 파일을 읽어 외부 ID 기준으로 추가/변경 (-dry-run이면 변경 내역만 출력)
+func runCatalogImport(cfg *config.Config, args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("catalog import", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	format := flags.String("format", "", "파일 형식 (csv, json, tmdb, 기본값: 확장자로 판단)")
+	dryRun := flags.Bool("dry-run", false, "저장하지 않고 변경 내역만 출력")
+	batchSize := flags.Int("batch-size", service.DefaultCatalogBatchSize, "한 트랜잭션에서 저장하는 콘텐츠 수")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() != 1 {
+		fmt.Fprintln(stderr, "가져올 파일을 하나 지정해야 합니다")
+		return 2
+	}
+	path := flags.Arg(0)
+	if *format == "" {
+		*format = catalogFormatFromPath(path)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		fmt.Fprintf(stderr, "파일 열기 실패: %v\n", err)
+		return 1
+	}
+	defer file.Close()
+
+	records, lineErrors, err := service.ParseCatalog(file, *format)
+	if err != nil {
+		fmt.Fprintf(stderr, "카탈로그 읽기 실패: %v\n", err)
+		return 1
+	}
+
+	db, err := helper.GetDB(cfg)
+	if err != nil || db == nil {
+		fmt.Fprintf(stderr, "데이터베이스 연결 실패: %v\n", err)
+		return 1
+	}
+	catalogService := service.NewCatalogService(db.DB, *batchSize)
+
+	plan, validationErrors, err := catalogService.Plan(records)
+	lineErrors = append(lineErrors, validationErrors...)
+	if len(lineErrors) > 0 {
+		sort.Slice(lineErrors, func(i, j int) bool { return lineErrors[i].Line < lineErrors[j].Line })
+		for _, lineError := range lineErrors {
+			fmt.Fprintln(stderr, lineError.Error())
+		}
+		fmt.Fprintf(stderr, "오류 %d건으로 가져오기를 중단합니다\n", len(lineErrors))
+		return 1
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "가져오기 계획 생성 실패: %v\n", err)
+		return 1
+	}
+
+	printCatalogPlan(stdout, plan)
+	if *dryRun {
+		fmt.Fprintln(stdout, "dry-run: 저장하지 않았습니다")
+		return 0
+	}
+
+	applied, err := catalogService.Apply(plan)
+	if err != nil {
+		fmt.Fprintf(stderr, "카탈로그 저장 실패 (%d건 저장 후 중단): %v\n", applied, err)
+		return 1
+	}
+	fmt.Fprintf(stdout, "%d건 저장했습니다\n", applied)
+	return 0
+}
+
+// runCatalogExport 전체 콘텐츠를 카탈로그 파일로 내보내기 (-o가 없으면 표준 출력)
+func runCatalogExport(cfg *config.Config, args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("catalog export", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	format := flags.String("format", "", "파일 형식 (csv, json, 기본값: 출력 파일 확장자, 없으면 csv)")
+	output := flags.String("o", "", "출력 파일 (기본값: 표준 출력)")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if *format == "" {
+		*format = service.CatalogFormatCSV
+		if *output != "" {
+			*format = catalogFormatFromPath(*output)
+		}
+	}
+
+	db, err := helper.GetDB(cfg)
+	if err != nil || db == nil {
+		fmt.Fprintf(stderr, "데이터베이스 연결 실패: %v\n", err)
+		return 1
+	}
+	entries, err := service.NewCatalogService(db.DB, 0).Export()
+	if err != nil {
+		fmt.Fprintf(stderr, "카탈로그 조회 실패: %v\n", err)
+		return 1
+	}
+
+	writer := stdout
+	if *output != "" {
+		file, err := os.Create(*output)
+		if err != nil {
+			fmt.Fprintf(stderr, "파일 생성 실패: %v\n", err)
+			return 1
+		}
+		defer file.Close()
+		writer = file
+	}
+	if err := service.WriteCatalog(writer, *format, entries); err != nil {
+		if errors.Is(err, service.ErrUnknownCatalogFormat) {
+			fmt.Fprintln(stderr, err)
+			return 2
+		}
+		fmt.Fprintf(stderr, "카탈로그 쓰기 실패: %v\n", err)
+		return 1
+	}
+	if *output != "" {
+		fmt.Fprintf(stdout, "%d건을 %s에 내보냈습니다\n", len(entries), *output)
+	}
+	return 0
+}
+
+// printCatalogPlan 가져오기 변경 내역 출력 (+ 추가, ~ 변경, 변경 없는 항목은 합계만)
+func printCatalogPlan(w io.Writer, plan *service.CatalogPlan) {
+	for _, change := range plan.Changes {
+		switch change.Action {
+		case service.CatalogActionCreate:
+			fmt.Fprintf(w, "+ 줄 %d %s %s\n", change.Line, change.ExternalID, change.Title)
+		case service.CatalogActionUpdate:
+			fmt.Fprintf(w, "~ 줄 %d %s %s (%s)\n", change.Line, change.ExternalID, change.Title, strings.Join(change.Fields, ", "))
+		}
+	}
+	for _, genre := range plan.NewGenres {
+		fmt.Fprintf(w, "+ 장르 %s\n", genre)
+	}
+	fmt.Fprintf(w, "추가 %d, 변경 %d, 변경 없음 %d, 새 장르 %d\n",
+		plan.Count(service.CatalogActionCreate), plan.Count(service.CatalogActionUpdate),
+		plan.Count(service.CatalogActionUnchanged), len(plan.NewGenres))
+}
+
+// catalogFormatFromPath 파일 확장자로 카탈로그 형식 판단 (.json이면 json, 그 외 csv)
+func catalogFormatFromPath(path string) string {
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		return service.CatalogFormatJSON
+	}
+	return service.CatalogFormatCSV
+}
@@ -1,30 +1,215 @@
 package config
 
 import (
+	"crypto/rand"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 )
 
 // Config 애플리케이션 설정 구조체
 type Config struct {
-	Environment      string   `json:"environment"`        // 실행 환경 (development, production)
-	Host             string   `json:"host"`               // 서버 호스트
-	Port             string   `json:"port"`               // 서버 포트
-	ServerPort       string   `json:"server_port"`        // 서버 포트 (Port와 동일, 호환성 유지)
-	JWTSecret        string   `json:"jwt_secret"`         // JWT 시크릿 키
-	JWTExpireHours   int      `json:"jwt_expire_hours"`   // JWT 만료 시간(시간)
-	DBHost           string   `json:"db_host"`            // 데이터베이스 호스트
-	DBPort           string   `json:"db_port"`            // 데이터베이스 포트
-	DBUser           string   `json:"db_user"`            // 데이터베이스 사용자
-	DBPassword       string   `json:"db_password"`        // 데이터베이스 비밀번호
-	DBName           string   `json:"db_name"`            // 데이터베이스 이름
-	CorsAllowOrigins []string `json:"cors_allow_origins"` // CORS 허용 출처
-	MediaPath        string   `json:"media_path"`         // 미디어 파일 경로
-	ThumbnailPath    string   `json:"thumbnail_path"`     // 썸네일 이미지 경로
+	Environment      string     `json:"environment"`        // 실행 환경 (development, production)
+	Host             string     `json:"host"`               // 서버 호스트
+	Port             string     `json:"port"`               // 서버 포트
+	ServerPort       string     `json:"server_port"`        // 서버 포트 (Port와 동일, 호환성 유지)
+	JWTSecret        string     `json:"jwt_secret"`         // JWT 시크릿 키
+	JWTExpireHours   int        `json:"jwt_expire_hours"`   // JWT 만료 시간(시간, access_token_expire_minutes 미설정 시 사용)
+	DBHost           string     `json:"db_host"`            // 데이터베이스 호스트
+	DBPort           string     `json:"db_port"`            // 데이터베이스 포트
+	DBUser           string     `json:"db_user"`            // 데이터베이스 사용자
+	DBPassword       string     `json:"db_password"`        // 데이터베이스 비밀번호
+	DBName           string     `json:"db_name"`            // 데이터베이스 이름
+	CorsAllowOrigins []string   `json:"cors_allow_origins"` // CORS 허용 출처
+	MediaPath        string     `json:"media_path"`         // 미디어 파일 경로
+	ThumbnailPath    string     `json:"thumbnail_path"`     // 썸네일 이미지 경로
+	Home             HomeConfig `json:"home"`               // 홈 화면 구성 설정
+	Mail             MailConfig `json:"mail"`               // 메일 발송 설정
+
+	OIDCProviders   []OIDCProviderConfig  `json:"oidc_providers"`   // 소셜 로그인(OpenID Connect) 제공자 목록
+	LoginProtection LoginProtectionConfig `json:"login_protection"` // 로그인 무차별 대입 방지 설정
+	PasswordPolicy  PasswordPolicyConfig  `json:"password_policy"`  // 비밀번호 정책
+	Billing         BillingConfig         `json:"billing"`          // 결제 설정
+	Geo             GeoConfig             `json:"geo"`              // 접속 국가 확인 설정
+	Review          ReviewConfig          `json:"review"`           // 리뷰 필터/신고 설정
+
+	RecommendationRefreshMinutes int `json:"recommendation_refresh_minutes"` // 추천 유사도 재계산 주기(분)
+	MaxProfilesPerUser           int `json:"max_profiles_per_user"`          // 계정당 최대 프로필 수
+	MaxListsPerProfile           int `json:"max_lists_per_profile"`          // 프로필당 최대 목록 수 (기본 찜 목록 제외)
+	AccessTokenExpireMinutes     int `json:"access_token_expire_minutes"`    // 액세스 토큰 만료 시간(분)
+	RefreshTokenExpireDays       int `json:"refresh_token_expire_days"`      // 리프레시 토큰 만료 기간(일)
+
+	PasswordResetExpireMinutes     int  `json:"password_reset_expire_minutes"`     // 비밀번호 재설정 토큰 만료 시간(분)
+	EmailVerificationExpireHours   int  `json:"email_verification_expire_hours"`   // 이메일 인증 토큰 만료 시간(시간)
+	RequireEmailVerificationStream bool `json:"require_email_verification_stream"` // 이메일 미인증 계정의 스트리밍 제한 여부
+
+	DataExportDir            string `json:"data_export_dir"`             // 개인정보 내보내기 ZIP 파일 저장 경로
+	DataExportExpireHours    int    `json:"data_export_expire_hours"`    // 내보내기 파일 보관 시간(시간)
+	AccountDeletionGraceDays int    `json:"account_deletion_grace_days"` // 탈퇴 요청 후 실제 삭제까지 유예 기간(일)
+}
+
+// 메일 발송 방식
+const (
+	MailDriverSMTP = "smtp" // SMTP 서버로 발송
+	MailDriverFile = "file" // 파일에 기록 (개발/테스트용)
+	MailDriverLog  = "log"  // 로그로 출력 (개발용)
+)
+
+// MailConfig 메일 발송 설정
+type MailConfig struct {
+	Driver       string `json:"driver"`        // 발송 방식 (smtp, file, log)
+	From         string `json:"from"`          // 발신 주소
+	SMTPHost     string `json:"smtp_host"`     // SMTP 서버 호스트
+	SMTPPort     string `json:"smtp_port"`     // SMTP 서버 포트
+	SMTPUser     string `json:"smtp_user"`     // SMTP 인증 사용자
+	SMTPPassword string `json:"smtp_password"` // SMTP 인증 비밀번호
+	FilePath     string `json:"file_path"`     // file 방식의 기록 파일 경로
+	LinkBaseURL  string `json:"link_base_url"` // 메일 본문 링크의 프론트엔드 기본 URL
+}
+
+// OIDCProviderConfig OpenID Connect 제공자 설정
+// Issuer의 /.well-known/openid-configuration 디스커버리 문서로 엔드포인트를 찾으므로
+// 테스트에서는 로컬 모의 제공자의 주소를 Issuer로 지정하면 됨
+type OIDCProviderConfig struct {
+	Name         string   `json:"name"`          // 제공자 식별자 (URL 경로에 사용, 예: google, kakao)
+	DisplayName  string   `json:"display_name"`  // 로그인 버튼 표시 이름
+	Issuer       string   `json:"issuer"`        // 발급자 URL (예: https://accounts.google.com)
+	ClientID     string   `json:"client_id"`     // 클라이언트 ID
+	ClientSecret string   `json:"client_secret"` // 클라이언트 시크릿
+	RedirectURL  string   `json:"redirect_url"`  // 인증 후 돌아올 프론트엔드 콜백 URL
+	Scopes       []string `json:"scopes"`        // 요청 범위 (비어 있으면 openid email profile)
+}
+
+// 로그인 실패 횟수 저장소
+const (
+	AttemptStoreMemory = "memory" // 서버 메모리 (단일 인스턴스용)
+	AttemptStoreRedis  = "redis"  // Redis 호환 서버 (여러 인스턴스가 공유)
+)
+
+// LoginProtectionConfig 로그인 무차별 대입 방지 설정
+// 계정/IP별 연속 실패가 허용 횟수를 넘으면 실패할 때마다 대기 시간이 두 배로 늘어나고,
+// 계정 실패가 잠금 기준에 도달하면 계정을 일정 시간 잠금
+type LoginProtectionConfig struct {
+	Store                string `json:"store"`                  // 실패 횟수 저장소 (memory, redis)
+	RedisAddr            string `json:"redis_addr"`             // Redis 주소 (host:port)
+	RedisPassword        string `json:"redis_password"`         // Redis 비밀번호
+	RedisDB              int    `json:"redis_db"`               // Redis DB 번호
+	FreeAttempts         int    `json:"free_attempts"`          // 계정별 대기 없이 허용하는 연속 실패 횟수
+	IPFreeAttempts       int    `json:"ip_free_attempts"`       // IP별 대기 없이 허용하는 연속 실패 횟수
+	BackoffBaseSeconds   int    `json:"backoff_base_seconds"`   // 첫 대기 시간(초)
+	BackoffMaxSeconds    int    `json:"backoff_max_seconds"`    // 최대 대기 시간(초)
+	AccountLockThreshold int    `json:"account_lock_threshold"` // 계정 잠금 기준 연속 실패 횟수
+	AccountLockMinutes   int    `json:"account_lock_minutes"`   // 계정 잠금 시간(분)
+	FailureWindowMinutes int    `json:"failure_window_minutes"` // 마지막 실패 후 실패 횟수를 유지하는 시간(분)
+
+	ParentalPINMaxAttempts        int `json:"parental_pin_max_attempts"`         // 보호자 PIN 연속 실패 허용 횟수 (도달 시 잠금 시간 동안 PIN 확인 거부)
+	TwoFactorMaxAttempts          int `json:"two_factor_max_attempts"`           // 계정별 2단계 인증 코드 연속 실패 허용 횟수 (도달 시 잠금 시간 동안 코드 확인 거부)
+	TwoFactorChallengeMaxAttempts int `json:"two_factor_challenge_max_attempts"` // 대기 토큰 하나로 시도할 수 있는 코드 확인 횟수 (초과 시 다시 로그인 필요)
+}
+
+// PasswordPolicyConfig 비밀번호 정책 (회원가입, 비밀번호 변경/재설정에 적용)
+type PasswordPolicyConfig struct {
+	MinLength            int    `json:"min_length"`             // 최소 글자 수
+	MaxLength            int    `json:"max_length"`             // 최대 바이트 수 (bcrypt는 72바이트까지만 사용)
+	MinCharClasses       int    `json:"min_char_classes"`       // 포함해야 하는 문자 종류 수 (소문자, 대문자, 숫자, 특수문자 중)
+	AllowPersonalInfo    bool   `json:"allow_personal_info"`    // 이메일 아이디/이름이 포함된 비밀번호 허용 여부
+	BreachedPasswordFile string `json:"breached_password_file"` // 유출 비밀번호 SHA-1 해시 목록 파일 (없으면 검사 생략)
+}
+
+// 결제 제공자
+const (
+	BillingProviderFake = "fake" // 로컬 모의 결제 (개발/테스트용)
+)
+
+// BillingConfig 결제 설정
+// 결제 제공자가 웹훅 본문을 WebhookSecret으로 서명하며, 서명 시각이 허용 범위를 벗어난 웹훅은 거부
+type BillingConfig struct {
+	Provider                string `json:"provider"`                  // 결제 제공자 (fake)
+	WebhookSecret           string `json:"webhook_secret"`            // 웹훅 서명 검증 키
+	WebhookToleranceSeconds int    `json:"webhook_tolerance_seconds"` // 웹훅 서명 시각 허용 오차(초)
+	Currency                string `json:"currency"`                  // 결제 통화 (ISO 4217)
+	SuccessURL              string `json:"success_url"`               // 결제 완료 후 돌아올 프론트엔드 URL
+	CancelURL               string `json:"cancel_url"`                // 결제 취소 시 돌아올 프론트엔드 URL
+	AllowSimulation         bool   `json:"allow_simulation"`          // 모의 결제 결과 처리 API 등록 여부 (기본값 false, 로컬 개발/테스트에서만 사용)
+}
+
+// GeoConfig 접속 국가 확인 설정 (국가별 콘텐츠 이용 가능 기간에 사용)
+// 클라이언트 IP는 요청을 보낸 주소가 TrustedProxies에 포함된 경우에만 ClientIPHeader에서 읽음
+type GeoConfig struct {
+	DatabaseFile   string   `json:"database_file"`    // IP 대역별 국가 코드 CSV 파일 (시작IP,끝IP,국가 또는 CIDR,국가)
+	DefaultCountry string   `json:"default_country"`  // 국가를 확인할 수 없을 때 사용할 국가 코드 (빈 값이면 지역 제한 없는 콘텐츠만 조회)
+	TrustedProxies []string `json:"trusted_proxies"`  // 신뢰하는 프록시 IP 또는 CIDR 목록
+	ClientIPHeader string   `json:"client_ip_header"` // 프록시가 전달하는 클라이언트 IP 헤더
+	CountryHeader  string   `json:"country_header"`   // 프록시가 확인한 국가 코드 헤더 (예: CF-IPCountry, 설정 시 IP 조회보다 우선)
+}
+
+// ReviewConfig 리뷰 필터/신고 설정
+// 금칙어가 포함된 리뷰는 거부하고, 스팸 의심 리뷰와 신고가 누적된 리뷰는 관리자 검토 전까지 숨김
+type ReviewConfig struct {
+	BannedWordsFile     string   `json:"banned_words_file"`     // 금칙어 목록 파일 (한 줄에 하나, #은 주석, !로 시작하면 예외 단어)
+	BannedWords         []string `json:"banned_words"`          // 추가 금칙어 (파일 목록과 함께 사용)
+	SpamWords           []string `json:"spam_words"`            // 스팸 의심 단어 (포함되면 검토 대기)
+	MaxLinks            int      `json:"max_links"`             // 리뷰 하나에 허용하는 링크 수 (초과하면 검토 대기)
+	ReportHideThreshold int      `json:"report_hide_threshold"` // 처리되지 않은 신고가 이 수 이상이면 검토 전까지 숨김
+}
+
+// OIDCProvider 이름으로 사용 가능한(클라이언트 ID가 설정된) OIDC 제공자 설정 조회
+func (c *Config) OIDCProvider(name string) (*OIDCProviderConfig, bool) {
+	for i := range c.OIDCProviders {
+		provider := &c.OIDCProviders[i]
+		if provider.Name == name && provider.Issuer != "" && provider.ClientID != "" {
+			return provider, true
+		}
+	}
+	return nil, false
+}
+
+// IsDevelopment 개발 또는 테스트 환경 여부
+func (c *Config) IsDevelopment() bool {
+	return c.Environment == "development" || c.Environment == "test"
+}
+
+// AccessTokenTTL 액세스 토큰 유효 기간
+func (c *Config) AccessTokenTTL() time.Duration {
+	if c.AccessTokenExpireMinutes > 0 {
+		return time.Duration(c.AccessTokenExpireMinutes) * time.Minute
+	}
+	return time.Duration(c.JWTExpireHours) * time.Hour
+}
+
+// RefreshTokenTTL 리프레시 토큰 유효 기간
+func (c *Config) RefreshTokenTTL() time.Duration {
+	return time.Duration(c.RefreshTokenExpireDays) * 24 * time.Hour
+}
+
+// 홈 화면 행 유형
+const (
+	HomeRowContinueWatching  = "continue_watching"   // 이어보기
+	HomeRowMyList            = "my_list"             // 내가 찜한 콘텐츠
+	HomeRowTrending          = "trending"            // 지금 뜨는 콘텐츠
+	HomeRowNewReleases       = "new_releases"        // 새로 올라온 콘텐츠
+	HomeRowTopGenres         = "top_genres"          // 인기 장르별 행 (Count개 생성)
+	HomeRowBecauseYouWatched = "because_you_watched" // 최근 시청 콘텐츠 기반 추천 (Count개 생성)
+	HomeRowCollections       = "collections"         // 노출 중인 큐레이션 컬렉션별 행 (Count개 생성)
+)
+
+// HomeRowConfig 홈 화면 행 정의
+type HomeRowConfig struct {
+	Type  string `json:"type"`  // 행 유형
+	Title string `json:"title"` // 행 제목 (장르/기반 콘텐츠/컬렉션 행은 %s에 이름이 들어감)
+	Limit int    `json:"limit"` // 행당 최대 콘텐츠 수
+	Count int    `json:"count"` // 여러 행을 생성하는 유형의 행 수
+}
+
+// HomeConfig 홈 화면 구성 설정 (비로그인/로그인 사용자별 행 순서)
+type HomeConfig struct {
+	AnonymousRows     []HomeRowConfig `json:"anonymous_rows"`     // 비로그인 사용자 행 구성
+	AuthenticatedRows []HomeRowConfig `json:"authenticated_rows"` // 로그인 사용자 행 구성
 }
 
 // LoadConfig 환경에 따른 설정 파일 로드
@@ -40,6 +225,7 @@ func LoadConfig() *Config {
 		overrideConfigFromEnv(&config)
 		config.Environment = env
 		config.ServerPort = config.Port
+		applyDevelopmentWebhookSecret(&config)
 		return &config
 	}
 
@@ -77,15 +263,34 @@ func LoadConfig() *Config {
 	// 환경 변수로 설정 덮어쓰기
 	overrideConfigFromEnv(&config)
 
+	// 설정 파일에 없는 항목은 기본값 사용
+	applyConfigDefaults(&config)
+
 	// 환경 설정
 	config.Environment = env
 
 	// ServerPort 설정 (Port와 동일하게 유지)
 	config.ServerPort = config.Port
 
+	// 개발/테스트 환경에서 웹훅 서명 키가 없으면 임시 키 사용
+	applyDevelopmentWebhookSecret(&config)
+
 	return &config
 }
 
+// applyDevelopmentWebhookSecret 개발/테스트 환경에서 웹훅 서명 키가 없으면 실행마다 새 임의 키 사용
+// 공개된 고정 키로 누구나 결제 완료 웹훅을 위조할 수 없도록 하며, 그 외 환경에서는 키를 반드시 설정해야 함
+func applyDevelopmentWebhookSecret(config *Config) {
+	if config.Billing.WebhookSecret != "" || !config.IsDevelopment() {
+		return
+	}
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		panic(fmt.Sprintf("웹훅 서명 키 생성 실패: %v", err))
+	}
+	config.Billing.WebhookSecret = hex.EncodeToString(secret)
+}
+
 // getDefaultConfig 기본 설정값 반환
 func getDefaultConfig() Config {
 	return Config{
@@ -103,6 +308,254 @@ func getDefaultConfig() Config {
 		CorsAllowOrigins: []string{"*"},
 		MediaPath:        "./assets/media",
 		ThumbnailPath:    "./assets/thumbnails",
+		Home:             getDefaultHomeConfig(),
+
+		RecommendationRefreshMinutes: 60,
+		MaxProfilesPerUser:           5,
+		MaxListsPerProfile:           20,
+		AccessTokenExpireMinutes:     15,
+		RefreshTokenExpireDays:       14,
+		Mail:                         getDefaultMailConfig(),
+
+		PasswordResetExpireMinutes:   60,
+		EmailVerificationExpireHours: 48,
+		LoginProtection:              getDefaultLoginProtectionConfig(),
+		PasswordPolicy:               getDefaultPasswordPolicyConfig(),
+		Billing:                      getDefaultBillingConfig(),
+		Geo:                          getDefaultGeoConfig(),
+		Review:                       getDefaultReviewConfig(),
+		DataExportDir:                "./data/exports",
+		DataExportExpireHours:        72,
+		AccountDeletionGraceDays:     30,
+	}
+}
+
+// getDefaultPasswordPolicyConfig 기본 비밀번호 정책 반환
+func getDefaultPasswordPolicyConfig() PasswordPolicyConfig {
+	return PasswordPolicyConfig{
+		MinLength:            8,
+		MaxLength:            72,
+		MinCharClasses:       2,
+		BreachedPasswordFile: "./config/breached_passwords.txt",
+	}
+}
+
+// getDefaultBillingConfig 기본 결제 설정 반환 (개발 환경은 모의 결제, 웹훅 서명 키는 기본값 없음)
+func getDefaultBillingConfig() BillingConfig {
+	return BillingConfig{
+		Provider:                BillingProviderFake,
+		WebhookToleranceSeconds: 300,
+		Currency:                "KRW",
+		SuccessURL:              "http://localhost:3000/billing/success",
+		CancelURL:               "http://localhost:3000/billing/cancel",
+	}
+}
+
+// getDefaultGeoConfig 기본 접속 국가 확인 설정 반환 (프록시 헤더는 신뢰하지 않음)
+func getDefaultGeoConfig() GeoConfig {
+	return GeoConfig{
+		DatabaseFile:   "./config/geoip_country.csv",
+		DefaultCountry: "KR",
+		ClientIPHeader: "X-Forwarded-For",
+	}
+}
+
+// getDefaultReviewConfig 기본 리뷰 필터/신고 설정 반환
+func getDefaultReviewConfig() ReviewConfig {
+	return ReviewConfig{
+		BannedWordsFile:     "./config/review_banned_words.txt",
+		SpamWords:           []string{"무료쿠폰", "텔레그램", "카톡친추", "오픈채팅", "bit.ly", "free coupon"},
+		MaxLinks:            0,
+		ReportHideThreshold: 3,
+	}
+}
+
+// getDefaultLoginProtectionConfig 기본 로그인 무차별 대입 방지 설정 반환
+func getDefaultLoginProtectionConfig() LoginProtectionConfig {
+	return LoginProtectionConfig{
+		Store:                AttemptStoreMemory,
+		FreeAttempts:         3,
+		IPFreeAttempts:       20,
+		BackoffBaseSeconds:   1,
+		BackoffMaxSeconds:    300,
+		AccountLockThreshold: 10,
+		AccountLockMinutes:   15,
+		FailureWindowMinutes: 15,
+
+		ParentalPINMaxAttempts:        5,
+		TwoFactorMaxAttempts:          5,
+		TwoFactorChallengeMaxAttempts: 3,
+	}
+}
+
+// getDefaultMailConfig 기본 메일 발송 설정 반환 (개발 환경은 로그로 출력)
+func getDefaultMailConfig() MailConfig {
+	return MailConfig{
+		Driver:      MailDriverLog,
+		From:        "MiniFlix <no-reply@miniflix.io>",
+		SMTPPort:    "587",
+		LinkBaseURL: "http://localhost:3000",
+	}
+}
+
+// getDefaultHomeConfig 기본 홈 화면 구성 반환
+func getDefaultHomeConfig() HomeConfig {
+	return HomeConfig{
+		AnonymousRows: []HomeRowConfig{
+			{Type: HomeRowTrending, Title: "지금 뜨는 콘텐츠", Limit: 20},
+			{Type: HomeRowCollections, Title: "%s", Limit: 20, Count: 2},
+			{Type: HomeRowNewReleases, Title: "새로 올라온 콘텐츠", Limit: 20},
+			{Type: HomeRowTopGenres, Title: "%s", Limit: 20, Count: 3},
+		},
+		AuthenticatedRows: []HomeRowConfig{
+			{Type: HomeRowContinueWatching, Title: "시청 중인 콘텐츠", Limit: 20},
+			{Type: HomeRowMyList, Title: "내가 찜한 콘텐츠", Limit: 20},
+			{Type: HomeRowTrending, Title: "지금 뜨는 콘텐츠", Limit: 20},
+			{Type: HomeRowCollections, Title: "%s", Limit: 20, Count: 2},
+			{Type: HomeRowBecauseYouWatched, Title: "%s 시청 후 추천", Limit: 20, Count: 1},
+			{Type: HomeRowNewReleases, Title: "새로 올라온 콘텐츠", Limit: 20},
+			{Type: HomeRowTopGenres, Title: "%s", Limit: 20, Count: 3},
+		},
+	}
+}
+
+// applyConfigDefaults 설정 파일에서 누락된 항목에 기본값 적용
+func applyConfigDefaults(config *Config) {
+	defaultHome := getDefaultHomeConfig()
+	if len(config.Home.AnonymousRows) == 0 {
+		config.Home.AnonymousRows = defaultHome.AnonymousRows
+	}
+	if len(config.Home.AuthenticatedRows) == 0 {
+		config.Home.AuthenticatedRows = defaultHome.AuthenticatedRows
+	}
+	if config.RecommendationRefreshMinutes <= 0 {
+		config.RecommendationRefreshMinutes = 60
+	}
+	if config.MaxProfilesPerUser <= 0 {
+		config.MaxProfilesPerUser = 5
+	}
+	if config.MaxListsPerProfile <= 0 {
+		config.MaxListsPerProfile = 20
+	}
+	if config.AccessTokenExpireMinutes <= 0 {
+		config.AccessTokenExpireMinutes = 15
+	}
+	if config.RefreshTokenExpireDays <= 0 {
+		config.RefreshTokenExpireDays = 14
+	}
+	if config.PasswordResetExpireMinutes <= 0 {
+		config.PasswordResetExpireMinutes = 60
+	}
+	if config.EmailVerificationExpireHours <= 0 {
+		config.EmailVerificationExpireHours = 48
+	}
+	if config.DataExportDir == "" {
+		config.DataExportDir = "./data/exports"
+	}
+	if config.DataExportExpireHours <= 0 {
+		config.DataExportExpireHours = 72
+	}
+	if config.AccountDeletionGraceDays <= 0 {
+		config.AccountDeletionGraceDays = 30
+	}
+	defaultLogin := getDefaultLoginProtectionConfig()
+	if config.LoginProtection.Store == "" {
+		config.LoginProtection.Store = defaultLogin.Store
+	}
+	if config.LoginProtection.FreeAttempts <= 0 {
+		config.LoginProtection.FreeAttempts = defaultLogin.FreeAttempts
+	}
+	if config.LoginProtection.IPFreeAttempts <= 0 {
+		config.LoginProtection.IPFreeAttempts = defaultLogin.IPFreeAttempts
+	}
+	if config.LoginProtection.BackoffBaseSeconds <= 0 {
+		config.LoginProtection.BackoffBaseSeconds = defaultLogin.BackoffBaseSeconds
+	}
+	if config.LoginProtection.BackoffMaxSeconds <= 0 {
+		config.LoginProtection.BackoffMaxSeconds = defaultLogin.BackoffMaxSeconds
+	}
+	if config.LoginProtection.AccountLockThreshold <= 0 {
+		config.LoginProtection.AccountLockThreshold = defaultLogin.AccountLockThreshold
+	}
+	if config.LoginProtection.AccountLockMinutes <= 0 {
+		config.LoginProtection.AccountLockMinutes = defaultLogin.AccountLockMinutes
+	}
+	if config.LoginProtection.FailureWindowMinutes <= 0 {
+		config.LoginProtection.FailureWindowMinutes = defaultLogin.FailureWindowMinutes
+	}
+	if config.LoginProtection.ParentalPINMaxAttempts <= 0 {
+		config.LoginProtection.ParentalPINMaxAttempts = defaultLogin.ParentalPINMaxAttempts
+	}
+	if config.LoginProtection.TwoFactorMaxAttempts <= 0 {
+		config.LoginProtection.TwoFactorMaxAttempts = defaultLogin.TwoFactorMaxAttempts
+	}
+	if config.LoginProtection.TwoFactorChallengeMaxAttempts <= 0 {
+		config.LoginProtection.TwoFactorChallengeMaxAttempts = defaultLogin.TwoFactorChallengeMaxAttempts
+	}
+	defaultPolicy := getDefaultPasswordPolicyConfig()
+	if config.PasswordPolicy.MinLength <= 0 {
+		config.PasswordPolicy.MinLength = defaultPolicy.MinLength
+	}
+	if config.PasswordPolicy.MaxLength <= 0 {
+		config.PasswordPolicy.MaxLength = defaultPolicy.MaxLength
+	}
+	if config.PasswordPolicy.MinCharClasses <= 0 {
+		config.PasswordPolicy.MinCharClasses = defaultPolicy.MinCharClasses
+	}
+	if config.PasswordPolicy.BreachedPasswordFile == "" {
+		config.PasswordPolicy.BreachedPasswordFile = defaultPolicy.BreachedPasswordFile
+	}
+	defaultBilling := getDefaultBillingConfig()
+	if config.Billing.Provider == "" {
+		config.Billing.Provider = defaultBilling.Provider
+	}
+	if config.Billing.WebhookToleranceSeconds <= 0 {
+		config.Billing.WebhookToleranceSeconds = defaultBilling.WebhookToleranceSeconds
+	}
+	if config.Billing.Currency == "" {
+		config.Billing.Currency = defaultBilling.Currency
+	}
+	if config.Billing.SuccessURL == "" {
+		config.Billing.SuccessURL = defaultBilling.SuccessURL
+	}
+	if config.Billing.CancelURL == "" {
+		config.Billing.CancelURL = defaultBilling.CancelURL
+	}
+	defaultGeo := getDefaultGeoConfig()
+	if config.Geo.DatabaseFile == "" {
+		config.Geo.DatabaseFile = defaultGeo.DatabaseFile
+	}
+	if config.Geo.DefaultCountry == "" {
+		config.Geo.DefaultCountry = defaultGeo.DefaultCountry
+	}
+	if config.Geo.ClientIPHeader == "" {
+		config.Geo.ClientIPHeader = defaultGeo.ClientIPHeader
+	}
+	defaultReview := getDefaultReviewConfig()
+	if config.Review.BannedWordsFile == "" {
+		config.Review.BannedWordsFile = defaultReview.BannedWordsFile
+	}
+	if config.Review.SpamWords == nil {
+		config.Review.SpamWords = defaultReview.SpamWords
+	}
+	if config.Review.MaxLinks < 0 {
+		config.Review.MaxLinks = defaultReview.MaxLinks
+	}
+	if config.Review.ReportHideThreshold <= 0 {
+		config.Review.ReportHideThreshold = defaultReview.ReportHideThreshold
+	}
+	defaultMail := getDefaultMailConfig()
+	if config.Mail.Driver == "" {
+		config.Mail.Driver = defaultMail.Driver
+	}
+	if config.Mail.From == "" {
+		config.Mail.From = defaultMail.From
+	}
+	if config.Mail.SMTPPort == "" {
+		config.Mail.SMTPPort = defaultMail.SMTPPort
+	}
+	if config.Mail.LinkBaseURL == "" {
+		config.Mail.LinkBaseURL = defaultMail.LinkBaseURL
 	}
 }
 
@@ -145,4 +598,59 @@ func overrideConfigFromEnv(config *Config) {
 	if thumbnailPath := os.Getenv("THUMBNAIL_PATH"); thumbnailPath != "" {
 		config.ThumbnailPath = thumbnailPath
 	}
+	if mailDriver := os.Getenv("MAIL_DRIVER"); mailDriver != "" {
+		config.Mail.Driver = mailDriver
+	}
+	if smtpHost := os.Getenv("SMTP_HOST"); smtpHost != "" {
+		config.Mail.SMTPHost = smtpHost
+	}
+	if smtpPort := os.Getenv("SMTP_PORT"); smtpPort != "" {
+		config.Mail.SMTPPort = smtpPort
+	}
+	if smtpUser := os.Getenv("SMTP_USER"); smtpUser != "" {
+		config.Mail.SMTPUser = smtpUser
+	}
+	if smtpPassword := os.Getenv("SMTP_PASSWORD"); smtpPassword != "" {
+		config.Mail.SMTPPassword = smtpPassword
+	}
+	if loginStore := os.Getenv("LOGIN_ATTEMPT_STORE"); loginStore != "" {
+		config.LoginProtection.Store = loginStore
+	}
+	if redisAddr := os.Getenv("REDIS_ADDR"); redisAddr != "" {
+		config.LoginProtection.RedisAddr = redisAddr
+	}
+	if redisPassword := os.Getenv("REDIS_PASSWORD"); redisPassword != "" {
+		config.LoginProtection.RedisPassword = redisPassword
+	}
+	if billingProvider := os.Getenv("BILLING_PROVIDER"); billingProvider != "" {
+		config.Billing.Provider = billingProvider
+	}
+	if webhookSecret := os.Getenv("BILLING_WEBHOOK_SECRET"); webhookSecret != "" {
+		config.Billing.WebhookSecret = webhookSecret
+	}
+	if allowSimulation := os.Getenv("BILLING_ALLOW_SIMULATION"); allowSimulation != "" {
+		config.Billing.AllowSimulation = allowSimulation == "true"
+	}
+	if geoDatabase := os.Getenv("GEOIP_DATABASE_FILE"); geoDatabase != "" {
+		config.Geo.DatabaseFile = geoDatabase
+	}
+	if trustedProxies := os.Getenv("TRUSTED_PROXIES"); trustedProxies != "" {
+		config.Geo.TrustedProxies = strings.Split(trustedProxies, ",")
+	}
+	if bannedWordsFile := os.Getenv("REVIEW_BANNED_WORDS_FILE"); bannedWordsFile != "" {
+		config.Review.BannedWordsFile = bannedWordsFile
+	}
+	if bannedWords := os.Getenv("REVIEW_BANNED_WORDS"); bannedWords != "" {
+		config.Review.BannedWords = append(config.Review.BannedWords, strings.Split(bannedWords, ",")...)
+	}
+	// OIDC 제공자 클라이언트 정보 (예: OIDC_GOOGLE_CLIENT_SECRET)
+	for i := range config.OIDCProviders {
+		prefix := "OIDC_" + strings.ToUpper(config.OIDCProviders[i].Name) + "_"
+		if clientID := os.Getenv(prefix + "CLIENT_ID"); clientID != "" {
+			config.OIDCProviders[i].ClientID = clientID
+		}
+		if clientSecret := os.Getenv(prefix + "CLIENT_SECRET"); clientSecret != "" {
+			config.OIDCProviders[i].ClientSecret = clientSecret
+		}
+	}
 }
@@ -0,0 +1,107 @@
+-- 001 시청자 프로필 도입 마이그레이션
+-- 찜 목록과 시청 기록의 소유자를 계정(user_id)에서 프로필(profile_id)로 변경
+-- 계정마다 기본 프로필을 하나 만들고 기존 찜 목록과 시청 기록을 기본 프로필로 옮김
+-- 적용: mysql -u <user> -p miniflix < backend/db/migrations/001_viewer_profiles.sql
+
+-- 프로필 테이블
+CREATE TABLE IF NOT EXISTS Profiles (
+    id BIGINT PRIMARY KEY AUTO_INCREMENT,
+    user_id BIGINT NOT NULL,
+    name VARCHAR(50) NOT NULL,
+    avatar_url VARCHAR(255) NOT NULL DEFAULT '',
+    language VARCHAR(10) NOT NULL DEFAULT 'ko',
+    maturity_level INT NOT NULL DEFAULT 19 COMMENT '시청 가능 연령 등급 (0: 전체, 12, 15, 19)',
+    is_default BOOLEAN NOT NULL DEFAULT FALSE COMMENT '계정 기본 프로필 여부 (삭제 불가)',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES Users(id) ON DELETE CASCADE,
+    INDEX idx_profiles_user (user_id)
+) ENGINE=InnoDB;
+
+-- 계정마다 계정 이름으로 기본 프로필 생성
+INSERT INTO Profiles (user_id, name, is_default, created_at, updated_at)
+SELECT u.id, u.name, TRUE, u.created_at, u.created_at
+FROM Users u
+WHERE NOT EXISTS (SELECT 1 FROM Profiles p WHERE p.user_id = u.id AND p.is_default = TRUE)
+ORDER BY u.id;
+
+-- user_id 컬럼을 참조하는 외래 키와 인덱스 삭제 (자동 생성된 이름이 환경마다 다를 수 있어 조회 후 삭제)
+DROP PROCEDURE IF EXISTS miniflix_drop_user_id;
+DELIMITER //
+CREATE PROCEDURE miniflix_drop_user_id(IN target_table VARCHAR(64))
+BEGIN
+    DECLARE done BOOLEAN DEFAULT FALSE;
+    DECLARE object_name VARCHAR(64);
+    DECLARE foreign_keys CURSOR FOR
+        SELECT constraint_name FROM information_schema.key_column_usage
+        WHERE table_schema = DATABASE() AND table_name = target_table
+            AND column_name = 'user_id' AND referenced_table_name IS NOT NULL;
+    DECLARE indexes CURSOR FOR
+        SELECT DISTINCT index_name FROM information_schema.statistics
+        WHERE table_schema = DATABASE() AND table_name = target_table
+            AND column_name = 'user_id';
+    DECLARE CONTINUE HANDLER FOR NOT FOUND SET done = TRUE;
+
+    OPEN foreign_keys;
+    drop_foreign_keys: LOOP
+        FETCH foreign_keys INTO object_name;
+        IF done THEN
+            LEAVE drop_foreign_keys;
+        END IF;
+        SET @statement = CONCAT('ALTER TABLE `', target_table, '` DROP FOREIGN KEY `', object_name, '`');
+        PREPARE stmt FROM @statement;
+        EXECUTE stmt;
+        DEALLOCATE PREPARE stmt;
+    END LOOP;
+    CLOSE foreign_keys;
+
+    SET done = FALSE;
+    OPEN indexes;
+    drop_indexes: LOOP
+        FETCH indexes INTO object_name;
+        IF done THEN
+            LEAVE drop_indexes;
+        END IF;
+        SET @statement = CONCAT('ALTER TABLE `', target_table, '` DROP INDEX `', object_name, '`');
+        PREPARE stmt FROM @statement;
+        EXECUTE stmt;
+        DEALLOCATE PREPARE stmt;
+    END LOOP;
+    CLOSE indexes;
+
+    SET @statement = CONCAT('ALTER TABLE `', target_table, '` DROP COLUMN user_id');
+    PREPARE stmt FROM @statement;
+    EXECUTE stmt;
+    DEALLOCATE PREPARE stmt;
+END //
+DELIMITER ;
+
+-- 찜 목록: 기본 프로필로 이전
+ALTER TABLE Wishlists ADD COLUMN profile_id BIGINT NULL AFTER id;
+UPDATE Wishlists w
+JOIN Profiles p ON p.user_id = w.user_id AND p.is_default = TRUE
+SET w.profile_id = p.id;
+CALL miniflix_drop_user_id('Wishlists');
+ALTER TABLE Wishlists
+    MODIFY profile_id BIGINT NOT NULL,
+    ADD UNIQUE KEY profile_id (profile_id, content_id),
+    ADD INDEX idx_wishlists_profile (profile_id),
+    ADD FOREIGN KEY (profile_id) REFERENCES Profiles(id) ON DELETE CASCADE;
+
+-- 시청 기록: 기본 프로필로 이전 (같은 콘텐츠의 중복 기록은 가장 최근 기록만 유지)
+ALTER TABLE ViewingHistories ADD COLUMN profile_id BIGINT NULL AFTER id;
+UPDATE ViewingHistories vh
+JOIN Profiles p ON p.user_id = vh.user_id AND p.is_default = TRUE
+SET vh.profile_id = p.id;
+DELETE vh FROM ViewingHistories vh
+JOIN ViewingHistories newer
+    ON newer.profile_id = vh.profile_id AND newer.content_id = vh.content_id
+    AND (newer.watched_at > vh.watched_at OR (newer.watched_at = vh.watched_at AND newer.id > vh.id));
+CALL miniflix_drop_user_id('ViewingHistories');
+ALTER TABLE ViewingHistories
+    MODIFY profile_id BIGINT NOT NULL,
+    ADD UNIQUE KEY profile_id (profile_id, content_id),
+    ADD INDEX idx_viewing_histories_profile_content (profile_id, content_id),
+    ADD FOREIGN KEY (profile_id) REFERENCES Profiles(id) ON DELETE CASCADE;
+
+DROP PROCEDURE miniflix_drop_user_id;
@@ -0,0 +1,21 @@
+-- 002 재생 세션 단위 동시 시청 수 계산 마이그레이션
+-- 재생 중인 스트림을 프로필당 한 행에서 재생 시작(기기)마다 한 행으로 변경
+-- 재생 중인 스트림은 몇 분 안에 만료되는 임시 기록이므로 기존 행은 옮기지 않고 테이블을 다시 만듦
+-- 적용: mysql -u <user> -p miniflix < backend/db/migrations/002_stream_sessions.sql
+
+DROP TABLE IF EXISTS ActiveStreams;
+
+CREATE TABLE ActiveStreams (
+    id VARCHAR(64) PRIMARY KEY COMMENT '재생 세션 ID',
+    profile_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    content_id BIGINT NOT NULL,
+    started_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_seen_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '마지막 재생 확인 시각',
+    FOREIGN KEY (profile_id) REFERENCES Profiles(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES Users(id) ON DELETE CASCADE,
+    FOREIGN KEY (content_id) REFERENCES Contents(id) ON DELETE CASCADE
+) ENGINE=InnoDB;
+
+CREATE INDEX idx_active_streams_user ON ActiveStreams(user_id, last_seen_at);
+CREATE INDEX idx_active_streams_profile ON ActiveStreams(profile_id, content_id);
@@ -0,0 +1,20 @@
+-- 003 기존 회원 구독 부여 마이그레이션
+-- 요금제 도입 전에 가입한 회원은 구독이 없어 배포 직후 재생이 거부(402)되므로,
+-- 구독한 적이 없는 탈퇴하지 않은 계정에 요금제 도입 전과 가장 가까운 프리미엄 요금제 30일 이용권을 부여
+-- (기간이 끝나면 다른 회원과 같이 결제로 구독을 이어감, 요금제와 기간은 운영 정책에 맞게 조정)
+-- 적용: mysql -u <user> -p miniflix < backend/db/migrations/003_existing_user_subscriptions.sql
+
+-- 요금제 데이터 (seed.sql을 적용하지 않은 데이터베이스용, 이미 있으면 유지)
+INSERT IGNORE INTO Plans (code, name, max_resolution, max_concurrent_streams, download_limit, monthly_price) VALUES
+    ('basic', '베이직', 720, 1, 0, 9500),
+    ('standard', '스탠다드', 1080, 2, 10, 13500),
+    ('premium', '프리미엄', 2160, 4, 100, 17000);
+
+-- 구독한 적이 없는 계정에 이용권 부여
+INSERT INTO Subscriptions (user_id, plan_id, starts_at, ends_at, created_at)
+SELECT u.id, p.id, NOW(), DATE_ADD(NOW(), INTERVAL 30 DAY), NOW()
+FROM Users u
+JOIN Plans p ON p.code = 'premium'
+WHERE u.deleted_at IS NULL
+    AND NOT EXISTS (SELECT 1 FROM Subscriptions s WHERE s.user_id = u.id)
+ORDER BY u.id;
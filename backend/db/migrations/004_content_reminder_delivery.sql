@@ -0,0 +1,8 @@
+-- 004 공개 알림 발송 재시도/중복 발송 방지 마이그레이션
+-- 발송에 실패한 알림은 대기 후 정해진 횟수까지 다시 시도하고, 발송 작업이 가져간 알림은 다른 인스턴스가 발송하지 않도록 기록
+-- 적용: mysql -u <user> -p miniflix < backend/db/migrations/004_content_reminder_delivery.sql
+
+ALTER TABLE ContentReminders
+    ADD COLUMN attempts INT NOT NULL DEFAULT 0 COMMENT '발송 실패 횟수' AFTER notified_at,
+    ADD COLUMN next_attempt_at TIMESTAMP NULL COMMENT '발송 실패 후 다시 시도할 수 있는 시각' AFTER attempts,
+    ADD COLUMN claimed_at TIMESTAMP NULL COMMENT '발송 작업이 가져간 시각 (다른 인스턴스의 중복 발송 방지)' AFTER next_attempt_at;
@@ -0,0 +1,20 @@
+-- 005 인기도 이벤트 중복 반영 방지 마이그레이션
+-- 찜 추가는 프로필/콘텐츠별 처음 한 번만, 재생 시작과 시청 완료는 일정 시간에 한 번만 인기도에 반영하도록 반영 기록 테이블 추가
+-- 적용: mysql -u <user> -p miniflix < backend/db/migrations/005_popularity_events.sql
+
+CREATE TABLE IF NOT EXISTS PopularityEvents (
+    profile_id BIGINT NOT NULL,
+    content_id BIGINT NOT NULL,
+    event VARCHAR(20) NOT NULL COMMENT '이벤트 종류 (stream_start, completion, wishlist_add)',
+    recorded_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '마지막으로 인기도에 반영한 시각',
+    PRIMARY KEY (profile_id, content_id, event),
+    FOREIGN KEY (profile_id) REFERENCES Profiles(id) ON DELETE CASCADE,
+    FOREIGN KEY (content_id) REFERENCES Contents(id) ON DELETE CASCADE
+) ENGINE=InnoDB;
+
+-- 기존 찜과 목록 항목은 이미 인기도에 반영되었으므로 반영 기록으로 남김
+INSERT IGNORE INTO PopularityEvents (profile_id, content_id, event, recorded_at)
+SELECT profile_id, content_id, 'wishlist_add', created_at FROM Wishlists;
+
+INSERT IGNORE INTO PopularityEvents (profile_id, content_id, event, recorded_at)
+SELECT l.profile_id, i.content_id, 'wishlist_add', i.added_at FROM UserListItems i JOIN UserLists l ON l.id = i.list_id;
@@ -13,18 +13,50 @@ CREATE TABLE IF NOT EXISTS Users (
     name VARCHAR(50) NOT NULL,
     created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
-    is_active BOOLEAN NOT NULL DEFAULT TRUE
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    is_admin BOOLEAN NOT NULL DEFAULT FALSE COMMENT '관리자 여부',
+    email_verified_at TIMESTAMP NULL COMMENT '이메일 인증 시각 (미인증 시 NULL)',
+    totp_secret VARCHAR(64) NULL COMMENT 'TOTP 비밀 키 (base32)',
+    totp_enabled BOOLEAN NOT NULL DEFAULT FALSE COMMENT '2단계 인증 활성화 여부',
+    totp_last_step BIGINT NOT NULL DEFAULT 0 COMMENT '마지막으로 사용된 TOTP 주기 번호 (코드 재사용 방지)',
+    parental_pin_hash VARCHAR(255) NULL COMMENT '보호자 PIN 해시 (키즈 프로필 전환 및 시청 제한 콘텐츠 재생 시 필요)',
+    deletion_scheduled_at TIMESTAMP NULL COMMENT '탈퇴 예정 시각 (유예 기간 중 로그인하면 취소)',
+    deleted_at TIMESTAMP NULL COMMENT '탈퇴 처리(익명화) 시각',
+    review_banned_at TIMESTAMP NULL COMMENT '리뷰 작성 금지 시각 (관리자 제재)'
+) ENGINE=InnoDB;
+
+-- 프로필 테이블 (하나의 계정을 여러 시청자가 공유)
+CREATE TABLE IF NOT EXISTS Profiles (
+    id BIGINT PRIMARY KEY AUTO_INCREMENT,
+    user_id BIGINT NOT NULL,
+    name VARCHAR(50) NOT NULL,
+    avatar_url VARCHAR(255) NOT NULL DEFAULT '',
+    language VARCHAR(10) NOT NULL DEFAULT 'ko',
+    maturity_level INT NOT NULL DEFAULT 19 COMMENT '시청 가능 연령 등급 (0: 전체, 12, 15, 19)',
+    is_default BOOLEAN NOT NULL DEFAULT FALSE COMMENT '계정 기본 프로필 여부 (삭제 불가)',
+    is_kids BOOLEAN NOT NULL DEFAULT FALSE COMMENT '키즈 프로필 여부 (시청 등급 12세 이하로 제한)',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES Users(id) ON DELETE CASCADE
 ) ENGINE=InnoDB;
 
 -- 콘텐츠 테이블
 CREATE TABLE IF NOT EXISTS Contents (
     id BIGINT PRIMARY KEY AUTO_INCREMENT,
+    external_id VARCHAR(100) NULL UNIQUE COMMENT '외부 ID (카탈로그 가져오기 기준, 예: tmdb:278)',
     title VARCHAR(200) NOT NULL,
     description TEXT,
     thumbnail_url VARCHAR(255) NOT NULL,
     video_url VARCHAR(255) NOT NULL,
     duration INT NOT NULL COMMENT '영상 길이(초)',
     release_year INT NOT NULL,
+    maturity_level INT NOT NULL DEFAULT 0 COMMENT '시청 등급 (KMRB 기준 0: 전체, 12, 15, 19)',
+    publish_at TIMESTAMP NULL COMMENT '공개 일시 (NULL이면 바로 공개, 이전에는 공개 예정 목록에만 노출)',
+    unpublish_at TIMESTAMP NULL COMMENT '공개 종료 일시 (NULL이면 계속 공개)',
+    thumbs_up_count INT NOT NULL DEFAULT 0 COMMENT '좋아요 수 (ContentRatings 집계)',
+    thumbs_down_count INT NOT NULL DEFAULT 0 COMMENT '싫어요 수 (ContentRatings 집계)',
+    star_rating_count INT NOT NULL DEFAULT 0 COMMENT '별점 수 (ContentRatings 집계)',
+    star_rating_sum INT NOT NULL DEFAULT 0 COMMENT '별점 합계 (평균 = 합계 / 수)',
     created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP
 ) ENGINE=InnoDB;
@@ -49,32 +81,430 @@ CREATE TABLE IF NOT EXISTS ContentGenres (
 -- 찜 목록 테이블
 CREATE TABLE IF NOT EXISTS Wishlists (
     id BIGINT PRIMARY KEY AUTO_INCREMENT,
-    user_id BIGINT NOT NULL,
+    profile_id BIGINT NOT NULL,
     content_id BIGINT NOT NULL,
     created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
-    FOREIGN KEY (user_id) REFERENCES Users(id) ON DELETE CASCADE,
+    FOREIGN KEY (profile_id) REFERENCES Profiles(id) ON DELETE CASCADE,
     FOREIGN KEY (content_id) REFERENCES Contents(id) ON DELETE CASCADE,
-    UNIQUE KEY (user_id, content_id)
+    UNIQUE KEY (profile_id, content_id)
 ) ENGINE=InnoDB;
 
 -- 시청 기록 테이블
 CREATE TABLE IF NOT EXISTS ViewingHistories (
     id BIGINT PRIMARY KEY AUTO_INCREMENT,
-    user_id BIGINT NOT NULL,
+    profile_id BIGINT NOT NULL,
     content_id BIGINT NOT NULL,
     watch_duration INT NOT NULL DEFAULT 0 COMMENT '시청 시간(초)',
     last_position INT NOT NULL DEFAULT 0 COMMENT '마지막 시청 위치(초)',
     watched_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
     is_completed BOOLEAN NOT NULL DEFAULT FALSE,
+    FOREIGN KEY (profile_id) REFERENCES Profiles(id) ON DELETE CASCADE,
+    FOREIGN KEY (content_id) REFERENCES Contents(id) ON DELETE CASCADE,
+    UNIQUE KEY (profile_id, content_id)
+) ENGINE=InnoDB;
+
+-- 콘텐츠 유사도 테이블 (추천 배치 작업으로 주기적으로 재계산)
+CREATE TABLE IF NOT EXISTS ContentSimilarities (
+    content_id BIGINT NOT NULL,
+    similar_content_id BIGINT NOT NULL,
+    score DOUBLE NOT NULL COMMENT '유사도 점수 (0~1)',
+    source VARCHAR(20) NOT NULL COMMENT '계산 근거 (co_occurrence, genre)',
+    computed_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (content_id, similar_content_id),
+    FOREIGN KEY (content_id) REFERENCES Contents(id) ON DELETE CASCADE,
+    FOREIGN KEY (similar_content_id) REFERENCES Contents(id) ON DELETE CASCADE
+) ENGINE=InnoDB;
+
+-- 콘텐츠 인기도 테이블 (재생/찜 이벤트 발생 시 시간 감쇠를 적용해 갱신)
+CREATE TABLE IF NOT EXISTS ContentPopularity (
+    content_id BIGINT PRIMARY KEY,
+    score DOUBLE NOT NULL DEFAULT 0 COMMENT 'updated_at 시점 기준 인기도 점수',
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (content_id) REFERENCES Contents(id) ON DELETE CASCADE
+) ENGINE=InnoDB;
+
+-- 인기도 이벤트 반영 기록 (같은 프로필의 반복 이벤트로 인기도가 부풀지 않도록 프로필/콘텐츠/이벤트별 마지막 반영 시각 보관)
+CREATE TABLE IF NOT EXISTS PopularityEvents (
+    profile_id BIGINT NOT NULL,
+    content_id BIGINT NOT NULL,
+    event VARCHAR(20) NOT NULL COMMENT '이벤트 종류 (stream_start, completion, wishlist_add)',
+    recorded_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '마지막으로 인기도에 반영한 시각',
+    PRIMARY KEY (profile_id, content_id, event),
+    FOREIGN KEY (profile_id) REFERENCES Profiles(id) ON DELETE CASCADE,
+    FOREIGN KEY (content_id) REFERENCES Contents(id) ON DELETE CASCADE
+) ENGINE=InnoDB;
+
+-- 리프레시 토큰 테이블 (로그인 세션별 토큰 계열, 갱신 시마다 교체되며 재사용 시 계열 전체 폐기)
+CREATE TABLE IF NOT EXISTS RefreshTokens (
+    id BIGINT PRIMARY KEY AUTO_INCREMENT,
+    user_id BIGINT NOT NULL,
+    profile_id BIGINT NOT NULL COMMENT '현재 선택된 프로필 ID',
+    family_id VARCHAR(64) NOT NULL COMMENT '토큰 계열 ID (로그인 세션 식별자)',
+    token_hash CHAR(64) NOT NULL UNIQUE COMMENT '리프레시 토큰 SHA-256 해시',
+    access_jti VARCHAR(64) NOT NULL COMMENT '함께 발급된 액세스 토큰 ID',
+    access_expires_at TIMESTAMP NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    revoked_at TIMESTAMP NULL COMMENT '교체/폐기 시각',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES Users(id) ON DELETE CASCADE
+) ENGINE=InnoDB;
+
+-- 로그인 세션 테이블 (리프레시 토큰 계열별 기기 정보)
+CREATE TABLE IF NOT EXISTS UserSessions (
+    id BIGINT PRIMARY KEY AUTO_INCREMENT,
+    user_id BIGINT NOT NULL,
+    family_id VARCHAR(64) NOT NULL UNIQUE COMMENT '리프레시 토큰 계열 ID',
+    device_name VARCHAR(100) NOT NULL DEFAULT '',
+    user_agent VARCHAR(255) NOT NULL DEFAULT '',
+    ip_address VARCHAR(45) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_seen_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '마지막 토큰 갱신 시각',
+    revoked_at TIMESTAMP NULL COMMENT '로그아웃/폐기 시각',
+    FOREIGN KEY (user_id) REFERENCES Users(id) ON DELETE CASCADE
+) ENGINE=InnoDB;
+
+-- 폐기된 액세스 토큰 테이블 (jti 거부 목록, 만료 후 정리)
+CREATE TABLE IF NOT EXISTS RevokedTokens (
+    jti VARCHAR(64) PRIMARY KEY,
+    expires_at TIMESTAMP NOT NULL
+) ENGINE=InnoDB;
+
+-- 일회용 토큰 테이블 (비밀번호 재설정/이메일 인증, 해시만 저장)
+CREATE TABLE IF NOT EXISTS UserTokens (
+    id BIGINT PRIMARY KEY AUTO_INCREMENT,
+    user_id BIGINT NOT NULL,
+    purpose VARCHAR(30) NOT NULL COMMENT '토큰 용도 (password_reset, email_verification)',
+    token_hash CHAR(64) NOT NULL UNIQUE COMMENT '토큰 SHA-256 해시',
+    expires_at TIMESTAMP NOT NULL,
+    used_at TIMESTAMP NULL COMMENT '사용/무효화 시각',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES Users(id) ON DELETE CASCADE
+) ENGINE=InnoDB;
+
+-- 2단계 인증 복구 코드 테이블 (해시만 저장, 한 번씩 사용 가능)
+CREATE TABLE IF NOT EXISTS RecoveryCodes (
+    id BIGINT PRIMARY KEY AUTO_INCREMENT,
+    user_id BIGINT NOT NULL,
+    code_hash CHAR(64) NOT NULL COMMENT '복구 코드 SHA-256 해시',
+    used_at TIMESTAMP NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES Users(id) ON DELETE CASCADE,
+    UNIQUE KEY uk_recovery_codes_user_code (user_id, code_hash)
+) ENGINE=InnoDB;
+
+CREATE TABLE IF NOT EXISTS UserIdentities (
+    id BIGINT PRIMARY KEY AUTO_INCREMENT,
+    user_id BIGINT NOT NULL,
+    provider VARCHAR(50) NOT NULL COMMENT '소셜 로그인 제공자 이름',
+    subject VARCHAR(255) NOT NULL COMMENT '제공자 내 사용자 식별자 (sub)',
+    email VARCHAR(100) NOT NULL COMMENT '연결 당시 제공자 이메일',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_login_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES Users(id) ON DELETE CASCADE,
+    UNIQUE KEY uk_user_identities_provider_subject (provider, subject)
+) ENGINE=InnoDB;
+
+CREATE TABLE IF NOT EXISTS AccountLockouts (
+    id BIGINT PRIMARY KEY AUTO_INCREMENT,
+    user_id BIGINT NULL COMMENT '잠긴 계정 ID (가입되지 않은 이메일은 NULL)',
+    email VARCHAR(100) NOT NULL COMMENT '로그인 시도 이메일',
+    ip_address VARCHAR(45) NOT NULL COMMENT '잠금을 일으킨 요청 IP',
+    failed_attempts INT NOT NULL COMMENT '잠금 시점의 연속 실패 횟수',
+    locked_until TIMESTAMP NOT NULL,
+    unlocked_at TIMESTAMP NULL COMMENT '관리자 잠금 해제 시각',
+    unlocked_by BIGINT NULL COMMENT '잠금을 해제한 관리자 ID',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES Users(id) ON DELETE SET NULL,
+    FOREIGN KEY (unlocked_by) REFERENCES Users(id) ON DELETE SET NULL
+) ENGINE=InnoDB;
+
+-- 개인정보 내보내기 테이블 (비동기로 생성되는 ZIP 파일, 보관 기간 후 삭제)
+CREATE TABLE IF NOT EXISTS DataExports (
+    id BIGINT PRIMARY KEY AUTO_INCREMENT,
+    user_id BIGINT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' COMMENT '상태 (pending, completed, failed)',
+    file_path VARCHAR(255) NOT NULL DEFAULT '' COMMENT '생성된 ZIP 파일 경로',
+    file_size BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    completed_at TIMESTAMP NULL,
+    expires_at TIMESTAMP NOT NULL COMMENT '파일 삭제 예정 시각',
+    FOREIGN KEY (user_id) REFERENCES Users(id) ON DELETE CASCADE
+) ENGINE=InnoDB;
+
+-- 요금제 테이블
+CREATE TABLE IF NOT EXISTS Plans (
+    id BIGINT PRIMARY KEY AUTO_INCREMENT,
+    code VARCHAR(20) NOT NULL UNIQUE COMMENT '요금제 코드 (basic, standard, premium)',
+    name VARCHAR(50) NOT NULL,
+    max_resolution INT NOT NULL COMMENT '최대 화질 (세로 해상도)',
+    max_concurrent_streams INT NOT NULL COMMENT '동시 시청 가능한 프로필 수',
+    download_limit INT NOT NULL DEFAULT 0 COMMENT '저장 가능한 콘텐츠 수 (0: 저장 불가)',
+    monthly_price INT NOT NULL COMMENT '월 요금 (원)',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+) ENGINE=InnoDB;
+
+-- 구독 테이블 (계정별 요금제 이용 기간)
+CREATE TABLE IF NOT EXISTS Subscriptions (
+    id BIGINT PRIMARY KEY AUTO_INCREMENT,
+    user_id BIGINT NOT NULL,
+    plan_id BIGINT NOT NULL,
+    starts_at TIMESTAMP NOT NULL,
+    ends_at TIMESTAMP NOT NULL,
+    cancelled_at TIMESTAMP NULL COMMENT '해지 신청 시각 (종료일까지는 이용 가능)',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
     FOREIGN KEY (user_id) REFERENCES Users(id) ON DELETE CASCADE,
+    FOREIGN KEY (plan_id) REFERENCES Plans(id)
+) ENGINE=InnoDB;
+
+-- 재생 중인 스트림 테이블 (재생 시작마다 한 행인 기기별 재생 세션, 재생 위치 업데이트로 갱신되며 동시 시청 수 계산에 사용)
+CREATE TABLE IF NOT EXISTS ActiveStreams (
+    id VARCHAR(64) PRIMARY KEY COMMENT '재생 세션 ID',
+    profile_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    content_id BIGINT NOT NULL,
+    started_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_seen_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '마지막 재생 확인 시각',
+    FOREIGN KEY (profile_id) REFERENCES Profiles(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES Users(id) ON DELETE CASCADE,
+    FOREIGN KEY (content_id) REFERENCES Contents(id) ON DELETE CASCADE
+) ENGINE=InnoDB;
+
+-- 결제 요청(체크아웃 세션) 테이블
+CREATE TABLE IF NOT EXISTS CheckoutSessions (
+    id BIGINT PRIMARY KEY AUTO_INCREMENT,
+    user_id BIGINT NOT NULL,
+    plan_id BIGINT NOT NULL,
+    provider VARCHAR(50) NOT NULL COMMENT '결제 제공자 (예: fake)',
+    provider_session_id VARCHAR(255) NOT NULL COMMENT '결제 제공자의 세션 ID',
+    checkout_url VARCHAR(1024) NOT NULL COMMENT '결제 페이지 URL',
+    amount INT NOT NULL COMMENT '결제 금액',
+    currency VARCHAR(3) NOT NULL COMMENT '통화 (ISO 4217)',
+    status VARCHAR(20) NOT NULL DEFAULT 'open' COMMENT '상태 (open, completed, failed)',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMP NOT NULL,
+    completed_at TIMESTAMP NULL,
+    UNIQUE KEY (provider, provider_session_id),
+    FOREIGN KEY (user_id) REFERENCES Users(id) ON DELETE CASCADE,
+    FOREIGN KEY (plan_id) REFERENCES Plans(id)
+) ENGINE=InnoDB;
+
+-- 청구서 테이블 (결제 성공/실패/환불 기록)
+CREATE TABLE IF NOT EXISTS Invoices (
+    id BIGINT PRIMARY KEY AUTO_INCREMENT,
+    user_id BIGINT NOT NULL,
+    checkout_session_id BIGINT NOT NULL,
+    subscription_id BIGINT NULL COMMENT '결제로 생성된 구독 (실패한 결제는 NULL)',
+    provider VARCHAR(50) NOT NULL,
+    provider_payment_id VARCHAR(255) NOT NULL COMMENT '결제 제공자의 결제 ID',
+    amount INT NOT NULL,
+    currency VARCHAR(3) NOT NULL,
+    status VARCHAR(20) NOT NULL COMMENT '상태 (paid, failed, refunded)',
+    failure_reason VARCHAR(255) NOT NULL DEFAULT '',
+    period_start TIMESTAMP NULL COMMENT '이용 기간 시작',
+    period_end TIMESTAMP NULL COMMENT '이용 기간 종료',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    refunded_at TIMESTAMP NULL,
+    UNIQUE KEY (provider, provider_payment_id),
+    FOREIGN KEY (user_id) REFERENCES Users(id) ON DELETE CASCADE,
+    FOREIGN KEY (checkout_session_id) REFERENCES CheckoutSessions(id),
+    FOREIGN KEY (subscription_id) REFERENCES Subscriptions(id) ON DELETE SET NULL
+) ENGINE=InnoDB;
+
+-- 처리한 결제 웹훅 이벤트 테이블 (같은 이벤트의 중복 처리 방지)
+CREATE TABLE IF NOT EXISTS BillingEvents (
+    provider VARCHAR(50) NOT NULL,
+    event_id VARCHAR(255) NOT NULL COMMENT '결제 제공자의 이벤트 ID',
+    event_type VARCHAR(50) NOT NULL,
+    processed_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (provider, event_id)
+) ENGINE=InnoDB;
+
+-- 콘텐츠 이용 가능 기간 테이블 (라이선스 국가/기간, 행이 없는 콘텐츠는 지역 제한 없음)
+CREATE TABLE IF NOT EXISTS ContentAvailability (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    content_id BIGINT NOT NULL,
+    region VARCHAR(2) NOT NULL COMMENT 'ISO 3166-1 alpha-2 국가 코드, *는 모든 국가',
+    available_from TIMESTAMP NULL COMMENT '이용 시작일시 (NULL이면 제한 없음)',
+    available_until TIMESTAMP NULL COMMENT '이용 종료일시 (NULL이면 제한 없음)',
+    FOREIGN KEY (content_id) REFERENCES Contents(id) ON DELETE CASCADE
+) ENGINE=InnoDB;
+
+-- 공개 알림 신청 테이블 (공개 예정 콘텐츠가 공개되면 메일 발송)
+CREATE TABLE IF NOT EXISTS ContentReminders (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    profile_id BIGINT NOT NULL,
+    content_id BIGINT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    notified_at TIMESTAMP NULL COMMENT '알림 발송 시각 (발송 전 NULL)',
+    attempts INT NOT NULL DEFAULT 0 COMMENT '발송 실패 횟수',
+    next_attempt_at TIMESTAMP NULL COMMENT '발송 실패 후 다시 시도할 수 있는 시각',
+    claimed_at TIMESTAMP NULL COMMENT '발송 작업이 가져간 시각 (다른 인스턴스의 중복 발송 방지)',
+    UNIQUE KEY (profile_id, content_id),
+    FOREIGN KEY (profile_id) REFERENCES Profiles(id) ON DELETE CASCADE,
+    FOREIGN KEY (content_id) REFERENCES Contents(id) ON DELETE CASCADE
+) ENGINE=InnoDB;
+
+-- 콘텐츠 번역 테이블 (언어별 제목/설명, 없으면 원문 사용)
+CREATE TABLE IF NOT EXISTS ContentTranslations (
+    content_id BIGINT NOT NULL,
+    locale VARCHAR(35) NOT NULL COMMENT '언어 태그 (BCP 47, 예: en, en-US)',
+    title VARCHAR(200) NOT NULL,
+    description TEXT NULL COMMENT '번역된 설명 (NULL이면 원문 설명)',
+    PRIMARY KEY (content_id, locale),
+    FOREIGN KEY (content_id) REFERENCES Contents(id) ON DELETE CASCADE
+) ENGINE=InnoDB;
+
+-- 장르 번역 테이블 (언어별 이름/설명, 없으면 원문 사용)
+CREATE TABLE IF NOT EXISTS GenreTranslations (
+    genre_id BIGINT NOT NULL,
+    locale VARCHAR(35) NOT NULL COMMENT '언어 태그 (BCP 47, 예: en, en-US)',
+    name VARCHAR(50) NOT NULL,
+    description TEXT NULL COMMENT '번역된 설명 (NULL이면 원문 설명)',
+    PRIMARY KEY (genre_id, locale),
+    FOREIGN KEY (genre_id) REFERENCES Genres(id) ON DELETE CASCADE
+) ENGINE=InnoDB;
+
+-- 큐레이션 컬렉션 테이블 (노출 기간이 NULL이면 제한 없음)
+CREATE TABLE IF NOT EXISTS Collections (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    slug VARCHAR(100) NOT NULL UNIQUE COMMENT 'URL 식별자 (소문자, 숫자, 하이픈)',
+    title VARCHAR(100) NOT NULL,
+    description VARCHAR(500) NULL,
+    hero_image_url VARCHAR(255) NULL COMMENT '대표 이미지 URL',
+    position INT NOT NULL DEFAULT 0 COMMENT '노출 순서 (작을수록 먼저)',
+    starts_at TIMESTAMP NULL COMMENT '노출 시작 일시',
+    ends_at TIMESTAMP NULL COMMENT '노출 종료 일시',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP
+) ENGINE=InnoDB;
+
+-- 컬렉션 콘텐츠 테이블 (position 순서대로 노출)
+CREATE TABLE IF NOT EXISTS CollectionItems (
+    collection_id BIGINT NOT NULL,
+    content_id BIGINT NOT NULL,
+    position INT NOT NULL DEFAULT 0,
+    PRIMARY KEY (collection_id, content_id),
+    FOREIGN KEY (collection_id) REFERENCES Collections(id) ON DELETE CASCADE,
+    FOREIGN KEY (content_id) REFERENCES Contents(id) ON DELETE CASCADE
+) ENGINE=InnoDB;
+
+-- 콘텐츠 평가 테이블 (프로필당 콘텐츠별 1건, 변경 시 Contents 집계 컬럼도 함께 갱신)
+CREATE TABLE IF NOT EXISTS ContentRatings (
+    profile_id BIGINT NOT NULL,
+    content_id BIGINT NOT NULL,
+    thumb TINYINT NULL COMMENT '엄지 평가 (1: 좋아요, -1: 싫어요)',
+    stars TINYINT NULL COMMENT '별점 (1~5)',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (profile_id, content_id),
+    FOREIGN KEY (profile_id) REFERENCES Profiles(id) ON DELETE CASCADE,
+    FOREIGN KEY (content_id) REFERENCES Contents(id) ON DELETE CASCADE
+) ENGINE=InnoDB;
+
+-- 리뷰 테이블 (계정당 콘텐츠별 1건, 추천/비추천 수는 ReviewVotes 변경 시 함께 갱신)
+CREATE TABLE IF NOT EXISTS Reviews (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    content_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    profile_id BIGINT NULL COMMENT '작성 프로필 (작성자 이름 표시용)',
+    body TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'published' COMMENT '상태 (published: 공개, pending: 검토 대기, hidden: 숨김)',
+    flag_reason VARCHAR(100) NULL COMMENT '자동 검토 대기 사유 (스팸 단어, 링크 등)',
+    helpful_count INT NOT NULL DEFAULT 0 COMMENT '도움이 됐어요 수',
+    not_helpful_count INT NOT NULL DEFAULT 0 COMMENT '도움이 안 됐어요 수',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_reviews_user_content (user_id, content_id),
     FOREIGN KEY (content_id) REFERENCES Contents(id) ON DELETE CASCADE,
-    UNIQUE KEY (user_id, content_id)
+    FOREIGN KEY (user_id) REFERENCES Users(id) ON DELETE CASCADE,
+    FOREIGN KEY (profile_id) REFERENCES Profiles(id) ON DELETE SET NULL
+) ENGINE=InnoDB;
+
+-- 리뷰 추천 테이블 (계정당 리뷰별 1건)
+CREATE TABLE IF NOT EXISTS ReviewVotes (
+    review_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    helpful BOOLEAN NOT NULL COMMENT '도움이 됐는지 여부',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (review_id, user_id),
+    FOREIGN KEY (review_id) REFERENCES Reviews(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES Users(id) ON DELETE CASCADE
+) ENGINE=InnoDB;
+
+-- 리뷰 신고 테이블 (계정당 리뷰별 1건, 관리자가 처리하면 resolved_at 기록)
+CREATE TABLE IF NOT EXISTS ReviewReports (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    review_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    reason VARCHAR(20) NOT NULL COMMENT '신고 사유 (spam, abuse, spoiler, other)',
+    detail VARCHAR(500) NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    resolved_at TIMESTAMP NULL COMMENT '처리 시각',
+    resolution VARCHAR(20) NULL COMMENT '처리 결과 (approve, hide, ban)',
+    UNIQUE KEY uk_review_reports_review_user (review_id, user_id),
+    FOREIGN KEY (review_id) REFERENCES Reviews(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES Users(id) ON DELETE CASCADE
+) ENGINE=InnoDB;
+
+-- 사용자 목록 테이블 (프로필별 이름 있는 목록, 기본 목록의 항목은 Wishlists 테이블 사용)
+CREATE TABLE IF NOT EXISTS UserLists (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    profile_id BIGINT NOT NULL,
+    name VARCHAR(50) NOT NULL,
+    is_default BOOLEAN NOT NULL DEFAULT FALSE COMMENT '기본 목록(찜 목록) 여부',
+    position INT NOT NULL DEFAULT 0 COMMENT '목록 순서 (작을수록 먼저, 기본 목록은 항상 처음)',
+    share_token VARCHAR(64) NULL UNIQUE COMMENT '공유 링크 토큰 (NULL이면 공유하지 않음)',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_user_lists_profile_name (profile_id, name),
+    FOREIGN KEY (profile_id) REFERENCES Profiles(id) ON DELETE CASCADE
+) ENGINE=InnoDB;
+
+-- 사용자 목록 항목 테이블 (position 순서대로 노출)
+CREATE TABLE IF NOT EXISTS UserListItems (
+    list_id BIGINT NOT NULL,
+    content_id BIGINT NOT NULL,
+    position INT NOT NULL DEFAULT 0,
+    added_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (list_id, content_id),
+    FOREIGN KEY (list_id) REFERENCES UserLists(id) ON DELETE CASCADE,
+    FOREIGN KEY (content_id) REFERENCES Contents(id) ON DELETE CASCADE
 ) ENGINE=InnoDB;
 
 -- 인덱스 추가
 CREATE INDEX idx_users_email ON Users(email);
 CREATE INDEX idx_contents_title ON Contents(title);
-CREATE INDEX idx_viewing_histories_user_content ON ViewingHistories(user_id, content_id);
-CREATE INDEX idx_wishlists_user ON Wishlists(user_id);
+CREATE INDEX idx_viewing_histories_profile_content ON ViewingHistories(profile_id, content_id);
+CREATE INDEX idx_wishlists_profile ON Wishlists(profile_id);
+CREATE INDEX idx_profiles_user ON Profiles(user_id);
 CREATE INDEX idx_content_genres_content ON ContentGenres(content_id);
-CREATE INDEX idx_content_genres_genre ON ContentGenres(genre_id); 
\ No newline at end of file
+CREATE INDEX idx_content_genres_genre ON ContentGenres(genre_id); 
+CREATE INDEX idx_content_similarities_score ON ContentSimilarities(content_id, score);
+CREATE INDEX idx_refresh_tokens_family ON RefreshTokens(family_id);
+CREATE INDEX idx_refresh_tokens_user ON RefreshTokens(user_id);
+CREATE INDEX idx_refresh_tokens_access_jti ON RefreshTokens(access_jti);
+CREATE INDEX idx_revoked_tokens_expires ON RevokedTokens(expires_at);
+CREATE INDEX idx_user_sessions_user ON UserSessions(user_id, last_seen_at);
+CREATE INDEX idx_user_tokens_user ON UserTokens(user_id, purpose);
+CREATE INDEX idx_user_identities_user ON UserIdentities(user_id);
+CREATE INDEX idx_account_lockouts_user ON AccountLockouts(user_id, created_at);
+CREATE INDEX idx_account_lockouts_email ON AccountLockouts(email, created_at);
+CREATE INDEX idx_users_deletion_scheduled ON Users(deletion_scheduled_at);
+CREATE INDEX idx_data_exports_user ON DataExports(user_id, created_at);
+CREATE INDEX idx_data_exports_expires ON DataExports(expires_at);
+CREATE INDEX idx_subscriptions_user ON Subscriptions(user_id, ends_at);
+CREATE INDEX idx_active_streams_user ON ActiveStreams(user_id, last_seen_at);
+CREATE INDEX idx_active_streams_profile ON ActiveStreams(profile_id, content_id);
+CREATE INDEX idx_checkout_sessions_user ON CheckoutSessions(user_id, created_at);
+CREATE INDEX idx_invoices_user ON Invoices(user_id, created_at);
+CREATE INDEX idx_content_availability_content ON ContentAvailability(content_id, region);
+CREATE INDEX idx_contents_publish_at ON Contents(publish_at);
+CREATE INDEX idx_content_reminders_pending ON ContentReminders(notified_at, content_id);
+CREATE INDEX idx_collections_position ON Collections(position);
+CREATE INDEX idx_collection_items_position ON CollectionItems(collection_id, position);
+CREATE INDEX idx_content_ratings_content ON ContentRatings(content_id);
+CREATE INDEX idx_reviews_content ON Reviews(content_id, status, created_at);
+CREATE INDEX idx_reviews_status ON Reviews(status, updated_at);
+CREATE INDEX idx_review_reports_pending ON ReviewReports(resolved_at, review_id);
+CREATE INDEX idx_user_lists_profile ON UserLists(profile_id, position);
+CREATE INDEX idx_user_list_items_position ON UserListItems(list_id, position);
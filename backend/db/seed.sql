@@ -12,6 +12,12 @@ INSERT INTO Genres (name, description) VALUES
     ('스릴러', '긴장감과 공포를 주는 영화'),
     ('로맨스', '사랑을 주제로 한 영화');
 
+-- 요금제 데이터
+INSERT INTO Plans (code, name, max_resolution, max_concurrent_streams, download_limit, monthly_price) VALUES
+    ('basic', '베이직', 720, 1, 0, 9500),
+    ('standard', '스탠다드', 1080, 2, 10, 13500),
+    ('premium', '프리미엄', 2160, 4, 100, 17000);
+
 -- 콘텐츠 샘플 데이터
 INSERT INTO Contents (id, title, description, thumbnail_url, video_url, duration, release_year) VALUES
 (1, 'The Shawshank Redemption', 'Imprisoned in the 1940s for the double murder of his wife and her lover, upstanding banker Andy Dufresne begins a new life at the Shawshank prison, where he puts his accounting skills to work for an amoral warden. During his long stretch in prison, Dufresne comes to be admired by the other inmates -- including an older prisoner named Red -- for his integrity and unquenchable sense of hope.', 'https://image.tmdb.org/t/p/w500//9cqNxx0GxF0bflZmeSMuL5tnGzr.jpg', 'https://image.tmdb.org/t/p/w500//zfbjgQE1uSd9wiPTX4VzsLi0rGG.jpg', 126, 1994),
@@ -3685,4 +3691,47 @@ INSERT INTO ContentGenres (content_id, genre_id) VALUES
 (1975, 2),
 (1975, 3),
 (1978, 8),
-(1980, 3);
\ No newline at end of file
+(1980, 3);
+-- 장르 번역 데이터
+INSERT INTO GenreTranslations (genre_id, locale, name, description) VALUES
+    (1, 'en', 'Action', 'Films full of thrills and adventure'),
+    (2, 'en', 'Comedy', 'Films that make you laugh'),
+    (3, 'en', 'Drama', 'Moving stories about people'),
+    (4, 'en', 'Sci-Fi', 'Films driven by scientific imagination'),
+    (5, 'en', 'Animation', 'Films made with drawings or computer graphics'),
+    (6, 'en', 'Documentary', 'Films about real events and facts'),
+    (7, 'en', 'Thriller', 'Films full of suspense and fear'),
+    (8, 'en', 'Romance', 'Films about love');
+
+-- 콘텐츠 번역 데이터
+INSERT INTO ContentTranslations (content_id, locale, title, description) VALUES
+    (1, 'ko', '쇼생크 탈출', NULL),
+    (2, 'ko', '대부', NULL),
+    (3, 'ko', '대부 2', NULL),
+    (4, 'ko', '쉰들러 리스트', NULL),
+    (5, 'ko', '12인의 성난 사람들', NULL),
+    (6, 'ko', '센과 치히로의 행방불명', NULL),
+    (7, 'ko', '다크 나이트', NULL),
+    (9, 'ko', '그린 마일', NULL),
+    (10, 'ko', '기생충', '전원백수로 살 길 막막하지만 사이는 좋은 기택 가족. 장남 기우가 고액 과외 면접을 위해 박사장네 집에 발을 들이면서 걷잡을 수 없는 사건이 벌어진다.'),
+    (11, 'ko', '펄프 픽션', NULL),
+    (12, 'ko', '너의 이름은.', NULL),
+    (6, 'ja', '千と千尋の神隠し', NULL),
+    (12, 'ja', '君の名は。', NULL);
+
+-- 시드 콘텐츠 외부 ID (카탈로그 내보내기/가져오기 기준)
+UPDATE Contents SET external_id = CONCAT('miniflix:', id) WHERE external_id IS NULL;
+
+-- 큐레이션 컬렉션
+INSERT INTO Collections (id, slug, title, description, hero_image_url, position) VALUES
+    (1, 'oscar-winners', '아카데미 수상작', '아카데미 작품상을 받은 명작 모음', '/heroes/oscar-winners.jpg', 0),
+    (2, 'anime-classics', '애니메이션 명작', '세대를 넘어 사랑받는 일본 애니메이션', '/heroes/anime-classics.jpg', 1);
+
+INSERT INTO CollectionItems (collection_id, content_id, position) VALUES
+    (1, 10, 0),
+    (1, 2, 1),
+    (1, 3, 2),
+    (1, 4, 3),
+    (2, 6, 0),
+    (2, 12, 1),
+    (2, 404, 2);
@@ -0,0 +1,342 @@
+package helper
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"backend/config"
+)
+
+// AttemptStore 로그인 실패 횟수와 차단 상태 저장소
+// 여러 서버 인스턴스가 같은 상태를 공유해야 하면 Redis 호환 저장소 사용
+type AttemptStore interface {
+	// Increment 실패 횟수를 1 증가시키고 현재 값 반환 (마지막 실패 후 window가 지나면 초기화)
+	Increment(key string, window time.Duration) (int64, error)
+	// Block key를 d 동안 차단 상태로 설정
+	Block(key string, d time.Duration) error
+	// BlockedFor 남은 차단 시간 반환 (차단되지 않았으면 0)
+	BlockedFor(key string) (time.Duration, error)
+	// Reset 실패 횟수 및 차단 상태 삭제
+	Reset(keys ...string) error
+}
+
+var (
+	attemptStoreOnce sync.Once
+	attemptStore     AttemptStore
+)
+
+// GetAttemptStore 설정에 맞는 로그인 실패 저장소 반환 (프로세스 전체에서 하나를 공유)
+func GetAttemptStore(cfg *config.Config) AttemptStore {
+	attemptStoreOnce.Do(func() {
+		attemptStore = NewAttemptStore(cfg.LoginProtection)
+	})
+	return attemptStore
+}
+
+// NewAttemptStore 설정의 저장소 방식에 맞는 AttemptStore 생성
+func NewAttemptStore(cfg config.LoginProtectionConfig) AttemptStore {
+	if cfg.Store == config.AttemptStoreRedis {
+		return NewRedisAttemptStore(cfg.RedisAddr, cfg.RedisPassword, cfg.RedisDB)
+	}
+	if cfg.Store != config.AttemptStoreMemory {
+		log.Printf("알 수 없는 로그인 실패 저장소 (%s), 메모리 저장소를 사용합니다", cfg.Store)
+	}
+	return NewMemoryAttemptStore()
+}
+
+// attemptEntry 메모리 저장소 항목
+type attemptEntry struct {
+	count     int64
+	expiresAt time.Time
+}
+
+// MemoryAttemptStore 서버 메모리에 보관하는 AttemptStore (단일 인스턴스/테스트용)
+type MemoryAttemptStore struct {
+	mu        sync.Mutex
+	entries   map[string]attemptEntry
+	lastSweep time.Time
+}
+
+// memorySweepInterval 만료된 항목을 정리하는 최소 간격
+const memorySweepInterval = time.Minute
+
+// NewMemoryAttemptStore 새 MemoryAttemptStore 생성
+func NewMemoryAttemptStore() *MemoryAttemptStore {
+	return &MemoryAttemptStore{entries: map[string]attemptEntry{}}
+}
+
+// Increment 실패 횟수 증가
+func (s *MemoryAttemptStore) Increment(key string, window time.Duration) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	s.sweep(now)
+
+	entry := s.entries[key]
+	if !now.Before(entry.expiresAt) {
+		entry.count = 0
+	}
+	entry.count++
+	entry.expiresAt = now.Add(window)
+	s.entries[key] = entry
+
+	return entry.count, nil
+}
+
+// Block key를 d 동안 차단
+func (s *MemoryAttemptStore) Block(key string, d time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.entries[key] = attemptEntry{count: 1, expiresAt: time.Now().Add(d)}
+	return nil
+}
+
+// BlockedFor 남은 차단 시간 조회
+func (s *MemoryAttemptStore) BlockedFor(key string) (time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, ok := s.entries[key]
+	if !ok {
+		return 0, nil
+	}
+	if remaining := time.Until(entry.expiresAt); remaining > 0 {
+		return remaining, nil
+	}
+	return 0, nil
+}
+
+// Reset 항목 삭제
+func (s *MemoryAttemptStore) Reset(keys ...string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, key := range keys {
+		delete(s.entries, key)
+	}
+	return nil
+}
+
+// sweep 만료된 항목 정리 (호출자가 잠금 보유)
+func (s *MemoryAttemptStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < memorySweepInterval {
+		return
+	}
+	for key, entry := range s.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(s.entries, key)
+		}
+	}
+	s.lastSweep = now
+}
+
+// redisTimeout Redis 연결/명령 제한 시간
+const redisTimeout = 3 * time.Second
+
+// redisKeyPrefix 다른 용도의 키와 겹치지 않도록 붙이는 접두사
+const redisKeyPrefix = "miniflix:"
+
+// RedisError Redis 서버가 반환한 오류 응답
+type RedisError string
+
+// Error 오류 메시지
+func (e RedisError) Error() string {
+	return "redis: " + string(e)
+}
+
+// RedisAttemptStore Redis 프로토콜(RESP)을 사용하는 AttemptStore
+// Redis 외에 KeyDB, Valkey 등 호환 서버에서도 동작 (INCR, PEXPIRE, SET PX, PTTL, DEL 사용)
+type RedisAttemptStore struct {
+	Addr     string
+	Password string
+	DB       int
+
+	mu     sync.Mutex
+	conn   net.Conn
+	reader *bufio.Reader
+}
+
+// NewRedisAttemptStore 새 RedisAttemptStore 생성 (첫 명령 실행 시 연결)
+func NewRedisAttemptStore(addr, password string, db int) *RedisAttemptStore {
+	return &RedisAttemptStore{Addr: addr, Password: password, DB: db}
+}
+
+// Increment INCR 후 PEXPIRE로 실패 횟수 유지 기간 갱신
+func (s *RedisAttemptStore) Increment(key string, window time.Duration) (int64, error) {
+	reply, err := s.do("INCR", redisKeyPrefix+key)
+	if err != nil {
+		return 0, err
+	}
+	count, ok := reply.(int64)
+	if !ok {
+		return 0, fmt.Errorf("redis: INCR 응답 형식 오류: %v", reply)
+	}
+
+	if _, err := s.do("PEXPIRE", redisKeyPrefix+key, strconv.FormatInt(window.Milliseconds(), 10)); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// Block SET PX로 차단 키 설정
+func (s *RedisAttemptStore) Block(key string, d time.Duration) error {
+	_, err := s.do("SET", redisKeyPrefix+key, "1", "PX", strconv.FormatInt(d.Milliseconds(), 10))
+	return err
+}
+
+// BlockedFor PTTL로 남은 차단 시간 조회
+func (s *RedisAttemptStore) BlockedFor(key string) (time.Duration, error) {
+	reply, err := s.do("PTTL", redisKeyPrefix+key)
+	if err != nil {
+		return 0, err
+	}
+	ttl, ok := reply.(int64)
+	if !ok {
+		return 0, fmt.Errorf("redis: PTTL 응답 형식 오류: %v", reply)
+	}
+	if ttl <= 0 {
+		return 0, nil // -2: 키 없음, -1: 만료 시간 없음 (사용하지 않음)
+	}
+	return time.Duration(ttl) * time.Millisecond, nil
+}
+
+// Reset DEL로 키 삭제
+func (s *RedisAttemptStore) Reset(keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	args := []string{"DEL"}
+	for _, key := range keys {
+		args = append(args, redisKeyPrefix+key)
+	}
+	_, err := s.do(args...)
+	return err
+}
+
+// do 명령을 보내고 응답 반환 (연결 오류 시 다음 명령에서 다시 연결)
+func (s *RedisAttemptStore) do(args ...string) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.conn == nil {
+		if err := s.connect(); err != nil {
+			return nil, err
+		}
+	}
+
+	reply, err := s.roundTrip(args)
+	if err != nil {
+		var redisErr RedisError
+		if !errors.As(err, &redisErr) {
+			s.conn.Close()
+			s.conn = nil
+		}
+		return nil, err
+	}
+	return reply, nil
+}
+
+// connect 서버에 연결하고 인증/DB 선택 (호출자가 잠금 보유)
+func (s *RedisAttemptStore) connect() error {
+	conn, err := net.DialTimeout("tcp", s.Addr, redisTimeout)
+	if err != nil {
+		return fmt.Errorf("redis 연결 실패: %w", err)
+	}
+	s.conn = conn
+	s.reader = bufio.NewReader(conn)
+
+	if s.Password != "" {
+		if _, err := s.roundTrip([]string{"AUTH", s.Password}); err != nil {
+			s.conn.Close()
+			s.conn = nil
+			return err
+		}
+	}
+	if s.DB != 0 {
+		if _, err := s.roundTrip([]string{"SELECT", strconv.Itoa(s.DB)}); err != nil {
+			s.conn.Close()
+			s.conn = nil
+			return err
+		}
+	}
+	return nil
+}
+
+// roundTrip RESP 배열로 명령을 쓰고 응답 하나를 읽음
+func (s *RedisAttemptStore) roundTrip(args []string) (interface{}, error) {
+	if err := s.conn.SetDeadline(time.Now().Add(redisTimeout)); err != nil {
+		return nil, err
+	}
+
+	buf := []byte("*" + strconv.Itoa(len(args)) + "\r\n")
+	for _, arg := range args {
+		buf = append(buf, "$"+strconv.Itoa(len(arg))+"\r\n"...)
+		buf = append(buf, arg...)
+		buf = append(buf, "\r\n"...)
+	}
+	if _, err := s.conn.Write(buf); err != nil {
+		return nil, err
+	}
+
+	return readRESP(s.reader)
+}
+
+// readRESP RESP 응답 하나 해석 (문자열, 오류, 정수, 벌크 문자열, 배열)
+func readRESP(r *bufio.Reader) (interface{}, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	if len(line) < 3 || line[len(line)-2] != '\r' {
+		return nil, errors.New("redis: 잘못된 응답 형식")
+	}
+	payload := line[1 : len(line)-2]
+
+	switch line[0] {
+	case '+':
+		return payload, nil
+	case '-':
+		return nil, RedisError(payload)
+	case ':':
+		return strconv.ParseInt(payload, 10, 64)
+	case '$':
+		size, err := strconv.Atoi(payload)
+		if err != nil {
+			return nil, err
+		}
+		if size < 0 {
+			return nil, nil
+		}
+		data := make([]byte, size+2)
+		if _, err := io.ReadFull(r, data); err != nil {
+			return nil, err
+		}
+		return string(data[:size]), nil
+	case '*':
+		count, err := strconv.Atoi(payload)
+		if err != nil {
+			return nil, err
+		}
+		if count < 0 {
+			return nil, nil
+		}
+		items := make([]interface{}, count)
+		for i := range items {
+			if items[i], err = readRESP(r); err != nil {
+				return nil, err
+			}
+		}
+		return items, nil
+	}
+
+	return nil, fmt.Errorf("redis: 알 수 없는 응답 유형 %q", line[0])
+}
@@ -0,0 +1,235 @@
+package helper
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"backend/config"
+)
+
+// 결제 웹훅 이벤트 유형
+const (
+	BillingEventPaymentSucceeded = "payment.succeeded" // 결제 성공
+	BillingEventPaymentFailed    = "payment.failed"    // 결제 실패
+	BillingEventPaymentRefunded  = "payment.refunded"  // 환불
+)
+
+// BillingSignatureHeader 웹훅 서명 헤더 이름
+const BillingSignatureHeader = "Miniflix-Signature"
+
+// 결제 제공자 관련 오류
+var (
+	ErrInvalidWebhookSignature = errors.New("웹훅 서명이 유효하지 않습니다")
+	ErrInvalidWebhookPayload   = errors.New("웹훅 본문을 해석할 수 없습니다")
+)
+
+// CheckoutRequest 결제 세션 생성 요청
+type CheckoutRequest struct {
+	Email      string // 결제자 이메일
+	PlanCode   string // 요금제 코드
+	Amount     int    // 결제 금액
+	Currency   string // 통화 (ISO 4217)
+	SuccessURL string // 결제 완료 후 이동할 URL
+	CancelURL  string // 결제 취소 시 이동할 URL
+}
+
+// CheckoutSession 결제 제공자가 생성한 결제 세션
+type CheckoutSession struct {
+	ID        string    // 제공자의 세션 ID
+	URL       string    // 결제 페이지 URL
+	ExpiresAt time.Time // 세션 만료 시각
+}
+
+// BillingEvent 결제 제공자 웹훅 이벤트 (제공자별 형식을 공통 형식으로 변환한 것)
+type BillingEvent struct {
+	ID            string    // 이벤트 ID (중복 처리 방지에 사용)
+	Type          string    // 이벤트 유형
+	SessionID     string    // 결제 세션 ID
+	PaymentID     string    // 결제 ID (환불 이벤트는 환불 대상 결제)
+	Amount        int       // 결제/환불 금액
+	Currency      string    // 통화
+	FailureReason string    // 실패 사유 (결제 실패 이벤트)
+	CreatedAt     time.Time // 이벤트 발생 시각
+}
+
+// BillingProvider 결제 제공자 인터페이스
+type BillingProvider interface {
+	// Name 제공자 식별자 (DB에 저장되는 값)
+	Name() string
+	// CreateCheckoutSession 결제 페이지 세션 생성
+	CreateCheckoutSession(req CheckoutRequest) (*CheckoutSession, error)
+	// ParseWebhook 웹훅 서명을 검증하고 이벤트로 변환
+	ParseWebhook(payload []byte, signature string) (*BillingEvent, error)
+}
+
+// NewBillingProvider 설정의 결제 제공자 생성
+func NewBillingProvider(cfg config.BillingConfig) (BillingProvider, error) {
+	switch cfg.Provider {
+	case config.BillingProviderFake:
+		return &FakeBillingProvider{
+			Secret:    cfg.WebhookSecret,
+			Tolerance: time.Duration(cfg.WebhookToleranceSeconds) * time.Second,
+		}, nil
+	default:
+		return nil, fmt.Errorf("지원하지 않는 결제 제공자: %s", cfg.Provider)
+	}
+}
+
+// SignWebhookPayload 웹훅 본문 서명 헤더 값 생성 ("t=유닉스시각,v1=HMAC-SHA256")
+func SignWebhookPayload(secret string, payload []byte, at time.Time) string {
+	timestamp := strconv.FormatInt(at.Unix(), 10)
+	return "t=" + timestamp + ",v1=" + webhookMAC(secret, timestamp, payload)
+}
+
+// VerifyWebhookSignature 웹훅 서명 헤더 검증 (서명 시각이 tolerance 이상 차이 나면 재전송 공격으로 보고 거부)
+func VerifyWebhookSignature(secret string, payload []byte, header string, tolerance time.Duration, now time.Time) error {
+	var timestamp string
+	var signatures []string
+	for _, part := range strings.Split(header, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "t":
+			timestamp = value
+		case "v1":
+			signatures = append(signatures, value)
+		}
+	}
+	if timestamp == "" || len(signatures) == 0 {
+		return ErrInvalidWebhookSignature
+	}
+
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return ErrInvalidWebhookSignature
+	}
+	if diff := now.Sub(time.Unix(unix, 0)); diff > tolerance || diff < -tolerance {
+		return ErrInvalidWebhookSignature
+	}
+
+	// 키 교체 중에는 서명이 여러 개 올 수 있으므로 하나라도 맞으면 통과
+	expected := webhookMAC(secret, timestamp, payload)
+	for _, signature := range signatures {
+		if hmac.Equal([]byte(signature), []byte(expected)) {
+			return nil
+		}
+	}
+	return ErrInvalidWebhookSignature
+}
+
+// webhookMAC "시각.본문"의 HMAC-SHA256 값 (16진수)
+func webhookMAC(secret, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// FakeBillingProvider 외부 호출 없이 동작하는 모의 결제 제공자 (개발/테스트용)
+// 결제 페이지 없이 바로 SuccessURL로 이동시키며, 결제 결과는 Event로 만든 웹훅으로 전달
+type FakeBillingProvider struct {
+	Secret    string
+	Tolerance time.Duration
+}
+
+// fakeBillingWebhook 모의 결제 제공자의 웹훅 본문 형식
+type fakeBillingWebhook struct {
+	ID      string `json:"id"`
+	Type    string `json:"type"`
+	Created int64  `json:"created"`
+	Data    struct {
+		SessionID     string `json:"session_id"`
+		PaymentID     string `json:"payment_id"`
+		Amount        int    `json:"amount"`
+		Currency      string `json:"currency"`
+		FailureReason string `json:"failure_reason,omitempty"`
+	} `json:"data"`
+}
+
+// Name 제공자 식별자
+func (p *FakeBillingProvider) Name() string {
+	return config.BillingProviderFake
+}
+
+// CreateCheckoutSession 임의의 세션 ID로 결제 세션 생성
+func (p *FakeBillingProvider) CreateCheckoutSession(req CheckoutRequest) (*CheckoutSession, error) {
+	id, err := GenerateRandomToken(16)
+	if err != nil {
+		return nil, err
+	}
+	sessionID := "cs_fake_" + id
+
+	checkoutURL := req.SuccessURL
+	if parsed, err := url.Parse(req.SuccessURL); err == nil {
+		query := parsed.Query()
+		query.Set("session_id", sessionID)
+		parsed.RawQuery = query.Encode()
+		checkoutURL = parsed.String()
+	}
+
+	return &CheckoutSession{ID: sessionID, URL: checkoutURL, ExpiresAt: time.Now().Add(30 * time.Minute)}, nil
+}
+
+// ParseWebhook 서명을 검증하고 모의 웹훅 본문을 이벤트로 변환
+func (p *FakeBillingProvider) ParseWebhook(payload []byte, signature string) (*BillingEvent, error) {
+	if err := VerifyWebhookSignature(p.Secret, payload, signature, p.Tolerance, time.Now()); err != nil {
+		return nil, err
+	}
+
+	var webhook fakeBillingWebhook
+	if err := json.Unmarshal(payload, &webhook); err != nil || webhook.ID == "" || webhook.Type == "" {
+		return nil, ErrInvalidWebhookPayload
+	}
+
+	return &BillingEvent{
+		ID:            webhook.ID,
+		Type:          webhook.Type,
+		SessionID:     webhook.Data.SessionID,
+		PaymentID:     webhook.Data.PaymentID,
+		Amount:        webhook.Data.Amount,
+		Currency:      webhook.Data.Currency,
+		FailureReason: webhook.Data.FailureReason,
+		CreatedAt:     time.Unix(webhook.Created, 0),
+	}, nil
+}
+
+// Event 모의 웹훅 본문과 서명 헤더 생성 (테스트나 개발용 결제 완료 처리에서 사용)
+func (p *FakeBillingProvider) Event(event BillingEvent) ([]byte, string, error) {
+	if event.ID == "" {
+		id, err := GenerateRandomToken(16)
+		if err != nil {
+			return nil, "", err
+		}
+		event.ID = "evt_fake_" + id
+	}
+	if event.CreatedAt.IsZero() {
+		event.CreatedAt = time.Now()
+	}
+
+	var webhook fakeBillingWebhook
+	webhook.ID = event.ID
+	webhook.Type = event.Type
+	webhook.Created = event.CreatedAt.Unix()
+	webhook.Data.SessionID = event.SessionID
+	webhook.Data.PaymentID = event.PaymentID
+	webhook.Data.Amount = event.Amount
+	webhook.Data.Currency = event.Currency
+	webhook.Data.FailureReason = event.FailureReason
+
+	payload, err := json.Marshal(webhook)
+	if err != nil {
+		return nil, "", err
+	}
+	return payload, SignWebhookPayload(p.Secret, payload, time.Now()), nil
+}
@@ -0,0 +1,121 @@
+package helper
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"io/fs"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// BreachedPasswordSource 유출 비밀번호 해시 조회 소스 (k-익명성 방식)
+// 비밀번호 SHA-1 해시의 앞 5자리만 전달하고, 같은 접두사의 후보 목록을 받아 나머지는 로컬에서 비교
+type BreachedPasswordSource interface {
+	// Range 해시 접두사(대문자 16진수 5자리)에 해당하는 나머지 35자리와 유출 횟수 반환
+	Range(prefix string) (map[string]int64, error)
+}
+
+// breachedPrefixLength 조회에 사용하는 해시 접두사 길이
+const breachedPrefixLength = 5
+
+// PasswordBreachCount 비밀번호가 유출 목록에 있으면 유출 횟수 반환 (없으면 0)
+func PasswordBreachCount(source BreachedPasswordSource, password string) (int64, error) {
+	sum := sha1.Sum([]byte(password))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+
+	candidates, err := source.Range(hash[:breachedPrefixLength])
+	if err != nil {
+		return 0, err
+	}
+	return candidates[hash[breachedPrefixLength:]], nil
+}
+
+// FileBreachedPasswordSource 로컬 파일의 유출 비밀번호 목록
+// Pwned Passwords 파일처럼 한 줄에 "SHA1해시[:횟수]" 형식이며, 처음 조회할 때 접두사별로 읽어 둠
+type FileBreachedPasswordSource struct {
+	Path string
+
+	once   sync.Once
+	ranges map[string]map[string]int64
+	err    error
+}
+
+// breachedSources 파일 경로별 소스 캐시 (목록은 한 번만 읽음)
+var (
+	breachedSourcesMu sync.Mutex
+	breachedSources   = map[string]*FileBreachedPasswordSource{}
+)
+
+// GetBreachedPasswordSource 파일 경로에 해당하는 캐시된 소스 반환 (경로가 비어 있으면 nil)
+func GetBreachedPasswordSource(path string) BreachedPasswordSource {
+	if path == "" {
+		return nil
+	}
+
+	breachedSourcesMu.Lock()
+	defer breachedSourcesMu.Unlock()
+
+	source, ok := breachedSources[path]
+	if !ok {
+		source = &FileBreachedPasswordSource{Path: path}
+		breachedSources[path] = source
+	}
+	return source
+}
+
+// Range 접두사에 해당하는 후보 목록 반환 (파일이 없으면 빈 목록)
+func (s *FileBreachedPasswordSource) Range(prefix string) (map[string]int64, error) {
+	s.once.Do(s.load)
+	if s.err != nil {
+		return nil, s.err
+	}
+	return s.ranges[strings.ToUpper(prefix)], nil
+}
+
+// load 파일을 읽어 접두사별로 분류
+func (s *FileBreachedPasswordSource) load() {
+	s.ranges = map[string]map[string]int64{}
+
+	file, err := os.Open(s.Path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			log.Printf("유출 비밀번호 목록 파일이 없어 검사를 생략합니다: %s", s.Path)
+			return
+		}
+		s.err = err
+		return
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		hash, countText, _ := strings.Cut(line, ":")
+		hash = strings.ToUpper(hash)
+		if len(hash) != sha1.Size*2 {
+			continue
+		}
+		count := int64(1)
+		if countText != "" {
+			if parsed, err := strconv.ParseInt(countText, 10, 64); err == nil && parsed > 0 {
+				count = parsed
+			}
+		}
+
+		prefix := hash[:breachedPrefixLength]
+		if s.ranges[prefix] == nil {
+			s.ranges[prefix] = map[string]int64{}
+		}
+		s.ranges[prefix][hash[breachedPrefixLength:]] = count
+	}
+	s.err = scanner.Err()
+}
@@ -0,0 +1,270 @@
+package helper
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"io/fs"
+	"log"
+	"net/http"
+	"net/netip"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"backend/config"
+)
+
+// countryCodePattern 국가 코드 형식 (ISO 3166-1 alpha-2)
+var countryCodePattern = regexp.MustCompile(`^[A-Z]{2}$`)
+
+// geoIPRange 국가 코드가 할당된 IP 대역
+type geoIPRange struct {
+	start   netip.Addr
+	end     netip.Addr
+	country string
+}
+
+// GeoIPDatabase IP 대역별 국가 코드 데이터베이스 (시작 IP 순으로 정렬해 이진 탐색)
+type GeoIPDatabase struct {
+	ranges []geoIPRange
+}
+
+// ParseGeoIPCSV IP 대역별 국가 코드 CSV 읽기
+// 한 줄에 "시작IP,끝IP,국가코드"(DB-IP 국가 CSV 형식) 또는 "CIDR,국가코드" 형식이며,
+// #으로 시작하는 줄과 해석할 수 없는 줄(헤더 등)은 건너뜀
+func ParseGeoIPCSV(r io.Reader) (*GeoIPDatabase, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	db := &GeoIPDatabase{}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var ipRange geoIPRange
+		var ok bool
+		switch len(record) {
+		case 2:
+			ipRange, ok = parseGeoIPPrefix(record[0], record[1])
+		case 3:
+			ipRange, ok = parseGeoIPRange(record[0], record[1], record[2])
+		}
+		if ok {
+			db.ranges = append(db.ranges, ipRange)
+		}
+	}
+
+	sort.Slice(db.ranges, func(i, j int) bool {
+		return db.ranges[i].start.Less(db.ranges[j].start)
+	})
+	return db, nil
+}
+
+// parseGeoIPPrefix "CIDR,국가코드" 형식의 줄 해석
+func parseGeoIPPrefix(cidr, country string) (geoIPRange, bool) {
+	prefix, err := netip.ParsePrefix(strings.TrimSpace(cidr))
+	if err != nil {
+		return geoIPRange{}, false
+	}
+	prefix = prefix.Masked()
+
+	// 대역의 마지막 주소 계산 (호스트 비트를 모두 1로)
+	bytes := prefix.Addr().AsSlice()
+	for bit := prefix.Bits(); bit < len(bytes)*8; bit++ {
+		bytes[bit/8] |= 0x80 >> (bit % 8)
+	}
+	end, _ := netip.AddrFromSlice(bytes)
+	return parseGeoIPRange(prefix.Addr().String(), end.String(), country)
+}
+
+// parseGeoIPRange "시작IP,끝IP,국가코드" 형식의 줄 해석
+func parseGeoIPRange(startIP, endIP, country string) (geoIPRange, bool) {
+	start, err := netip.ParseAddr(strings.TrimSpace(startIP))
+	if err != nil {
+		return geoIPRange{}, false
+	}
+	end, err := netip.ParseAddr(strings.TrimSpace(endIP))
+	if err != nil {
+		return geoIPRange{}, false
+	}
+	start, end = start.Unmap(), end.Unmap()
+	country = strings.ToUpper(strings.TrimSpace(country))
+	if start.BitLen() != end.BitLen() || end.Less(start) || !countryCodePattern.MatchString(country) {
+		return geoIPRange{}, false
+	}
+	return geoIPRange{start: start, end: end, country: country}, true
+}
+
+// Country IP가 속한 대역의 국가 코드 반환 (찾지 못하면 빈 문자열)
+func (d *GeoIPDatabase) Country(ip netip.Addr) string {
+	if d == nil || !ip.IsValid() {
+		return ""
+	}
+	ip = ip.Unmap()
+
+	// 시작 IP가 ip보다 큰 첫 대역의 바로 앞 대역이 후보
+	i := sort.Search(len(d.ranges), func(i int) bool {
+		return ip.Less(d.ranges[i].start)
+	})
+	if i == 0 {
+		return ""
+	}
+	candidate := d.ranges[i-1]
+	if candidate.end.Less(ip) {
+		return ""
+	}
+	return candidate.country
+}
+
+// geoIPDatabases 파일 경로별 데이터베이스 캐시 (파일은 한 번만 읽음)
+var (
+	geoIPDatabasesMu sync.Mutex
+	geoIPDatabases   = map[string]*GeoIPDatabase{}
+)
+
+// GetGeoIPDatabase 파일 경로에 해당하는 캐시된 데이터베이스 반환
+// 파일이 없거나 읽을 수 없으면 경고를 남기고 빈 데이터베이스 반환 (모든 요청이 기본 국가로 처리됨)
+func GetGeoIPDatabase(path string) *GeoIPDatabase {
+	geoIPDatabasesMu.Lock()
+	defer geoIPDatabasesMu.Unlock()
+
+	if db, ok := geoIPDatabases[path]; ok {
+		return db
+	}
+
+	db, err := loadGeoIPDatabase(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			log.Printf("IP 국가 데이터베이스 파일이 없어 기본 국가를 사용합니다: %s", path)
+		} else {
+			log.Printf("IP 국가 데이터베이스 읽기 실패: %v", err)
+		}
+		db = &GeoIPDatabase{}
+	}
+	geoIPDatabases[path] = db
+	return db
+}
+
+// loadGeoIPDatabase 파일에서 데이터베이스 읽기
+func loadGeoIPDatabase(path string) (*GeoIPDatabase, error) {
+	if path == "" {
+		return &GeoIPDatabase{}, nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return ParseGeoIPCSV(file)
+}
+
+// GeoResolver 요청의 클라이언트 IP와 접속 국가 확인
+type GeoResolver struct {
+	Database       *GeoIPDatabase
+	DefaultCountry string
+	TrustedProxies []netip.Prefix
+	ClientIPHeader string
+	CountryHeader  string
+}
+
+// NewGeoResolver 설정으로 GeoResolver 생성 (형식이 잘못된 프록시 주소는 경고 후 무시)
+func NewGeoResolver(cfg config.GeoConfig) *GeoResolver {
+	resolver := &GeoResolver{
+		Database:       GetGeoIPDatabase(cfg.DatabaseFile),
+		DefaultCountry: strings.ToUpper(cfg.DefaultCountry),
+		ClientIPHeader: cfg.ClientIPHeader,
+		CountryHeader:  cfg.CountryHeader,
+	}
+
+	for _, proxy := range cfg.TrustedProxies {
+		proxy = strings.TrimSpace(proxy)
+		if proxy == "" {
+			continue
+		}
+		if prefix, err := netip.ParsePrefix(proxy); err == nil {
+			resolver.TrustedProxies = append(resolver.TrustedProxies, prefix.Masked())
+			continue
+		}
+		if addr, err := netip.ParseAddr(proxy); err == nil {
+			addr = addr.Unmap()
+			resolver.TrustedProxies = append(resolver.TrustedProxies, netip.PrefixFrom(addr, addr.BitLen()))
+			continue
+		}
+		log.Printf("신뢰 프록시 주소 형식 오류로 무시합니다: %s", proxy)
+	}
+	return resolver
+}
+
+// isTrustedProxy 신뢰하는 프록시 주소인지 확인
+func (r *GeoResolver) isTrustedProxy(addr netip.Addr) bool {
+	for _, prefix := range r.TrustedProxies {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// ClientIP 요청의 클라이언트 IP
+// 요청을 보낸 주소가 신뢰하는 프록시일 때만 헤더를 오른쪽부터 읽어 신뢰하지 않는 첫 주소를 사용 (헤더 위조 방지)
+func (r *GeoResolver) ClientIP(req *http.Request) netip.Addr {
+	peer := remoteAddr(req.RemoteAddr)
+	if !peer.IsValid() || !r.isTrustedProxy(peer) || r.ClientIPHeader == "" {
+		return peer
+	}
+
+	client := peer
+	hops := strings.Split(req.Header.Get(r.ClientIPHeader), ",")
+	for i := len(hops) - 1; i >= 0; i-- {
+		addr, err := netip.ParseAddr(strings.TrimSpace(hops[i]))
+		if err != nil {
+			break
+		}
+		client = addr.Unmap()
+		if !r.isTrustedProxy(client) {
+			break
+		}
+	}
+	return client
+}
+
+// Country 요청의 접속 국가 코드 (확인할 수 없으면 기본 국가)
+// 신뢰하는 프록시가 국가 코드 헤더를 전달하면 IP 조회보다 우선
+func (r *GeoResolver) Country(req *http.Request) string {
+	if r.CountryHeader != "" {
+		if peer := remoteAddr(req.RemoteAddr); peer.IsValid() && r.isTrustedProxy(peer) {
+			country := strings.ToUpper(strings.TrimSpace(req.Header.Get(r.CountryHeader)))
+			if countryCodePattern.MatchString(country) {
+				return country
+			}
+		}
+	}
+
+	if country := r.Database.Country(r.ClientIP(req)); country != "" {
+		return country
+	}
+	return r.DefaultCountry
+}
+
+// remoteAddr "IP:포트" 형식의 요청 주소에서 IP 추출
+func remoteAddr(address string) netip.Addr {
+	if addrPort, err := netip.ParseAddrPort(address); err == nil {
+		return addrPort.Addr().Unmap()
+	}
+	if addr, err := netip.ParseAddr(address); err == nil {
+		return addr.Unmap()
+	}
+	return netip.Addr{}
+}
@@ -4,29 +4,56 @@ import (
 	"errors"
 	"time"
 
-	"github.com/golang-jwt/jwt/v5"
 	"backend/config"
+	"github.com/golang-jwt/jwt/v5"
 )
 
 // JWTClaims JWT 클레임 구조체
 type JWTClaims struct {
-	UserID int64  `json:"user_id"`
-	Name   string `json:"name"`
-	Email  string `json:"email"`
+	UserID    int64  `json:"user_id"`
+	ProfileID int64  `json:"profile_id"` // 선택된 프로필 ID
+	Name      string `json:"name"`
+	Email     string `json:"email"`
 	jwt.RegisteredClaims
 }
 
-// GenerateToken JWT 토큰 생성
-func GenerateToken(userID int64, name, email string, cfg *config.Config) (string, error) {
+// AccessToken 발급된 액세스 토큰 정보
+type AccessToken struct {
+	Token     string    // 서명된 JWT 문자열
+	ID        string    // 토큰 고유 ID (jti, 폐기 시 사용)
+	ExpiresAt time.Time // 만료 시각
+}
+
+// GenerateToken JWT 토큰 생성 (시청 기록/찜 등은 profileID 기준으로 처리됨)
+func GenerateToken(userID, profileID int64, name, email string, cfg *config.Config) (string, error) {
+	accessToken, err := GenerateAccessToken(userID, profileID, name, email, cfg)
+	if err != nil {
+		return "", err
+	}
+	return accessToken.Token, nil
+}
+
+// GenerateAccessToken 고유 ID(jti)를 포함한 단기 액세스 토큰 생성
+func GenerateAccessToken(userID, profileID int64, name, email string, cfg *config.Config) (*AccessToken, error) {
+	jti, err := GenerateRandomToken(16)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(cfg.AccessTokenTTL())
+
 	// 클레임 생성
 	claims := JWTClaims{
-		UserID: userID,
-		Name:   name,
-		Email:  email,
+		UserID:    userID,
+		ProfileID: profileID,
+		Name:      name,
+		Email:     email,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour * time.Duration(cfg.JWTExpireHours))),
-			IssuedAt:  jwt.NewNumericDate(time.Now()),
-			NotBefore: jwt.NewNumericDate(time.Now()),
+			ID:        jti,
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
 			Issuer:    "miniflix",
 			Subject:   email,
 		},
@@ -38,10 +65,10 @@ func GenerateToken(userID int64, name, email string, cfg *config.Config) (string
 	// 서명
 	tokenString, err := token.SignedString([]byte(cfg.JWTSecret))
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return tokenString, nil
+	return &AccessToken{Token: tokenString, ID: jti, ExpiresAt: expiresAt}, nil
 }
 
 // ValidateToken JWT 토큰 유효성 검증
@@ -59,10 +86,133 @@ func ValidateToken(tokenString string, cfg *config.Config) (*JWTClaims, error) {
 		return nil, err
 	}
 
-	// 클레임 추출
-	if claims, ok := token.Claims.(*JWTClaims); ok && token.Valid {
+	// 클레임 추출 (폐기 확인을 위해 jti가 없는 토큰은 거부)
+	if claims, ok := token.Claims.(*JWTClaims); ok && token.Valid && claims.ID != "" {
 		return claims, nil
 	}
 
 	return nil, errors.New("유효하지 않은 토큰")
-} 
\ No newline at end of file
+}
+
+// TwoFactorChallengeTTL 2단계 인증 대기 토큰 유효 기간
+const TwoFactorChallengeTTL = 5 * time.Minute
+
+// twoFactorChallengeClaims 2단계 인증 대기 토큰 클레임
+type twoFactorChallengeClaims struct {
+	UserID int64 `json:"user_id"`
+	jwt.RegisteredClaims
+}
+
+// challengeSigningKey 2단계 인증 대기 토큰 서명 키 (액세스 토큰으로 사용할 수 없도록 별도 키 사용)
+func challengeSigningKey(cfg *config.Config) []byte {
+	return []byte(cfg.JWTSecret + ":two-factor-challenge")
+}
+
+// GenerateTwoFactorChallenge 비밀번호 확인 후 2단계 인증 코드 확인 전까지 사용하는 대기 토큰 생성
+// 토큰마다 고유 ID(jti)를 부여하여 토큰별 시도 횟수 제한과 재사용 방지에 사용
+func GenerateTwoFactorChallenge(userID int64, cfg *config.Config) (string, error) {
+	jti, err := GenerateRandomToken(16)
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	claims := twoFactorChallengeClaims{
+		UserID: userID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(now.Add(TwoFactorChallengeTTL)),
+			IssuedAt:  jwt.NewNumericDate(now),
+			Issuer:    "miniflix",
+			ID:        jti,
+		},
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(challengeSigningKey(cfg))
+}
+
+// ValidateTwoFactorChallenge 2단계 인증 대기 토큰 검증 후 사용자 ID와 토큰 ID 반환
+func ValidateTwoFactorChallenge(tokenString string, cfg *config.Config) (int64, string, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &twoFactorChallengeClaims{}, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, errors.New("유효하지 않은 서명 방식")
+		}
+		return challengeSigningKey(cfg), nil
+	})
+	if err != nil {
+		return 0, "", err
+	}
+
+	if claims, ok := token.Claims.(*twoFactorChallengeClaims); ok && token.Valid && claims.ID != "" {
+		return claims.UserID, claims.ID, nil
+	}
+	return 0, "", errors.New("유효하지 않은 토큰")
+}
+
+// OIDCStateTTL 소셜 로그인 상태 토큰 유효 기간
+const OIDCStateTTL = 10 * time.Minute
+
+// OIDCState 소셜 로그인 요청 상태 (콜백에서 state/nonce 확인에 사용)
+type OIDCState struct {
+	Provider string // 제공자 이름
+	State    string // CSRF 방지용 state 값
+	Nonce    string // ID 토큰 재사용 방지용 nonce 값
+}
+
+// oidcStateClaims 소셜 로그인 상태 토큰 클레임
+type oidcStateClaims struct {
+	Provider string `json:"provider"`
+	State    string `json:"state"`
+	Nonce    string `json:"nonce"`
+	jwt.RegisteredClaims
+}
+
+// oidcStateSigningKey 소셜 로그인 상태 토큰 서명 키 (액세스 토큰으로 사용할 수 없도록 별도 키 사용)
+func oidcStateSigningKey(cfg *config.Config) []byte {
+	return []byte(cfg.JWTSecret + ":oidc-state")
+}
+
+// NewOIDCState 무작위 state/nonce로 새 소셜 로그인 상태 생성
+func NewOIDCState(provider string) (*OIDCState, error) {
+	state, err := GenerateRandomToken(16)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := GenerateRandomToken(16)
+	if err != nil {
+		return nil, err
+	}
+	return &OIDCState{Provider: provider, State: state, Nonce: nonce}, nil
+}
+
+// GenerateOIDCStateToken 소셜 로그인 상태를 서명된 토큰으로 생성 (클라이언트가 콜백 때까지 보관)
+func GenerateOIDCStateToken(state *OIDCState, cfg *config.Config) (string, error) {
+	now := time.Now()
+	claims := oidcStateClaims{
+		Provider: state.Provider,
+		State:    state.State,
+		Nonce:    state.Nonce,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(now.Add(OIDCStateTTL)),
+			IssuedAt:  jwt.NewNumericDate(now),
+			Issuer:    "miniflix",
+		},
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(oidcStateSigningKey(cfg))
+}
+
+// ValidateOIDCStateToken 소셜 로그인 상태 토큰 검증 후 상태 반환
+func ValidateOIDCStateToken(tokenString string, cfg *config.Config) (*OIDCState, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &oidcStateClaims{}, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, errors.New("유효하지 않은 서명 방식")
+		}
+		return oidcStateSigningKey(cfg), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if claims, ok := token.Claims.(*oidcStateClaims); ok && token.Valid {
+		return &OIDCState{Provider: claims.Provider, State: claims.State, Nonce: claims.Nonce}, nil
+	}
+	return nil, errors.New("유효하지 않은 토큰")
+}
@@ -0,0 +1,100 @@
+package helper
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// maxLocaleChain 번역 조회에 사용하는 최대 언어 수 (쿼리 인자 수 제한)
+const maxLocaleChain = 6
+
+// localePattern 언어 태그 형식 (BCP 47의 언어[-스크립트][-지역] 부분)
+var localePattern = regexp.MustCompile(`^[a-zA-Z]{2,3}(-[a-zA-Z0-9]{2,8})*$`)
+
+// NormalizeLocale 언어 태그 정규화 (en_us → en-US, zh-hant-tw → zh-Hant-TW, 형식이 잘못되면 빈 문자열)
+func NormalizeLocale(tag string) string {
+	tag = strings.ReplaceAll(strings.TrimSpace(tag), "_", "-")
+	if !localePattern.MatchString(tag) {
+		return ""
+	}
+
+	parts := strings.Split(tag, "-")
+	parts[0] = strings.ToLower(parts[0])
+	for i := 1; i < len(parts); i++ {
+		switch len(parts[i]) {
+		case 2:
+			parts[i] = strings.ToUpper(parts[i])
+		case 4:
+			parts[i] = strings.ToUpper(parts[i][:1]) + strings.ToLower(parts[i][1:])
+		default:
+			parts[i] = strings.ToLower(parts[i])
+		}
+	}
+	return strings.Join(parts, "-")
+}
+
+// ParseAcceptLanguage Accept-Language 헤더를 선호도(q) 순 언어 태그 목록으로 변환
+// q=0인 언어와 *는 제외하고, 선호도가 같으면 헤더 순서를 유지
+func ParseAcceptLanguage(header string) []string {
+	type weightedLocale struct {
+		locale string
+		q      float64
+	}
+
+	var weighted []weightedLocale
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		locale := NormalizeLocale(tag)
+		if locale == "" {
+			continue
+		}
+
+		q := 1.0
+		for _, param := range strings.Split(params, ";") {
+			key, value, ok := strings.Cut(strings.TrimSpace(param), "=")
+			if ok && strings.TrimSpace(key) == "q" {
+				if parsed, err := strconv.ParseFloat(strings.TrimSpace(value), 64); err == nil {
+					q = parsed
+				}
+			}
+		}
+		if q <= 0 {
+			continue
+		}
+		weighted = append(weighted, weightedLocale{locale: locale, q: q})
+	}
+
+	sort.SliceStable(weighted, func(i, j int) bool {
+		return weighted[i].q > weighted[j].q
+	})
+
+	locales := make([]string, 0, len(weighted))
+	for _, w := range weighted {
+		locales = append(locales, w.locale)
+	}
+	return locales
+}
+
+// LocaleFallbackChain 선호 언어 순서로 번역 조회 순서 생성
+// 지역/스크립트가 붙은 언어는 바로 뒤에 상위 언어를 추가 (en-US → en-US, en), 중복은 제거
+func LocaleFallbackChain(preferred ...string) []string {
+	chain := []string{}
+	seen := map[string]bool{}
+	for _, tag := range preferred {
+		locale := NormalizeLocale(tag)
+		for locale != "" && len(chain) < maxLocaleChain {
+			if !seen[locale] {
+				seen[locale] = true
+				chain = append(chain, locale)
+			}
+			cut := strings.LastIndex(locale, "-")
+			if cut < 0 {
+				break
+			}
+			locale = locale[:cut]
+		}
+	}
+	return chain
+}
@@ -0,0 +1,110 @@
+package helper
+
+import (
+	"fmt"
+	"log"
+	"mime"
+	"net"
+	"net/smtp"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"backend/config"
+)
+
+// MailMessage 발송할 메일
+type MailMessage struct {
+	To      string // 수신 주소
+	Subject string // 제목
+	Body    string // 본문 (text/plain)
+}
+
+// Mailer 메일 발송 인터페이스
+type Mailer interface {
+	Send(msg MailMessage) error
+}
+
+// NewMailer 설정의 발송 방식에 맞는 Mailer 생성
+func NewMailer(cfg config.MailConfig) Mailer {
+	switch cfg.Driver {
+	case config.MailDriverSMTP:
+		return &SMTPMailer{Config: cfg}
+	case config.MailDriverFile:
+		return &FileMailer{From: cfg.From, Path: cfg.FilePath}
+	default:
+		return &FileMailer{From: cfg.From}
+	}
+}
+
+// SMTPMailer SMTP 서버로 메일 발송
+type SMTPMailer struct {
+	Config config.MailConfig
+}
+
+// Send SMTP 서버로 메일 발송 (사용자가 설정된 경우 PLAIN 인증 사용)
+func (m *SMTPMailer) Send(msg MailMessage) error {
+	var auth smtp.Auth
+	if m.Config.SMTPUser != "" {
+		auth = smtp.PlainAuth("", m.Config.SMTPUser, m.Config.SMTPPassword, m.Config.SMTPHost)
+	}
+
+	addr := net.JoinHostPort(m.Config.SMTPHost, m.Config.SMTPPort)
+	return smtp.SendMail(addr, auth, mailAddress(m.Config.From), []string{msg.To}, formatMail(m.Config.From, msg))
+}
+
+// FileMailer 메일을 파일에 기록 (Path가 비어 있으면 로그로 출력, 개발/테스트용)
+type FileMailer struct {
+	From string
+	Path string
+}
+
+// fileMailerMu 같은 파일에 동시에 기록하지 않도록 보호
+var fileMailerMu sync.Mutex
+
+// Send 메일 내용을 파일 끝에 추가하거나 로그로 출력
+func (m *FileMailer) Send(msg MailMessage) error {
+	content := formatMail(m.From, msg)
+	if m.Path == "" {
+		log.Printf("메일 발송 (로그):\n%s", content)
+		return nil
+	}
+
+	fileMailerMu.Lock()
+	defer fileMailerMu.Unlock()
+
+	file, err := os.OpenFile(m.Path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = file.Write(append(content, '\n'))
+	return err
+}
+
+// formatMail RFC 5322 형식의 메일 메시지 생성
+func formatMail(from string, msg MailMessage) []byte {
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", from)
+	fmt.Fprintf(&b, "To: %s\r\n", msg.To)
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.BEncoding.Encode("UTF-8", msg.Subject))
+	fmt.Fprintf(&b, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(msg.Body)
+	b.WriteString("\r\n")
+	return []byte(b.String())
+}
+
+// mailAddress "이름 <주소>" 형식에서 주소만 추출
+func mailAddress(from string) string {
+	if start := strings.LastIndex(from, "<"); start >= 0 {
+		if end := strings.LastIndex(from, ">"); end > start {
+			return from[start+1 : end]
+		}
+	}
+	return from
+}
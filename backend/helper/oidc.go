@@ -0,0 +1,493 @@
+package helper
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"backend/config"
+)
+
+// ErrInvalidIDToken ID 토큰 검증 실패
+var ErrInvalidIDToken = errors.New("유효하지 않은 ID 토큰")
+
+// OIDC 클라이언트 동작 설정
+const (
+	oidcHTTPTimeout         = 10 * time.Second
+	oidcDiscoveryTTL        = time.Hour       // 디스커버리 문서 재조회 주기
+	oidcJWKSRefreshInterval = time.Minute     // 알 수 없는 키 ID로 서명 키를 다시 받는 최소 간격
+	oidcMaxResponseSize     = 1 << 20         // 제공자 응답 최대 크기
+	oidcClockSkew           = 1 * time.Minute // ID 토큰 시각 검증 허용 오차
+)
+
+// oidcDefaultScopes 범위가 설정되지 않은 제공자에 요청하는 기본 범위
+var oidcDefaultScopes = []string{"openid", "email", "profile"}
+
+// oidcSigningMethods ID 토큰 서명에 허용하는 알고리즘 (비대칭 키만 허용)
+var oidcSigningMethods = []string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512"}
+
+// OIDCDiscovery 디스커버리 문서 (/.well-known/openid-configuration) 중 사용하는 항목
+type OIDCDiscovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	UserinfoEndpoint      string `json:"userinfo_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// OIDCIdentity ID 토큰과 userinfo에서 확인한 제공자 계정 정보
+type OIDCIdentity struct {
+	Subject       string // 제공자 내 사용자 식별자 (sub)
+	Email         string // 이메일
+	EmailVerified bool   // 제공자가 이메일 소유를 확인했는지 여부
+	Name          string // 표시 이름
+}
+
+// OIDCClient 디스커버리 문서 기반 OpenID Connect 인가 코드 흐름 클라이언트
+type OIDCClient struct {
+	Config     config.OIDCProviderConfig
+	HTTPClient *http.Client
+
+	mu            sync.Mutex
+	discovery     *OIDCDiscovery
+	discoveredAt  time.Time
+	keys          map[string]interface{}
+	keysFetchedAt time.Time
+}
+
+// oidcClients 제공자별 클라이언트 캐시 (디스커버리 문서와 서명 키 재사용)
+var (
+	oidcClientsMu sync.Mutex
+	oidcClients   = map[string]*OIDCClient{}
+)
+
+// NewOIDCClient 새 OIDCClient 생성
+func NewOIDCClient(provider config.OIDCProviderConfig) *OIDCClient {
+	return &OIDCClient{
+		Config:     provider,
+		HTTPClient: &http.Client{Timeout: oidcHTTPTimeout},
+	}
+}
+
+// GetOIDCClient 제공자 설정에 해당하는 캐시된 OIDCClient 반환 (없으면 생성)
+func GetOIDCClient(provider config.OIDCProviderConfig) *OIDCClient {
+	key := provider.Name + "|" + provider.Issuer + "|" + provider.ClientID
+
+	oidcClientsMu.Lock()
+	defer oidcClientsMu.Unlock()
+
+	client, ok := oidcClients[key]
+	if !ok {
+		client = NewOIDCClient(provider)
+		oidcClients[key] = client
+	}
+	return client
+}
+
+// Discover 디스커버리 문서 조회 (oidcDiscoveryTTL 동안 캐시)
+func (c *OIDCClient) Discover(ctx context.Context) (*OIDCDiscovery, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.discovery != nil && time.Since(c.discoveredAt) < oidcDiscoveryTTL {
+		return c.discovery, nil
+	}
+
+	var doc OIDCDiscovery
+	issuer := strings.TrimSuffix(c.Config.Issuer, "/")
+	if err := c.getJSON(ctx, issuer+"/.well-known/openid-configuration", "", &doc); err != nil {
+		return nil, fmt.Errorf("디스커버리 문서 조회 실패: %w", err)
+	}
+	if strings.TrimSuffix(doc.Issuer, "/") != issuer {
+		return nil, fmt.Errorf("디스커버리 문서의 발급자 불일치: %s", doc.Issuer)
+	}
+	if doc.AuthorizationEndpoint == "" || doc.TokenEndpoint == "" || doc.JWKSURI == "" {
+		return nil, errors.New("디스커버리 문서에 필수 엔드포인트가 없습니다")
+	}
+
+	c.discovery = &doc
+	c.discoveredAt = time.Now()
+	return c.discovery, nil
+}
+
+// AuthCodeURL 제공자 로그인 페이지 URL 생성
+func (c *OIDCClient) AuthCodeURL(ctx context.Context, state, nonce string) (string, error) {
+	doc, err := c.Discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	authURL, err := url.Parse(doc.AuthorizationEndpoint)
+	if err != nil {
+		return "", err
+	}
+
+	scopes := c.Config.Scopes
+	if len(scopes) == 0 {
+		scopes = oidcDefaultScopes
+	}
+
+	query := authURL.Query()
+	query.Set("response_type", "code")
+	query.Set("client_id", c.Config.ClientID)
+	query.Set("redirect_uri", c.Config.RedirectURL)
+	query.Set("scope", strings.Join(scopes, " "))
+	query.Set("state", state)
+	query.Set("nonce", nonce)
+	authURL.RawQuery = query.Encode()
+
+	return authURL.String(), nil
+}
+
+// Authenticate 인가 코드를 토큰으로 교환하고 ID 토큰(nonce 포함)을 검증하여 계정 정보 반환
+// ID 토큰에 이메일 또는 이메일 인증 여부가 없으면 userinfo 엔드포인트에서 보완
+func (c *OIDCClient) Authenticate(ctx context.Context, code, nonce string) (*OIDCIdentity, error) {
+	tokens, err := c.exchange(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	claims, err := c.parseIDToken(ctx, tokens.IDToken, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	identity := claims.identity()
+	if claims.Email == "" || claims.EmailVerified == nil {
+		if err := c.mergeUserInfo(ctx, tokens.AccessToken, identity); err != nil {
+			return nil, err
+		}
+	}
+
+	return identity, nil
+}
+
+// oidcTokenResponse 토큰 엔드포인트 응답
+type oidcTokenResponse struct {
+	AccessToken      string `json:"access_token"`
+	IDToken          string `json:"id_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// exchange 인가 코드를 토큰으로 교환 (client_secret_post 방식)
+func (c *OIDCClient) exchange(ctx context.Context, code string) (*oidcTokenResponse, error) {
+	doc, err := c.Discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {c.Config.RedirectURL},
+		"client_id":     {c.Config.ClientID},
+		"client_secret": {c.Config.ClientSecret},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, doc.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("토큰 교환 요청 실패: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var tokens oidcTokenResponse
+	if err := json.NewDecoder(io.LimitReader(resp.Body, oidcMaxResponseSize)).Decode(&tokens); err != nil {
+		return nil, fmt.Errorf("토큰 응답 해석 실패 (%s): %w", resp.Status, err)
+	}
+	if resp.StatusCode != http.StatusOK || tokens.Error != "" {
+		return nil, fmt.Errorf("토큰 교환 실패 (%s): %s %s", resp.Status, tokens.Error, tokens.ErrorDescription)
+	}
+	if tokens.IDToken == "" {
+		return nil, errors.New("토큰 응답에 ID 토큰이 없습니다")
+	}
+
+	return &tokens, nil
+}
+
+// flexibleBool 불리언 또는 "true"/"false" 문자열로 전달되는 클레임
+type flexibleBool bool
+
+// UnmarshalJSON 불리언과 문자열 형식 모두 해석
+func (b *flexibleBool) UnmarshalJSON(data []byte) error {
+	value, err := strconv.ParseBool(strings.Trim(string(data), `"`))
+	if err != nil {
+		return err
+	}
+	*b = flexibleBool(value)
+	return nil
+}
+
+// oidcIDTokenClaims ID 토큰 클레임
+type oidcIDTokenClaims struct {
+	Nonce         string        `json:"nonce"`
+	Email         string        `json:"email"`
+	EmailVerified *flexibleBool `json:"email_verified"`
+	Name          string        `json:"name"`
+	Nickname      string        `json:"nickname"`
+	jwt.RegisteredClaims
+}
+
+// VerifyIDToken ID 토큰의 서명, 발급자, 대상, 만료 시각 및 nonce 검증
+func (c *OIDCClient) VerifyIDToken(ctx context.Context, rawIDToken, nonce string) (*OIDCIdentity, error) {
+	claims, err := c.parseIDToken(ctx, rawIDToken, nonce)
+	if err != nil {
+		return nil, err
+	}
+	return claims.identity(), nil
+}
+
+// parseIDToken ID 토큰을 검증하고 클레임 반환
+func (c *OIDCClient) parseIDToken(ctx context.Context, rawIDToken, nonce string) (*oidcIDTokenClaims, error) {
+	doc, err := c.Discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := &oidcIDTokenClaims{}
+	_, err = jwt.ParseWithClaims(rawIDToken, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		return c.signingKey(ctx, doc.JWKSURI, kid)
+	},
+		jwt.WithValidMethods(oidcSigningMethods),
+		jwt.WithIssuer(doc.Issuer),
+		jwt.WithAudience(c.Config.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(oidcClockSkew),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidIDToken, err)
+	}
+
+	if claims.Nonce == "" || subtle.ConstantTimeCompare([]byte(claims.Nonce), []byte(nonce)) != 1 {
+		return nil, fmt.Errorf("%w: nonce 불일치", ErrInvalidIDToken)
+	}
+	if claims.Subject == "" {
+		return nil, fmt.Errorf("%w: sub 클레임 없음", ErrInvalidIDToken)
+	}
+	return claims, nil
+}
+
+// identity ID 토큰 클레임을 계정 정보로 변환
+func (c *oidcIDTokenClaims) identity() *OIDCIdentity {
+	identity := &OIDCIdentity{
+		Subject: c.Subject,
+		Email:   c.Email,
+		Name:    firstNonEmpty(c.Name, c.Nickname),
+	}
+	if c.EmailVerified != nil {
+		identity.EmailVerified = bool(*c.EmailVerified)
+	}
+	return identity
+}
+
+// oidcUserInfo userinfo 엔드포인트 응답
+type oidcUserInfo struct {
+	Subject       string        `json:"sub"`
+	Email         string        `json:"email"`
+	EmailVerified *flexibleBool `json:"email_verified"`
+	Name          string        `json:"name"`
+	Nickname      string        `json:"nickname"`
+}
+
+// mergeUserInfo userinfo 엔드포인트의 이메일/이름으로 계정 정보 보완 (sub가 다르면 거부)
+func (c *OIDCClient) mergeUserInfo(ctx context.Context, accessToken string, identity *OIDCIdentity) error {
+	doc, err := c.Discover(ctx)
+	if err != nil {
+		return err
+	}
+	if doc.UserinfoEndpoint == "" || accessToken == "" {
+		return nil
+	}
+
+	var info oidcUserInfo
+	if err := c.getJSON(ctx, doc.UserinfoEndpoint, accessToken, &info); err != nil {
+		return fmt.Errorf("userinfo 조회 실패: %w", err)
+	}
+	if info.Subject != identity.Subject {
+		return errors.New("userinfo의 sub가 ID 토큰과 다릅니다")
+	}
+
+	if info.Email != "" {
+		identity.Email = info.Email
+		identity.EmailVerified = info.EmailVerified != nil && bool(*info.EmailVerified)
+	}
+	if identity.Name == "" {
+		identity.Name = firstNonEmpty(info.Name, info.Nickname)
+	}
+	return nil
+}
+
+// signingKey 키 ID에 해당하는 제공자 서명 공개 키 조회 (모르는 키 ID면 키 목록을 다시 받음)
+func (c *OIDCClient) signingKey(ctx context.Context, jwksURI, kid string) (interface{}, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key, ok := c.lookupKey(kid)
+	if !ok && time.Since(c.keysFetchedAt) >= oidcJWKSRefreshInterval {
+		if err := c.fetchKeys(ctx, jwksURI); err != nil {
+			return nil, err
+		}
+		key, ok = c.lookupKey(kid)
+	}
+	if !ok {
+		return nil, fmt.Errorf("서명 키를 찾을 수 없습니다: kid=%s", kid)
+	}
+	return key, nil
+}
+
+// lookupKey 캐시된 서명 키 조회 (키 ID가 없는 토큰은 키가 하나뿐일 때만 허용)
+func (c *OIDCClient) lookupKey(kid string) (interface{}, bool) {
+	if kid == "" && len(c.keys) == 1 {
+		for _, key := range c.keys {
+			return key, true
+		}
+	}
+	key, ok := c.keys[kid]
+	return key, ok
+}
+
+// jsonWebKey JWKS의 공개 키 항목
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// fetchKeys JWKS 엔드포인트에서 서명 키 목록 갱신
+func (c *OIDCClient) fetchKeys(ctx context.Context, jwksURI string) error {
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	c.keysFetchedAt = time.Now()
+	if err := c.getJSON(ctx, jwksURI, "", &set); err != nil {
+		return fmt.Errorf("서명 키 조회 실패: %w", err)
+	}
+
+	keys := make(map[string]interface{}, len(set.Keys))
+	for _, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.publicKey()
+		if err != nil {
+			continue // 지원하지 않는 키는 무시
+		}
+		keys[jwk.Kid] = key
+	}
+	c.keys = keys
+	return nil
+}
+
+// publicKey JWK를 RSA/ECDSA 공개 키로 변환
+func (k jsonWebKey) publicKey() (interface{}, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBase64URL(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBase64URL(k.E)
+		if err != nil {
+			return nil, err
+		}
+		exponent := new(big.Int).SetBytes(e)
+		if !exponent.IsInt64() || exponent.Int64() < 3 || exponent.Int64() > 1<<31-1 {
+			return nil, errors.New("유효하지 않은 RSA 지수")
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())}, nil
+
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("지원하지 않는 곡선: %s", k.Crv)
+		}
+		x, err := decodeBase64URL(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBase64URL(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		key := &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+		if !curve.IsOnCurve(key.X, key.Y) {
+			return nil, errors.New("유효하지 않은 EC 공개 키")
+		}
+		return key, nil
+	}
+
+	return nil, fmt.Errorf("지원하지 않는 키 유형: %s", k.Kty)
+}
+
+// decodeBase64URL 패딩 유무와 관계없이 base64url 디코딩
+func decodeBase64URL(s string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+}
+
+// getJSON GET 요청의 JSON 응답 해석 (accessToken이 있으면 Bearer 인증)
+func (c *OIDCClient) getJSON(ctx context.Context, target, accessToken string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if accessToken != "" {
+		req.Header.Set("Authorization", "Bearer "+accessToken)
+	}
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s 응답 오류: %s", target, resp.Status)
+	}
+	return json.NewDecoder(io.LimitReader(resp.Body, oidcMaxResponseSize)).Decode(v)
+}
+
+// firstNonEmpty 비어 있지 않은 첫 문자열 반환
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,33 @@
+package helper
+
+import (
+	"log"
+	"time"
+)
+
+// StartPeriodicJob 주기적으로 실행되는 백그라운드 작업 시작
+// 시작 직후 한 번 실행한 뒤 interval마다 반복하며, 반환된 함수를 호출하면 작업이 중지됨
+func StartPeriodicJob(name string, interval time.Duration, job func() error) func() {
+	stop := make(chan struct{})
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			if err := job(); err != nil {
+				log.Printf("백그라운드 작업 실패 (%s): %v", name, err)
+			}
+
+			select {
+			case <-ticker.C:
+			case <-stop:
+				return
+			}
+		}
+	}()
+
+	return func() {
+		close(stop)
+	}
+}
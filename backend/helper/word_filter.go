@@ -0,0 +1,123 @@
+package helper
+
+import (
+	"bufio"
+	"errors"
+	"io/fs"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// WordFilter 금칙어 필터
+// 대소문자, 숫자, 문장부호를 무시하고 비교하므로 "시1발", "F.u.c.k"처럼 끼워 넣은 문자도 걸러냄
+// 공백은 단어 경계로 유지하여 "다시 발견"처럼 단어 사이에 걸친 경우는 걸러내지 않음
+// !로 시작하는 단어는 예외 단어로, 금칙어를 포함하더라도 허용 (예: "!시발점")
+type WordFilter struct {
+	words      []string // 정규화된 금칙어
+	exceptions []string // 정규화된 예외 단어
+}
+
+// NewWordFilter 단어 목록으로 필터 생성 (빈 단어와 정규화 후 빈 단어는 무시)
+func NewWordFilter(words []string) *WordFilter {
+	filter := &WordFilter{}
+	for _, word := range words {
+		word = strings.TrimSpace(word)
+		exception := strings.HasPrefix(word, "!")
+		normalized := normalizeFilterText(strings.TrimPrefix(word, "!"))
+		if normalized == "" {
+			continue
+		}
+		if exception {
+			filter.exceptions = append(filter.exceptions, normalized)
+		} else {
+			filter.words = append(filter.words, normalized)
+		}
+	}
+	return filter
+}
+
+// Match 텍스트에 포함된 첫 번째 금칙어 반환 (없으면 false)
+func (f *WordFilter) Match(text string) (string, bool) {
+	if f == nil || len(f.words) == 0 {
+		return "", false
+	}
+
+	normalized := normalizeFilterText(text)
+	// 예외 단어는 금칙어 검사 전에 제거
+	for _, exception := range f.exceptions {
+		normalized = strings.ReplaceAll(normalized, exception, " ")
+	}
+	for _, word := range f.words {
+		if strings.Contains(normalized, word) {
+			return word, true
+		}
+	}
+	return "", false
+}
+
+// normalizeFilterText 비교용 텍스트 정규화 (문자와 단어 사이 공백 하나만 남기고 소문자로 변환)
+func normalizeFilterText(text string) string {
+	var builder strings.Builder
+	space := false
+	for _, r := range strings.ToLower(text) {
+		switch {
+		case unicode.IsLetter(r):
+			if space && builder.Len() > 0 {
+				builder.WriteByte(' ')
+			}
+			space = false
+			builder.WriteRune(r)
+		case unicode.IsSpace(r):
+			space = true
+		}
+	}
+	return builder.String()
+}
+
+// bannedWordsCache 파일 경로별 금칙어 목록 캐시 (파일은 한 번만 읽음)
+var (
+	bannedWordsMu    sync.Mutex
+	bannedWordsCache = map[string][]string{}
+)
+
+// GetBannedWords 금칙어 목록 파일 읽기 (한 줄에 하나, #은 주석, 파일이 없으면 빈 목록)
+func GetBannedWords(path string) ([]string, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	bannedWordsMu.Lock()
+	defer bannedWordsMu.Unlock()
+
+	if words, ok := bannedWordsCache[path]; ok {
+		return words, nil
+	}
+
+	words := []string{}
+	file, err := os.Open(path)
+	if err != nil {
+		if !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+		log.Printf("금칙어 목록 파일이 없어 추가 금칙어만 사용합니다: %s", path)
+	} else {
+		defer file.Close()
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+			words = append(words, line)
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	bannedWordsCache[path] = words
+	return words, nil
+}
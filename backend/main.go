@@ -6,6 +6,7 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/gin-contrib/cors"
 	"github.com/gin-contrib/gzip"
@@ -19,7 +20,9 @@ import (
 	_ "backend/docs" // Swagger 문서 임포트
 	"backend/helper"
 	"backend/middleware"
+	"backend/model"
 	"backend/route"
+	"backend/service"
 )
 
 func setupSwagger(r *gin.Engine) {
@@ -55,6 +58,16 @@ func main() {
 	// 설정 로드
 	cfg := config.LoadConfig()
 
+	// 하위 명령이 있으면 서버 대신 실행 (예: miniflix catalog import catalog.csv)
+	if len(os.Args) > 1 {
+		os.Exit(runCommand(cfg, os.Args[1:], os.Stdout, os.Stderr))
+	}
+
+	// 결제 웹훅 서명 키가 없으면 위조된 결제 완료 웹훅을 막을 수 없으므로 시작하지 않음
+	if cfg.Billing.WebhookSecret == "" {
+		log.Fatalf("결제 웹훅 서명 키(billing.webhook_secret 또는 BILLING_WEBHOOK_SECRET)를 설정해야 합니다 (환경: %s)", cfg.Environment)
+	}
+
 	// 설정 수동 확인 (최소한의 설정만 로그에 출력)
 	log.Printf("서버 포트: %s", cfg.ServerPort)
 	log.Printf("데이터베이스: %s@%s:%s/%s", cfg.DBUser, cfg.DBHost, cfg.DBPort, cfg.DBName)
@@ -69,6 +82,8 @@ func main() {
 
 	// API 라우트 설정
 	apiGroup := router.Group("/api")
+	// 접속 국가 확인 (국가별 콘텐츠 이용 제한)
+	apiGroup.Use(middleware.GeoMiddleware(cfg))
 	{
 		// 인증 라우트
 		route.SetupAuthRoutes(apiGroup, cfg)
@@ -79,21 +94,99 @@ func main() {
 		// 장르 라우트 (공개 접근)
 		route.SetupGenreRoutes(apiGroup, cfg)
 
-		// 인증이 필요한 라우트
+		// 큐레이션 컬렉션 라우트 (인증 선택)
+		route.SetupCollectionRoutes(apiGroup, cfg)
+
+		// 홈 화면 라우트 (인증 선택)
+		route.SetupHomeRoutes(apiGroup, cfg)
+
+		// 리뷰 추천/신고 라우트 (인증 필요)
+		route.SetupReviewRoutes(apiGroup, cfg)
+
+		// 사용자 목록 라우트 (공유 목록 조회는 공개, 나머지는 인증 필요)
+		route.SetupUserListRoutes(apiGroup, cfg)
+
+		// 요금제/구독 라우트 (요금제 목록은 공개, 내 구독은 인증 필요)
+		route.SetupSubscriptionRoutes(apiGroup, cfg)
+
+		// 결제 라우트 (웹훅은 서명 검증, 나머지는 인증 필요)
+		route.SetupBillingRoutes(apiGroup, cfg)
+
+		// 인증이 필요한 라우트 (인증 미들웨어는 각 라우트 그룹에서 한 번만 적용)
 		authenticatedGroup := apiGroup.Group("")
-		authenticatedGroup.Use(middleware.AuthMiddleware(cfg))
 		{
 			// 사용자 라우트
 			route.SetupUserRoutes(authenticatedGroup, cfg)
 
 			// 찜 목록 라우트
 			route.SetupWishlistRoutes(authenticatedGroup, cfg)
+
+			// 프로필 라우트
+			route.SetupProfileRoutes(authenticatedGroup, cfg)
+
+			// 2단계 인증 라우트
+			route.SetupTwoFactorRoutes(authenticatedGroup, cfg)
 		}
 
+		// 관리자 라우트 (관리자 권한 확인 포함)
+		route.SetupAdminRoutes(apiGroup, cfg)
+
 		// Swagger API 문서 설정
 		apiGroup.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 	}
 
+	// 추천 유사도 재계산 작업 시작
+	stopRecommendationJob := helper.StartPeriodicJob("추천 유사도 계산",
+		time.Duration(cfg.RecommendationRefreshMinutes)*time.Minute,
+		func() error {
+			db, err := helper.GetDB(cfg)
+			if err != nil || db == nil {
+				return err
+			}
+			return service.NewRecommendationService(db.DB).RecomputeSimilarities()
+		})
+
+	// 만료된 토큰 정리 작업 시작
+	stopTokenCleanupJob := helper.StartPeriodicJob("만료 토큰 정리", time.Hour, func() error {
+		db, err := helper.GetDB(cfg)
+		if err != nil || db == nil {
+			return err
+		}
+		if err := model.PurgeExpiredTokens(db.DB); err != nil {
+			return err
+		}
+		return model.PurgeExpiredUserTokens(db.DB)
+	})
+
+	// 탈퇴 유예 기간이 끝난 계정과 보관 기간이 지난 내보내기 파일 정리 작업 시작
+	stopPrivacyCleanupJob := helper.StartPeriodicJob("개인정보 정리", time.Hour, func() error {
+		db, err := helper.GetDB(cfg)
+		if err != nil || db == nil {
+			return err
+		}
+		purged, err := service.NewAccountDeletionService(db.DB, cfg).PurgeDueAccounts()
+		if err != nil {
+			return err
+		}
+		if purged > 0 {
+			log.Printf("탈퇴 계정 %d개의 개인정보를 삭제했습니다", purged)
+		}
+		return service.NewDataExportService(db.DB, cfg).PurgeExpired()
+	})
+
+	// 공개된 콘텐츠의 공개 알림 발송 작업 시작
+	stopReminderJob := helper.StartPeriodicJob("공개 알림 발송", time.Minute, func() error {
+		db, err := helper.GetDB(cfg)
+		if err != nil || db == nil {
+			return err
+		}
+		sent, err := service.NewContentReminderService(db.DB, helper.NewMailer(cfg.Mail), cfg).SendDueReminders()
+		if sent > 0 {
+			log.Printf("공개 알림 %d건을 발송했습니다", sent)
+		}
+		return err
+	})
+
 	// 서버 시작
 	go func() {
 		log.Printf("서버 시작: http://localhost:%s", cfg.ServerPort)
@@ -109,6 +202,10 @@ func main() {
 
 	// 정상 종료 처리
 	log.Println("서버 종료 중...")
+	stopRecommendationJob()
+	stopTokenCleanupJob()
+	stopPrivacyCleanupJob()
+	stopReminderJob()
 	helper.CloseDB()
 	log.Println("서버가 정상적으로 종료되었습니다.")
 }
@@ -128,7 +225,7 @@ func setupRouter(cfg *config.Config) *gin.Engine {
 				"http://127.0.0.1:8080", "http://127.0.0.1:3000",
 			},
 			AllowMethods:     []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
-			AllowHeaders:     []string{"Origin", "Authorization", "Content-Type"},
+			AllowHeaders:     []string{"Origin", "Authorization", "Content-Type", "X-Parental-PIN"},
 			AllowCredentials: true,
 		}))
 	} else {
@@ -145,7 +242,7 @@ func setupRouter(cfg *config.Config) *gin.Engine {
 			// 	"https://sunglim-fe.bokji24.com", "https://sunglim-be.bokji24.com",
 			// },
 			AllowMethods:     []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
-			AllowHeaders:     []string{"Origin", "Authorization", "Content-Type"},
+			AllowHeaders:     []string{"Origin", "Authorization", "Content-Type", "X-Parental-PIN"},
 			AllowCredentials: true,
 		}))
 	}
@@ -172,8 +269,8 @@ func setupRouter(cfg *config.Config) *gin.Engine {
 	router.Use(cors.New(cors.Config{
 		AllowOrigins:     []string{"*"},
 		AllowMethods:     []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization"},
-		ExposeHeaders:    []string{"Content-Length"},
+		AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization", "X-Parental-PIN"},
+		ExposeHeaders:    []string{"Content-Length", "Retry-After"},
 		AllowCredentials: true,
 	}))
 
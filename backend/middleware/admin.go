@@ -0,0 +1,38 @@
+package middleware
+
+import (
+	"database/sql"
+	"log"
+	"net/http"
+
+	"backend/config"
+	"backend/helper"
+	"backend/model"
+	"github.com/gin-gonic/gin"
+)
+
+// AdminMiddleware 관리자 권한 확인 미들웨어 (AuthMiddleware 뒤에 사용)
+// 권한 회수가 바로 반영되도록 토큰이 아닌 데이터베이스의 관리자 여부를 확인
+func AdminMiddleware(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		db, err := helper.GetDB(cfg)
+		if err != nil || db == nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "권한 확인 실패"})
+			return
+		}
+
+		isAdmin, err := model.IsUserAdmin(db.DB, c.GetInt64("userID"))
+		if err != nil && err != sql.ErrNoRows {
+			log.Printf("관리자 권한 확인 실패: %v", err)
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "권한 확인 실패"})
+			return
+		}
+		if !isAdmin {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "관리자 권한이 필요합니다"})
+			return
+		}
+
+		c.Next()
+	}
+}
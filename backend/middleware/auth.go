@@ -1,12 +1,14 @@
 package middleware
 
 import (
+	"log"
 	"net/http"
 	"strings"
 
-	"github.com/gin-gonic/gin"
 	"backend/config"
 	"backend/helper"
+	"backend/model"
+	"github.com/gin-gonic/gin"
 )
 
 // AuthMiddleware JWT 인증 미들웨어
@@ -33,10 +35,20 @@ func AuthMiddleware(cfg *config.Config) gin.HandlerFunc {
 			return
 		}
 
+		// 폐기된 토큰 확인
+		revoked, err := isTokenRevoked(cfg, claims.ID)
+		if err != nil {
+			log.Printf("토큰 폐기 여부 확인 실패: %v", err)
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "인증 확인 실패"})
+			return
+		}
+		if revoked {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "만료된 세션입니다. 다시 로그인해 주세요"})
+			return
+		}
+
 		// 인증 정보를 컨텍스트에 저장
-		c.Set("userID", claims.UserID)
-		c.Set("userName", claims.Name)
-		c.Set("userEmail", claims.Email)
+		setAuthContext(c, claims)
 
 		c.Next()
 	}
@@ -69,12 +81,39 @@ func OptionalAuthMiddleware(cfg *config.Config) gin.HandlerFunc {
 			return
 		}
 
+		// 폐기된 토큰은 비로그인 사용자로 처리
+		if revoked, err := isTokenRevoked(cfg, claims.ID); err != nil || revoked {
+			c.Next()
+			return
+		}
+
 		// 인증 정보를 컨텍스트에 저장
-		c.Set("userID", claims.UserID)
-		c.Set("userName", claims.Name)
-		c.Set("userEmail", claims.Email)
+		setAuthContext(c, claims)
 		c.Set("isAuthenticated", true)
 
 		c.Next()
 	}
-} 
\ No newline at end of file
+}
+
+// setAuthContext 토큰 클레임의 인증 정보를 컨텍스트에 저장
+func setAuthContext(c *gin.Context, claims *helper.JWTClaims) {
+	c.Set("userID", claims.UserID)
+	c.Set("profileID", claims.ProfileID)
+	c.Set("userName", claims.Name)
+	c.Set("userEmail", claims.Email)
+	c.Set("tokenID", claims.ID)
+	c.Set("tokenExpiresAt", claims.ExpiresAt.Time)
+}
+
+// isTokenRevoked 로그아웃/비밀번호 변경 등으로 폐기된 토큰인지 확인
+func isTokenRevoked(cfg *config.Config, jti string) (bool, error) {
+	db, err := helper.GetDB(cfg)
+	if err != nil {
+		return false, err
+	}
+	if db == nil {
+		// 테스트 모드 (데이터베이스 없음)
+		return false, nil
+	}
+	return model.IsAccessTokenRevoked(db.DB, jti)
+}
@@ -0,0 +1,28 @@
+package middleware
+
+import (
+	"backend/config"
+	"backend/helper"
+
+	"github.com/gin-gonic/gin"
+)
+
+// 접속 정보를 저장하는 컨텍스트 키
+const (
+	CountryKey  = "country"  // 접속 국가 코드
+	ClientIPKey = "clientIP" // 클라이언트 IP (신뢰하는 프록시의 헤더만 반영)
+)
+
+// GeoMiddleware 요청의 접속 국가와 클라이언트 IP를 확인해 컨텍스트에 저장하는 미들웨어
+// 국가별 이용 가능 기간이 설정된 콘텐츠의 조회/재생 제한과 IP별 로그인 제한에 사용
+func GeoMiddleware(cfg *config.Config) gin.HandlerFunc {
+	resolver := helper.NewGeoResolver(cfg.Geo)
+
+	return func(c *gin.Context) {
+		c.Set(CountryKey, resolver.Country(c.Request))
+		if ip := resolver.ClientIP(c.Request); ip.IsValid() {
+			c.Set(ClientIPKey, ip.String())
+		}
+		c.Next()
+	}
+}
@@ -0,0 +1,122 @@
+package model
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// 탈퇴 계정 익명화 값
+const (
+	DeletedUserName    = "탈퇴한 사용자"
+	DeletedProfileName = "삭제된 프로필"
+)
+
+// DeleteAccountRequest 회원 탈퇴 요청 모델
+// @Description 회원 탈퇴 시 본인 확인을 위한 현재 비밀번호
+type DeleteAccountRequest struct {
+	CurrentPassword string `json:"current_password" binding:"required"` // 현재 계정 비밀번호
+}
+
+// AccountDeletionResponse 회원 탈퇴 요청 응답 모델
+// @Description 탈퇴 예정 시각 (유예 기간 중 다시 로그인하면 탈퇴 요청이 취소됨)
+type AccountDeletionResponse struct {
+	Message     string    `json:"message" example:"탈퇴가 예약되었습니다. 예정일 전에 다시 로그인하면 탈퇴가 취소됩니다"` // 안내 메시지
+	ScheduledAt time.Time `json:"scheduled_at"`                                             // 탈퇴(데이터 삭제) 예정 시각
+}
+
+// ScheduleAccountDeletion 탈퇴 예정 시각 설정
+func ScheduleAccountDeletion(db *sql.DB, userID int64, scheduledAt time.Time) error {
+	_, err := db.Exec(
+		"UPDATE Users SET deletion_scheduled_at = ? WHERE id = ? AND deleted_at IS NULL",
+		scheduledAt, userID,
+	)
+	return err
+}
+
+// CancelAccountDeletion 예정된 탈퇴 취소 (취소된 요청이 있으면 true)
+func CancelAccountDeletion(db *sql.DB, userID int64) (bool, error) {
+	result, err := db.Exec(
+		"UPDATE Users SET deletion_scheduled_at = NULL WHERE id = ? AND deletion_scheduled_at IS NOT NULL AND deleted_at IS NULL",
+		userID,
+	)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+// GetDueAccountDeletions 유예 기간이 끝난 탈퇴 예정 계정 ID 목록 조회
+func GetDueAccountDeletions(db *sql.DB) ([]int64, error) {
+	rows, err := db.Query(
+		"SELECT id FROM Users WHERE deletion_scheduled_at <= ? AND deleted_at IS NULL",
+		time.Now(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	userIDs := []int64{}
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		userIDs = append(userIDs, id)
+	}
+	return userIDs, rows.Err()
+}
+
+// AnonymizeUser 탈퇴 계정의 개인정보 삭제/익명화
+// 시청 기록은 인기/추천 통계 계산에 쓰이므로 프로필과의 연결만 남기고 위치와 시각을 흐리게 처리하며,
+// 찜 목록과 사용자 목록(공유 링크 포함), 공개 알림 신청, 작성한 리뷰와 로그인 관련 기록은 삭제 (인기도 점수, 리뷰 추천 수 등 이미 집계된 통계는 유지)
+// 이용 중이거나 시작 예정인 구독은 즉시 종료하고 해지 처리 (결제 기록 보관을 위해 구독 행은 유지)
+func AnonymizeUser(db *sql.DB, userID int64) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	now := time.Now()
+	statements := []struct {
+		query string
+		args  []interface{}
+	}{
+		{`UPDATE Users SET
+			email = ?, password_hash = '', name = ?, is_active = FALSE, is_admin = FALSE,
+			email_verified_at = NULL, totp_secret = NULL, totp_enabled = FALSE, parental_pin_hash = NULL,
+			deletion_scheduled_at = NULL, deleted_at = ?, updated_at = ?
+		WHERE id = ?`, []interface{}{fmt.Sprintf("deleted-%d@deleted.invalid", userID), DeletedUserName, now, now, userID}},
+		{"UPDATE Profiles SET name = ?, avatar_url = '' WHERE user_id = ?", []interface{}{DeletedProfileName, userID}},
+		{`UPDATE ViewingHistories vh JOIN Profiles p ON p.id = vh.profile_id
+		SET vh.last_position = 0, vh.watched_at = DATE(vh.watched_at)
+		WHERE p.user_id = ?`, []interface{}{userID}},
+		{"DELETE w FROM Wishlists w JOIN Profiles p ON p.id = w.profile_id WHERE p.user_id = ?", []interface{}{userID}},
+		{"DELETE l FROM UserLists l JOIN Profiles p ON p.id = l.profile_id WHERE p.user_id = ?", []interface{}{userID}},
+		{"DELETE r FROM ContentReminders r JOIN Profiles p ON p.id = r.profile_id WHERE p.user_id = ?", []interface{}{userID}},
+		{"DELETE e FROM PopularityEvents e JOIN Profiles p ON p.id = e.profile_id WHERE p.user_id = ?", []interface{}{userID}},
+		{`UPDATE Subscriptions SET ends_at = LEAST(ends_at, ?), cancelled_at = COALESCE(cancelled_at, ?)
+		WHERE user_id = ? AND ends_at > ?`, []interface{}{now, now, userID, now}},
+		{"DELETE FROM UserSessions WHERE user_id = ?", []interface{}{userID}},
+		{"DELETE FROM RefreshTokens WHERE user_id = ?", []interface{}{userID}},
+		{"DELETE FROM UserTokens WHERE user_id = ?", []interface{}{userID}},
+		{"DELETE FROM RecoveryCodes WHERE user_id = ?", []interface{}{userID}},
+		{"DELETE FROM UserIdentities WHERE user_id = ?", []interface{}{userID}},
+		{"DELETE FROM AccountLockouts WHERE user_id = ?", []interface{}{userID}},
+		{"DELETE FROM DataExports WHERE user_id = ?", []interface{}{userID}},
+		{"DELETE FROM Reviews WHERE user_id = ?", []interface{}{userID}},
+	}
+	for _, stmt := range statements {
+		if _, err := tx.Exec(stmt.query, stmt.args...); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,85 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+// AccountLockout 로그인 실패로 인한 계정 잠금 감사 기록
+// @Description 계정 잠금 발생/해제 기록
+type AccountLockout struct {
+	ID             int64      `json:"id"`              // 기록 고유 ID
+	UserID         *int64     `json:"user_id"`         // 잠긴 계정 ID (가입되지 않은 이메일은 null)
+	Email          string     `json:"email"`           // 로그인 시도 이메일
+	IPAddress      string     `json:"ip_address"`      // 잠금을 일으킨 요청 IP
+	FailedAttempts int        `json:"failed_attempts"` // 잠금 시점의 연속 실패 횟수
+	LockedUntil    time.Time  `json:"locked_until"`    // 잠금 해제 예정 시각
+	UnlockedAt     *time.Time `json:"unlocked_at"`     // 관리자 잠금 해제 시각
+	UnlockedBy     *int64     `json:"unlocked_by"`     // 잠금을 해제한 관리자 ID
+	CreatedAt      time.Time  `json:"created_at"`      // 잠금 일시
+}
+
+// CreateAccountLockout 계정 잠금 기록 저장
+func CreateAccountLockout(db *sql.DB, lockout *AccountLockout) error {
+	lockout.CreatedAt = time.Now()
+	result, err := db.Exec(`
+		INSERT INTO AccountLockouts (user_id, email, ip_address, failed_attempts, locked_until, created_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+	`, lockout.UserID, lockout.Email, lockout.IPAddress, lockout.FailedAttempts, lockout.LockedUntil, lockout.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	lockout.ID, err = result.LastInsertId()
+	return err
+}
+
+// UnlockAccountLockouts 계정의 진행 중인 잠금 기록에 관리자 해제 정보 기록
+func UnlockAccountLockouts(db *sql.DB, userID, adminID int64) error {
+	now := time.Now()
+	_, err := db.Exec(`
+		UPDATE AccountLockouts SET unlocked_at = ?, unlocked_by = ?
+		WHERE user_id = ? AND unlocked_at IS NULL AND locked_until > ?
+	`, now, adminID, userID, now)
+	return err
+}
+
+// GetAccountLockouts 계정의 최근 잠금 기록 조회 (최신순)
+func GetAccountLockouts(db *sql.DB, userID int64, limit int) ([]AccountLockout, error) {
+	rows, err := db.Query(`
+		SELECT id, user_id, email, ip_address, failed_attempts, locked_until, unlocked_at, unlocked_by, created_at
+		FROM AccountLockouts
+		WHERE user_id = ?
+		ORDER BY created_at DESC, id DESC
+		LIMIT ?
+	`, userID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	lockouts := []AccountLockout{}
+	for rows.Next() {
+		var lockout AccountLockout
+		var lockedUserID, unlockedBy sql.NullInt64
+		var unlockedAt sql.NullTime
+		if err := rows.Scan(
+			&lockout.ID, &lockedUserID, &lockout.Email, &lockout.IPAddress, &lockout.FailedAttempts,
+			&lockout.LockedUntil, &unlockedAt, &unlockedBy, &lockout.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		if lockedUserID.Valid {
+			lockout.UserID = &lockedUserID.Int64
+		}
+		if unlockedAt.Valid {
+			lockout.UnlockedAt = &unlockedAt.Time
+		}
+		if unlockedBy.Valid {
+			lockout.UnlockedBy = &unlockedBy.Int64
+		}
+		lockouts = append(lockouts, lockout)
+	}
+
+	return lockouts, rows.Err()
+}
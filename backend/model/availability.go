@@ -0,0 +1,141 @@
+package model
+
+import (
+	"database/sql"
+	"errors"
+	"regexp"
+	"time"
+)
+
+// RegionWorldwide 모든 국가에 적용되는 이용 가능 기간의 지역 코드
+const RegionWorldwide = "*"
+
+// ErrInvalidAvailability 이용 가능 기간 설정 오류
+var ErrInvalidAvailability = errors.New("이용 가능 기간은 국가 코드(ISO 3166-1 alpha-2 또는 *)와 시작일이 종료일보다 앞서야 합니다")
+
+// regionPattern 국가 코드 형식 (대문자 2자리)
+var regionPattern = regexp.MustCompile(`^[A-Z]{2}$`)
+
+// Viewer 콘텐츠를 조회하는 시청자 (시청 등급과 이용 가능 지역 필터, 번역 선택에 사용)
+type Viewer struct {
+	ProfileID int64    // 프로필 ID (비로그인은 0)
+	Country   string   // 접속 국가 코드 (알 수 없으면 빈 문자열, 지역 제한이 없는 콘텐츠만 조회됨)
+	Locales   []string // 번역 조회 언어 순서 (번역이 없으면 원문)
+}
+
+// ContentAvailability 콘텐츠 이용 가능 기간 모델
+// @Description 라이선스에 따른 국가별 콘텐츠 이용 가능 기간 (기간이 하나도 없는 콘텐츠는 모든 국가에서 항상 이용 가능)
+type ContentAvailability struct {
+	Region         string     `json:"region" binding:"required" example:"KR"` // 국가 코드 (ISO 3166-1 alpha-2, *는 모든 국가)
+	AvailableFrom  *time.Time `json:"available_from"`                         // 이용 시작일시 (null이면 제한 없음)
+	AvailableUntil *time.Time `json:"available_until"`                        // 이용 종료일시 (null이면 제한 없음)
+}
+
+// SetContentAvailabilityRequest 콘텐츠 이용 가능 기간 설정 요청 모델 (관리자)
+// @Description 콘텐츠의 이용 가능 기간 전체를 교체할 때 전송하는 데이터 모델 (빈 목록이면 지역 제한 해제)
+type SetContentAvailabilityRequest struct {
+	Windows []ContentAvailability `json:"windows" binding:"dive"` // 이용 가능 기간 목록
+}
+
+// Validate 이용 가능 기간 목록 검증
+func (r *SetContentAvailabilityRequest) Validate() error {
+	for _, window := range r.Windows {
+		if window.Region != RegionWorldwide && !regionPattern.MatchString(window.Region) {
+			return ErrInvalidAvailability
+		}
+		if window.AvailableFrom != nil && window.AvailableUntil != nil && !window.AvailableFrom.Before(*window.AvailableUntil) {
+			return ErrInvalidAvailability
+		}
+	}
+	return nil
+}
+
+// availabilityCondition 시청자 국가에서 현재 이용 가능한 콘텐츠만 조회하는 WHERE 조건
+// 이용 가능 기간이 하나도 없는 콘텐츠는 지역 제한이 없는 것으로 봄 (국가 코드 인자 1개 필요)
+func availabilityCondition(alias string) string {
+	return availabilityConditionAt(alias, "NOW()")
+}
+
+// availabilityConditionAt at 시점(SQL 식)에 시청자 국가에서 이용 가능한 콘텐츠만 조회하는 WHERE 조건
+func availabilityConditionAt(alias, at string) string {
+	return `(NOT EXISTS (SELECT 1 FROM ContentAvailability ca WHERE ca.content_id = ` + alias + `.id)
+		OR EXISTS (SELECT 1 FROM ContentAvailability ca WHERE ca.content_id = ` + alias + `.id
+			AND ca.region IN (?, '` + RegionWorldwide + `')
+			AND (ca.available_from IS NULL OR ca.available_from <= ` + at + `)
+			AND (ca.available_until IS NULL OR ca.available_until > ` + at + `)))`
+}
+
+// catalogCondition 공개 기간, 시청 등급, 이용 가능 지역을 함께 적용하는 WHERE 조건 (catalogArgs 인자 필요)
+func catalogCondition(alias string) string {
+	return publishedCondition(alias) + " AND " + maturityCondition(alias) + " AND " + availabilityCondition(alias)
+}
+
+// catalogArgs catalogCondition에 전달할 인자
+func (v Viewer) catalogArgs() []interface{} {
+	return []interface{}{v.ProfileID, v.Country}
+}
+
+// IsContentAvailable 콘텐츠가 국가에서 현재 이용 가능한지 확인 (콘텐츠가 없으면 sql.ErrNoRows)
+func IsContentAvailable(db *sql.DB, contentID int64, country string) (bool, error) {
+	var available bool
+	err := db.QueryRow(`
+		SELECT `+availabilityCondition("c")+`
+		FROM Contents c
+		WHERE c.id = ?
+	`, country, contentID).Scan(&available)
+	return available, err
+}
+
+// GetContentAvailability 콘텐츠의 이용 가능 기간 목록 조회
+func GetContentAvailability(db *sql.DB, contentID int64) ([]ContentAvailability, error) {
+	rows, err := db.Query(`
+		SELECT region, available_from, available_until
+		FROM ContentAvailability
+		WHERE content_id = ?
+		ORDER BY region ASC, available_from ASC
+	`, contentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	windows := []ContentAvailability{}
+	for rows.Next() {
+		var window ContentAvailability
+		var from, until sql.NullTime
+		if err := rows.Scan(&window.Region, &from, &until); err != nil {
+			return nil, err
+		}
+		if from.Valid {
+			window.AvailableFrom = &from.Time
+		}
+		if until.Valid {
+			window.AvailableUntil = &until.Time
+		}
+		windows = append(windows, window)
+	}
+	return windows, rows.Err()
+}
+
+// ReplaceContentAvailability 콘텐츠의 이용 가능 기간 전체 교체 (트랜잭션)
+func ReplaceContentAvailability(db *sql.DB, contentID int64, windows []ContentAvailability) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM ContentAvailability WHERE content_id = ?", contentID); err != nil {
+		return err
+	}
+	for _, window := range windows {
+		if _, err := tx.Exec(
+			"INSERT INTO ContentAvailability (content_id, region, available_from, available_until) VALUES (?, ?, ?, ?)",
+			contentID, window.Region, window.AvailableFrom, window.AvailableUntil,
+		); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,389 @@
+package model
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// 결제 세션 상태
+const (
+	CheckoutSessionOpen      = "open"      // 결제 대기
+	CheckoutSessionCompleted = "completed" // 결제 완료
+	CheckoutSessionFailed    = "failed"    // 결제 실패
+)
+
+// 청구서 상태
+const (
+	InvoicePaid     = "paid"     // 결제 완료
+	InvoiceFailed   = "failed"   // 결제 실패
+	InvoiceRefunded = "refunded" // 환불
+)
+
+// ErrPaymentMismatch 결제 금액/통화가 결제 세션과 다름
+var ErrPaymentMismatch = errors.New("결제 금액이 결제 요청과 일치하지 않습니다")
+
+// CreateCheckoutRequest 결제 요청 모델
+// @Description 요금제 결제 페이지를 요청할 때 전송하는 데이터 모델
+type CreateCheckoutRequest struct {
+	Plan string `json:"plan" binding:"required,oneof=basic standard premium" example:"standard"` // 요금제 코드
+}
+
+// CheckoutSession 결제 세션 모델
+// @Description 결제 페이지 주소와 결제 상태
+type CheckoutSession struct {
+	ID                int64      `json:"id"`                                                    // 결제 세션 ID
+	UserID            int64      `json:"-"`                                                     // 계정 ID
+	PlanID            int64      `json:"-"`                                                     // 요금제 ID
+	PlanCode          string     `json:"plan" example:"standard"`                               // 요금제 코드
+	Provider          string     `json:"-"`                                                     // 결제 제공자
+	ProviderSessionID string     `json:"-"`                                                     // 결제 제공자의 세션 ID
+	CheckoutURL       string     `json:"checkout_url" example:"https://pay.example.com/cs_123"` // 결제 페이지 URL
+	Amount            int        `json:"amount" example:"13500"`                                // 결제 금액
+	Currency          string     `json:"currency" example:"KRW"`                                // 통화
+	Status            string     `json:"status" example:"open"`                                 // 상태 (open, completed, failed)
+	CreatedAt         time.Time  `json:"created_at"`                                            // 생성일시
+	ExpiresAt         time.Time  `json:"expires_at"`                                            // 결제 페이지 만료일시
+	CompletedAt       *time.Time `json:"completed_at"`                                          // 결제 완료일시
+}
+
+// Invoice 청구서 모델
+// @Description 결제 성공/실패/환불 기록
+type Invoice struct {
+	ID            int64      `json:"id"`                       // 청구서 ID
+	PlanCode      string     `json:"plan" example:"standard"`  // 요금제 코드
+	PlanName      string     `json:"plan_name" example:"스탠다드"` // 요금제 이름
+	Amount        int        `json:"amount" example:"13500"`   // 결제 금액
+	Currency      string     `json:"currency" example:"KRW"`   // 통화
+	Status        string     `json:"status" example:"paid"`    // 상태 (paid, failed, refunded)
+	FailureReason string     `json:"failure_reason,omitempty"` // 결제 실패 사유
+	PeriodStart   *time.Time `json:"period_start"`             // 이용 기간 시작 (실패한 결제는 null)
+	PeriodEnd     *time.Time `json:"period_end"`               // 이용 기간 종료 (실패한 결제는 null)
+	CreatedAt     time.Time  `json:"created_at"`               // 발행일시
+	RefundedAt    *time.Time `json:"refunded_at"`              // 환불일시
+}
+
+// PaymentEvent 결제 제공자 웹훅에서 받은 결제 이벤트
+type PaymentEvent struct {
+	Provider      string // 결제 제공자
+	EventID       string // 제공자의 이벤트 ID
+	Type          string // 이벤트 유형
+	SessionID     string // 제공자의 결제 세션 ID
+	PaymentID     string // 제공자의 결제 ID
+	Amount        int    // 금액
+	Currency      string // 통화
+	FailureReason string // 실패 사유
+}
+
+// billingExecer 결제 처리에 사용하는 DB/트랜잭션 공통 인터페이스
+type billingExecer interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+	QueryRow(query string, args ...interface{}) *sql.Row
+}
+
+// CreateCheckoutSession 결제 세션 저장
+func CreateCheckoutSession(db *sql.DB, session *CheckoutSession) error {
+	session.Status = CheckoutSessionOpen
+	session.CreatedAt = time.Now()
+	result, err := db.Exec(`
+		INSERT INTO CheckoutSessions
+			(user_id, plan_id, provider, provider_session_id, checkout_url, amount, currency, status, created_at, expires_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`, session.UserID, session.PlanID, session.Provider, session.ProviderSessionID, session.CheckoutURL,
+		session.Amount, session.Currency, session.Status, session.CreatedAt, session.ExpiresAt)
+	if err != nil {
+		return err
+	}
+
+	session.ID, err = result.LastInsertId()
+	return err
+}
+
+// GetCheckoutSession 계정의 결제 세션 조회 (다른 계정의 세션이면 sql.ErrNoRows)
+func GetCheckoutSession(db *sql.DB, userID, sessionID int64) (*CheckoutSession, error) {
+	session := &CheckoutSession{}
+	var completedAt sql.NullTime
+	err := db.QueryRow(`
+		SELECT
+			cs.id, cs.user_id, cs.plan_id, p.code, cs.provider, cs.provider_session_id, cs.checkout_url,
+			cs.amount, cs.currency, cs.status, cs.created_at, cs.expires_at, cs.completed_at
+		FROM
+			CheckoutSessions cs
+		JOIN
+			Plans p ON p.id = cs.plan_id
+		WHERE
+			cs.id = ? AND cs.user_id = ?
+	`, sessionID, userID).Scan(
+		&session.ID, &session.UserID, &session.PlanID, &session.PlanCode, &session.Provider, &session.ProviderSessionID,
+		&session.CheckoutURL, &session.Amount, &session.Currency, &session.Status, &session.CreatedAt, &session.ExpiresAt, &completedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if completedAt.Valid {
+		session.CompletedAt = &completedAt.Time
+	}
+	return session, nil
+}
+
+// GetUserInvoices 계정의 청구서 목록 조회 (최근 순)
+func GetUserInvoices(db *sql.DB, userID int64) ([]Invoice, error) {
+	rows, err := db.Query(`
+		SELECT
+			i.id, p.code, p.name, i.amount, i.currency, i.status, i.failure_reason,
+			i.period_start, i.period_end, i.created_at, i.refunded_at
+		FROM
+			Invoices i
+		JOIN
+			CheckoutSessions cs ON cs.id = i.checkout_session_id
+		JOIN
+			Plans p ON p.id = cs.plan_id
+		WHERE
+			i.user_id = ?
+		ORDER BY
+			i.created_at DESC, i.id DESC
+	`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	invoices := []Invoice{}
+	for rows.Next() {
+		var invoice Invoice
+		var periodStart, periodEnd, refundedAt sql.NullTime
+		if err := rows.Scan(&invoice.ID, &invoice.PlanCode, &invoice.PlanName, &invoice.Amount, &invoice.Currency,
+			&invoice.Status, &invoice.FailureReason, &periodStart, &periodEnd, &invoice.CreatedAt, &refundedAt); err != nil {
+			return nil, err
+		}
+		if periodStart.Valid {
+			invoice.PeriodStart = &periodStart.Time
+		}
+		if periodEnd.Valid {
+			invoice.PeriodEnd = &periodEnd.Time
+		}
+		if refundedAt.Valid {
+			invoice.RefundedAt = &refundedAt.Time
+		}
+		invoices = append(invoices, invoice)
+	}
+	return invoices, rows.Err()
+}
+
+// claimBillingEvent 처리한 이벤트로 기록 (이미 처리한 이벤트면 false)
+func claimBillingEvent(exec billingExecer, event *PaymentEvent) (bool, error) {
+	result, err := exec.Exec(
+		"INSERT IGNORE INTO BillingEvents (provider, event_id, event_type, processed_at) VALUES (?, ?, ?, ?)",
+		event.Provider, event.EventID, event.Type, time.Now(),
+	)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+// lockCheckoutSession 이벤트의 결제 세션 조회 및 잠금 (없으면 sql.ErrNoRows)
+func lockCheckoutSession(exec billingExecer, event *PaymentEvent) (*CheckoutSession, error) {
+	session := &CheckoutSession{}
+	err := exec.QueryRow(`
+		SELECT id, user_id, plan_id, amount, currency, status
+		FROM CheckoutSessions
+		WHERE provider = ? AND provider_session_id = ?
+		FOR UPDATE
+	`, event.Provider, event.SessionID).Scan(
+		&session.ID, &session.UserID, &session.PlanID, &session.Amount, &session.Currency, &session.Status,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+// ApplyPaymentSucceeded 결제 성공 이벤트 반영 (트랜잭션)
+// 요금제 구독을 한 달 추가하고(이용 중인 구독이 있으면 가장 늦은 종료일부터) 청구서를 발행
+// 이미 처리한 이벤트면 아무것도 바꾸지 않고 false 반환
+func ApplyPaymentSucceeded(db *sql.DB, event *PaymentEvent) (bool, error) {
+	tx, err := db.Begin()
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	claimed, err := claimBillingEvent(tx, event)
+	if err != nil || !claimed {
+		return false, err
+	}
+
+	session, err := lockCheckoutSession(tx, event)
+	if err != nil {
+		return false, err
+	}
+	// 같은 세션의 결제가 이미 반영된 경우 (제공자가 다른 이벤트 ID로 다시 보낸 경우)
+	if session.Status == CheckoutSessionCompleted {
+		return true, tx.Commit()
+	}
+	if event.Amount != session.Amount || event.Currency != session.Currency {
+		return false, ErrPaymentMismatch
+	}
+
+	// 구독 부여(AppendSubscription)와 같은 방식으로 계정을 잠그고 가장 늦은 종료일부터 한 달 추가
+	subscription, err := appendSubscriptionTx(tx, session.UserID, session.PlanID, 1, 0)
+	if err != nil {
+		return false, err
+	}
+
+	if _, err := tx.Exec(`
+		INSERT INTO Invoices
+			(user_id, checkout_session_id, subscription_id, provider, provider_payment_id, amount, currency, status, period_start, period_end, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`, session.UserID, session.ID, subscription.ID, event.Provider, event.PaymentID, event.Amount, event.Currency,
+		InvoicePaid, subscription.StartsAt, subscription.EndsAt, subscription.CreatedAt); err != nil {
+		return false, err
+	}
+
+	if _, err := tx.Exec(
+		"UPDATE CheckoutSessions SET status = ?, completed_at = ? WHERE id = ?",
+		CheckoutSessionCompleted, subscription.CreatedAt, session.ID,
+	); err != nil {
+		return false, err
+	}
+
+	return true, tx.Commit()
+}
+
+// ApplyPaymentFailed 결제 실패 이벤트 반영 (트랜잭션)
+// 결제 대기 중인 세션을 실패 처리하고 실패한 청구서를 기록
+func ApplyPaymentFailed(db *sql.DB, event *PaymentEvent) (bool, error) {
+	tx, err := db.Begin()
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	claimed, err := claimBillingEvent(tx, event)
+	if err != nil || !claimed {
+		return false, err
+	}
+
+	session, err := lockCheckoutSession(tx, event)
+	if err != nil {
+		return false, err
+	}
+
+	now := time.Now()
+	if session.Status == CheckoutSessionOpen {
+		if _, err := tx.Exec(
+			"UPDATE CheckoutSessions SET status = ? WHERE id = ?", CheckoutSessionFailed, session.ID,
+		); err != nil {
+			return false, err
+		}
+	}
+
+	if _, err := tx.Exec(`
+		INSERT INTO Invoices
+			(user_id, checkout_session_id, provider, provider_payment_id, amount, currency, status, failure_reason, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`, session.UserID, session.ID, event.Provider, event.PaymentID, event.Amount, event.Currency,
+		InvoiceFailed, event.FailureReason, now); err != nil {
+		return false, err
+	}
+
+	return true, tx.Commit()
+}
+
+// ApplyPaymentRefunded 환불 이벤트 반영 (트랜잭션)
+// 결제 완료된 청구서를 환불 처리하고 그 결제로 생긴 구독의 남은 기간을 취소 (부분 환불은 지원하지 않음)
+func ApplyPaymentRefunded(db *sql.DB, event *PaymentEvent) (bool, error) {
+	tx, err := db.Begin()
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	claimed, err := claimBillingEvent(tx, event)
+	if err != nil || !claimed {
+		return false, err
+	}
+
+	var invoiceID int64
+	var subscriptionID sql.NullInt64
+	var status string
+	err = tx.QueryRow(`
+		SELECT id, subscription_id, status
+		FROM Invoices
+		WHERE provider = ? AND provider_payment_id = ?
+		FOR UPDATE
+	`, event.Provider, event.PaymentID).Scan(&invoiceID, &subscriptionID, &status)
+	if err != nil {
+		return false, err
+	}
+	if status != InvoicePaid {
+		return true, tx.Commit()
+	}
+
+	now := time.Now()
+	if _, err := tx.Exec(
+		"UPDATE Invoices SET status = ?, refunded_at = ? WHERE id = ?", InvoiceRefunded, now, invoiceID,
+	); err != nil {
+		return false, err
+	}
+	if subscriptionID.Valid {
+		if err := cancelRefundedSubscription(tx, subscriptionID.Int64, now); err != nil {
+			return false, err
+		}
+	}
+
+	return true, tx.Commit()
+}
+
+// cancelRefundedSubscription 환불된 구독의 남은 기간 취소
+// 이용 중이면 지금 종료하고, 시작 전이면 기간을 0으로 만든 뒤, 그 뒤에 이어지는 구독을 취소된 기간만큼 앞당김
+func cancelRefundedSubscription(tx *sql.Tx, subscriptionID int64, now time.Time) error {
+	var userID int64
+	if err := tx.QueryRow("SELECT user_id FROM Subscriptions WHERE id = ?", subscriptionID).Scan(&userID); err != nil {
+		return err
+	}
+	// 구독 생성과 같은 순서로 계정을 먼저 잠가 기간 계산이 겹치지 않도록 함
+	var lockedID int64
+	if err := tx.QueryRow("SELECT id FROM Users WHERE id = ? FOR UPDATE", userID).Scan(&lockedID); err != nil {
+		return err
+	}
+	var startsAt, endsAt time.Time
+	if err := tx.QueryRow(
+		"SELECT starts_at, ends_at FROM Subscriptions WHERE id = ? FOR UPDATE", subscriptionID,
+	).Scan(&startsAt, &endsAt); err != nil {
+		return err
+	}
+
+	// 이미 끝난 구독은 되돌릴 기간이 없음
+	if !endsAt.After(now) {
+		_, err := tx.Exec(
+			"UPDATE Subscriptions SET cancelled_at = COALESCE(cancelled_at, ?) WHERE id = ?", now, subscriptionID,
+		)
+		return err
+	}
+
+	cancelFrom := now
+	if startsAt.After(now) {
+		cancelFrom = startsAt
+	}
+	if _, err := tx.Exec(
+		"UPDATE Subscriptions SET ends_at = ?, cancelled_at = COALESCE(cancelled_at, ?) WHERE id = ?",
+		cancelFrom, now, subscriptionID,
+	); err != nil {
+		return err
+	}
+
+	// 뒤에 이어지는 시작 전 구독을 취소된 기간만큼 앞당겨 유료 기간 사이에 빈 기간이 생기지 않도록 함
+	seconds := int64(endsAt.Sub(cancelFrom) / time.Second)
+	_, err := tx.Exec(`
+		UPDATE Subscriptions
+		SET starts_at = DATE_SUB(starts_at, INTERVAL ? SECOND), ends_at = DATE_SUB(ends_at, INTERVAL ? SECOND)
+		WHERE user_id = ? AND id <> ? AND starts_at >= ?
+	`, seconds, seconds, userID, subscriptionID, endsAt)
+	return err
+}
@@ -0,0 +1,264 @@
+package model
+
+import (
+	"database/sql"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CatalogEntry 카탈로그 가져오기/내보내기 항목 (외부 ID로 콘텐츠를 식별)
+type CatalogEntry struct {
+	ExternalID    string   `json:"external_id"`    // 외부 ID (예: tmdb:278)
+	Title         string   `json:"title"`          // 제목
+	Description   string   `json:"description"`    // 설명
+	ThumbnailURL  string   `json:"thumbnail_url"`  // 썸네일 URL
+	VideoURL      string   `json:"video_url"`      // 영상 URL
+	Duration      int      `json:"duration"`       // 영상 길이(초)
+	ReleaseYear   int      `json:"release_year"`   // 개봉 연도
+	MaturityLevel int      `json:"maturity_level"` // 시청 등급 (0, 12, 15, 19)
+	Genres        []string `json:"genres"`         // 장르 이름 목록
+}
+
+// CatalogContent 카탈로그 항목과 저장된 콘텐츠 ID (새 콘텐츠이면 0)
+type CatalogContent struct {
+	ID int64
+	CatalogEntry
+}
+
+// Normalize 앞뒤 공백 제거 및 중복/빈 장르 제거
+func (e *CatalogEntry) Normalize() {
+	e.ExternalID = strings.TrimSpace(e.ExternalID)
+	e.Title = strings.TrimSpace(e.Title)
+	e.Description = strings.TrimSpace(e.Description)
+	e.ThumbnailURL = strings.TrimSpace(e.ThumbnailURL)
+	e.VideoURL = strings.TrimSpace(e.VideoURL)
+
+	genres := []string{}
+	seen := map[string]bool{}
+	for _, genre := range e.Genres {
+		genre = strings.TrimSpace(genre)
+		if genre != "" && !seen[genre] {
+			seen[genre] = true
+			genres = append(genres, genre)
+		}
+	}
+	e.Genres = genres
+}
+
+// Validate 카탈로그 항목 검증 (문제가 여러 개면 모두 나열)
+func (e *CatalogEntry) Validate() error {
+	var problems []string
+	if e.ExternalID == "" {
+		problems = append(problems, "external_id가 필요합니다")
+	} else if len(e.ExternalID) > 100 {
+		problems = append(problems, "external_id는 100자 이하여야 합니다")
+	}
+	if e.Title == "" {
+		problems = append(problems, "title이 필요합니다")
+	} else if len([]rune(e.Title)) > 200 {
+		problems = append(problems, "title은 200자 이하여야 합니다")
+	}
+	if e.ThumbnailURL == "" || len(e.ThumbnailURL) > 255 {
+		problems = append(problems, "thumbnail_url은 1~255자여야 합니다")
+	}
+	if e.VideoURL == "" || len(e.VideoURL) > 255 {
+		problems = append(problems, "video_url은 1~255자여야 합니다")
+	}
+	if e.Duration <= 0 {
+		problems = append(problems, "duration은 0보다 커야 합니다")
+	}
+	if e.ReleaseYear < 1888 || e.ReleaseYear > time.Now().Year()+5 {
+		problems = append(problems, "release_year가 올바르지 않습니다: "+strconv.Itoa(e.ReleaseYear))
+	}
+	if !IsValidMaturityLevel(e.MaturityLevel) {
+		problems = append(problems, "maturity_level은 0, 12, 15, 19 중 하나여야 합니다")
+	}
+	for _, genre := range e.Genres {
+		if len([]rune(genre)) > 50 {
+			problems = append(problems, "장르 이름은 50자 이하여야 합니다: "+genre)
+		}
+	}
+
+	if len(problems) > 0 {
+		return errors.New(strings.Join(problems, ", "))
+	}
+	return nil
+}
+
+// GetCatalogContentsByExternalIDs 외부 ID로 저장된 콘텐츠 일괄 조회 (외부 ID → 콘텐츠)
+func GetCatalogContentsByExternalIDs(db *sql.DB, externalIDs []string) (map[string]CatalogContent, error) {
+	contents := map[string]CatalogContent{}
+	if len(externalIDs) == 0 {
+		return contents, nil
+	}
+
+	list, err := queryCatalogContents(db, "WHERE c.external_id IN ("+inPlaceholders(len(externalIDs))+")", stringArgs(externalIDs)...)
+	if err != nil {
+		return nil, err
+	}
+	for _, content := range list {
+		contents[content.ExternalID] = content
+	}
+	return contents, nil
+}
+
+// ExportCatalog 전체 콘텐츠를 카탈로그 항목으로 조회 (ID순)
+func ExportCatalog(db *sql.DB) ([]CatalogContent, error) {
+	return queryCatalogContents(db, "")
+}
+
+// queryCatalogContents 조건에 맞는 콘텐츠를 장르 이름과 함께 조회
+func queryCatalogContents(db *sql.DB, where string, args ...interface{}) ([]CatalogContent, error) {
+	rows, err := db.Query(`
+		SELECT
+			c.id, IFNULL(c.external_id, ''), c.title, IFNULL(c.description, ''), c.thumbnail_url, c.video_url,
+			c.duration, c.release_year, c.maturity_level
+		FROM
+			Contents c
+		`+where+`
+		ORDER BY
+			c.id ASC
+	`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	contents := []CatalogContent{}
+	contentIDs := []int64{}
+	for rows.Next() {
+		var content CatalogContent
+		if err := rows.Scan(
+			&content.ID, &content.ExternalID, &content.Title, &content.Description, &content.ThumbnailURL, &content.VideoURL,
+			&content.Duration, &content.ReleaseYear, &content.MaturityLevel,
+		); err != nil {
+			return nil, err
+		}
+		contents = append(contents, content)
+		contentIDs = append(contentIDs, content.ID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	genreNames, err := loadGenreNames(db, contentIDs)
+	if err != nil {
+		return nil, err
+	}
+	for i := range contents {
+		contents[i].Genres = genreNames[contents[i].ID]
+		if contents[i].Genres == nil {
+			contents[i].Genres = []string{}
+		}
+	}
+	return contents, nil
+}
+
+// GetExistingGenreNames 이미 등록된 장르 이름 집합 조회
+func GetExistingGenreNames(db *sql.DB, names []string) (map[string]bool, error) {
+	existing := map[string]bool{}
+	if len(names) == 0 {
+		return existing, nil
+	}
+
+	rows, err := db.Query("SELECT name FROM Genres WHERE name IN ("+inPlaceholders(len(names))+")", stringArgs(names)...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		existing[name] = true
+	}
+	return existing, rows.Err()
+}
+
+// UpsertCatalogContents 카탈로그 콘텐츠를 한 트랜잭션으로 저장
+// ID가 0이면 새로 추가하고, 아니면 기본 정보를 갱신한 뒤 장르를 교체하며, 없는 장르는 새로 만듦
+func UpsertCatalogContents(db *sql.DB, contents []CatalogContent) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// 장르 ID 조회 (없는 장르는 추가)
+	genreNames := []string{}
+	seen := map[string]bool{}
+	for _, content := range contents {
+		for _, genre := range content.Genres {
+			if !seen[genre] {
+				seen[genre] = true
+				genreNames = append(genreNames, genre)
+			}
+		}
+	}
+	genreIDs := map[string]int64{}
+	if len(genreNames) > 0 {
+		for _, name := range genreNames {
+			if _, err := tx.Exec("INSERT IGNORE INTO Genres (name) VALUES (?)", name); err != nil {
+				return err
+			}
+		}
+		rows, err := tx.Query("SELECT id, name FROM Genres WHERE name IN ("+inPlaceholders(len(genreNames))+")", stringArgs(genreNames)...)
+		if err != nil {
+			return err
+		}
+		for rows.Next() {
+			var id int64
+			var name string
+			if err := rows.Scan(&id, &name); err != nil {
+				rows.Close()
+				return err
+			}
+			genreIDs[name] = id
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return err
+		}
+	}
+
+	for _, content := range contents {
+		contentID := content.ID
+		if contentID == 0 {
+			result, err := tx.Exec(`
+				INSERT INTO Contents (external_id, title, description, thumbnail_url, video_url, duration, release_year, maturity_level)
+				VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+			`, content.ExternalID, content.Title, content.Description, content.ThumbnailURL, content.VideoURL,
+				content.Duration, content.ReleaseYear, content.MaturityLevel)
+			if err != nil {
+				return err
+			}
+			if contentID, err = result.LastInsertId(); err != nil {
+				return err
+			}
+		} else {
+			if _, err := tx.Exec(`
+				UPDATE Contents
+				SET title = ?, description = ?, thumbnail_url = ?, video_url = ?, duration = ?, release_year = ?, maturity_level = ?
+				WHERE id = ?
+			`, content.Title, content.Description, content.ThumbnailURL, content.VideoURL,
+				content.Duration, content.ReleaseYear, content.MaturityLevel, contentID); err != nil {
+				return err
+			}
+			if _, err := tx.Exec("DELETE FROM ContentGenres WHERE content_id = ?", contentID); err != nil {
+				return err
+			}
+		}
+
+		for _, genre := range content.Genres {
+			if _, err := tx.Exec("INSERT INTO ContentGenres (content_id, genre_id) VALUES (?, ?)", contentID, genreIDs[genre]); err != nil {
+				return err
+			}
+		}
+	}
+
+	return tx.Commit()
+}
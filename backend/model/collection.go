@@ -0,0 +1,338 @@
+package model
+
+import (
+	"database/sql"
+	"errors"
+	"regexp"
+	"time"
+)
+
+// 컬렉션 관련 오류
+var (
+	ErrInvalidCollection        = errors.New("유효하지 않은 컬렉션입니다")
+	ErrCollectionSlugTaken      = errors.New("이미 사용 중인 컬렉션 슬러그입니다")
+	ErrCollectionContentMissing = errors.New("존재하지 않는 콘텐츠가 포함되어 있습니다")
+)
+
+// collectionSlugPattern 컬렉션 슬러그 형식 (소문자, 숫자, 하이픈)
+var collectionSlugPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// Collection 큐레이션 컬렉션 모델
+// @Description 편집자가 고른 콘텐츠 묶음 (노출 기간이 null이면 제한 없음)
+type Collection struct {
+	ID           int64      `json:"id" example:"1"`
+	Slug         string     `json:"slug" example:"oscar-winners"`               // URL 식별자
+	Title        string     `json:"title" example:"아카데미 수상작"`                   // 제목
+	Description  string     `json:"description" example:"아카데미 작품상을 받은 영화 모음"`   // 설명
+	HeroImageURL string     `json:"hero_image_url" example:"/heroes/oscar.jpg"` // 대표 이미지 URL
+	Position     int        `json:"position" example:"0"`                       // 노출 순서 (작을수록 먼저)
+	StartsAt     *time.Time `json:"starts_at"`                                  // 노출 시작 일시
+	EndsAt       *time.Time `json:"ends_at"`                                    // 노출 종료 일시
+	ItemCount    int        `json:"item_count" example:"12"`                    // 담긴 콘텐츠 수
+}
+
+// CollectionRequest 컬렉션 생성/수정 요청 모델
+// @Description 컬렉션 생성/수정 요청에 사용되는 모델
+type CollectionRequest struct {
+	Slug         string     `json:"slug" binding:"required,max=100" example:"studio-ghibli"` // URL 식별자 (소문자, 숫자, 하이픈)
+	Title        string     `json:"title" binding:"required,max=100" example:"지브리 스튜디오"`     // 제목
+	Description  string     `json:"description" binding:"max=500" example:"지브리 스튜디오의 애니메이션"` // 설명
+	HeroImageURL string     `json:"hero_image_url" binding:"max=255" example:"/heroes/ghibli.jpg"`
+	Position     int        `json:"position" example:"0"` // 노출 순서 (작을수록 먼저)
+	StartsAt     *time.Time `json:"starts_at"`            // 노출 시작 일시 (null이면 바로 노출)
+	EndsAt       *time.Time `json:"ends_at"`              // 노출 종료 일시 (null이면 계속 노출)
+}
+
+// Validate 컬렉션 요청 검증
+func (r *CollectionRequest) Validate() error {
+	if !collectionSlugPattern.MatchString(r.Slug) {
+		return ErrInvalidCollection
+	}
+	if r.StartsAt != nil && r.EndsAt != nil && !r.StartsAt.Before(*r.EndsAt) {
+		return ErrInvalidCollection
+	}
+	return nil
+}
+
+// CollectionItemsRequest 컬렉션 콘텐츠 순서 설정 요청 모델
+// @Description 컬렉션에 담을 콘텐츠 ID 목록 (목록 순서가 노출 순서, 빠진 콘텐츠는 제거)
+type CollectionItemsRequest struct {
+	ContentIDs []int64 `json:"content_ids" binding:"required,max=500,dive,gt=0" example:"3,1,2"`
+}
+
+// CollectionOrderRequest 컬렉션 노출 순서 설정 요청 모델
+// @Description 컬렉션 ID 목록 (목록 순서가 노출 순서, 빠진 컬렉션은 뒤로)
+type CollectionOrderRequest struct {
+	CollectionIDs []int64 `json:"collection_ids" binding:"required,dive,gt=0" example:"2,1"`
+}
+
+// CollectionDetailResponse 컬렉션 상세 응답 모델
+// @Description 컬렉션 정보와 순서대로 정렬된 콘텐츠 목록
+type CollectionDetailResponse struct {
+	Collection
+	Items []ContentListResponse `json:"items"` // 콘텐츠 목록 (장르, 찜 여부 포함)
+}
+
+// CollectionAdminResponse 관리자용 컬렉션 응답 모델
+// @Description 컬렉션 정보와 노출 여부와 관계없는 전체 콘텐츠 ID 목록
+type CollectionAdminResponse struct {
+	Collection
+	ContentIDs []int64 `json:"content_ids"` // 순서대로 정렬된 콘텐츠 ID 목록
+}
+
+// activeCollectionCondition 현재 노출 기간인 컬렉션만 조회하는 WHERE 조건 (인자 없음)
+func activeCollectionCondition(alias string) string {
+	return "(" + alias + ".starts_at IS NULL OR " + alias + ".starts_at <= NOW())" +
+		" AND (" + alias + ".ends_at IS NULL OR " + alias + ".ends_at > NOW())"
+}
+
+// collectionColumns 컬렉션 조회 컬럼 (scanCollection과 순서 일치)
+const collectionColumns = `
+	col.id, col.slug, col.title, IFNULL(col.description, ''), IFNULL(col.hero_image_url, ''),
+	col.position, col.starts_at, col.ends_at,
+	(SELECT COUNT(*) FROM CollectionItems ci WHERE ci.collection_id = col.id)`
+
+// scanCollection 컬렉션 한 행 스캔
+func scanCollection(row interface{ Scan(...interface{}) error }) (*Collection, error) {
+	var collection Collection
+	var startsAt, endsAt sql.NullTime
+	if err := row.Scan(
+		&collection.ID, &collection.Slug, &collection.Title, &collection.Description, &collection.HeroImageURL,
+		&collection.Position, &startsAt, &endsAt, &collection.ItemCount,
+	); err != nil {
+		return nil, err
+	}
+	if startsAt.Valid {
+		collection.StartsAt = &startsAt.Time
+	}
+	if endsAt.Valid {
+		collection.EndsAt = &endsAt.Time
+	}
+	return &collection, nil
+}
+
+// queryCollections 조건에 맞는 컬렉션 목록 조회 (노출 순서대로)
+func queryCollections(db *sql.DB, where string) ([]Collection, error) {
+	rows, err := db.Query(`
+		SELECT ` + collectionColumns + `
+		FROM Collections col
+		` + where + `
+		ORDER BY col.position ASC, col.id ASC
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	collections := []Collection{}
+	for rows.Next() {
+		collection, err := scanCollection(rows)
+		if err != nil {
+			return nil, err
+		}
+		collections = append(collections, *collection)
+	}
+	return collections, rows.Err()
+}
+
+// GetActiveCollections 현재 노출 기간인 컬렉션 목록 조회 (노출 순서대로)
+func GetActiveCollections(db *sql.DB) ([]Collection, error) {
+	return queryCollections(db, "WHERE "+activeCollectionCondition("col"))
+}
+
+// GetAllCollections 노출 기간과 관계없이 전체 컬렉션 목록 조회 (관리자)
+func GetAllCollections(db *sql.DB) ([]Collection, error) {
+	return queryCollections(db, "")
+}
+
+// GetActiveCollectionBySlug 현재 노출 기간인 컬렉션을 슬러그로 조회 (없으면 sql.ErrNoRows)
+func GetActiveCollectionBySlug(db *sql.DB, slug string) (*Collection, error) {
+	return scanCollection(db.QueryRow(`
+		SELECT `+collectionColumns+`
+		FROM Collections col
+		WHERE col.slug = ? AND `+activeCollectionCondition("col"), slug))
+}
+
+// GetCollection 컬렉션을 ID로 조회 (없으면 sql.ErrNoRows)
+func GetCollection(db *sql.DB, collectionID int64) (*Collection, error) {
+	return scanCollection(db.QueryRow(`
+		SELECT `+collectionColumns+`
+		FROM Collections col
+		WHERE col.id = ?`, collectionID))
+}
+
+// GetCollectionContents 컬렉션 콘텐츠를 순서대로 조회 (시청자가 볼 수 없는 콘텐츠는 제외, 최대 limit개)
+func GetCollectionContents(db *sql.DB, collectionID int64, viewer Viewer, limit int) ([]ContentListResponse, error) {
+	args := append([]interface{}{collectionID}, viewer.catalogArgs()...)
+	return queryContentList(db, viewer, `
+		SELECT
+			c.id, c.title, c.thumbnail_url, c.release_year
+		FROM
+			CollectionItems ci
+		JOIN
+			Contents c ON c.id = ci.content_id
+		WHERE
+			ci.collection_id = ? AND `+catalogCondition("c")+`
+		ORDER BY
+			ci.position ASC, c.id ASC
+		LIMIT ?
+	`, append(args, limit)...)
+}
+
+// GetCollectionContentIDs 컬렉션의 전체 콘텐츠 ID를 순서대로 조회 (관리자)
+func GetCollectionContentIDs(db *sql.DB, collectionID int64) ([]int64, error) {
+	rows, err := db.Query(`
+		SELECT content_id
+		FROM CollectionItems
+		WHERE collection_id = ?
+		ORDER BY position ASC, content_id ASC
+	`, collectionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	contentIDs := []int64{}
+	for rows.Next() {
+		var contentID int64
+		if err := rows.Scan(&contentID); err != nil {
+			return nil, err
+		}
+		contentIDs = append(contentIDs, contentID)
+	}
+	return contentIDs, rows.Err()
+}
+
+// checkCollectionSlugAvailable 다른 컬렉션이 슬러그를 사용 중이면 ErrCollectionSlugTaken
+func checkCollectionSlugAvailable(db *sql.DB, slug string, collectionID int64) error {
+	var taken bool
+	err := db.QueryRow("SELECT EXISTS(SELECT 1 FROM Collections WHERE slug = ? AND id <> ?)", slug, collectionID).Scan(&taken)
+	if err != nil {
+		return err
+	}
+	if taken {
+		return ErrCollectionSlugTaken
+	}
+	return nil
+}
+
+// CreateCollection 컬렉션 생성
+func CreateCollection(db *sql.DB, req *CollectionRequest) (int64, error) {
+	if err := checkCollectionSlugAvailable(db, req.Slug, 0); err != nil {
+		return 0, err
+	}
+
+	result, err := db.Exec(`
+		INSERT INTO Collections (slug, title, description, hero_image_url, position, starts_at, ends_at)
+		VALUES (?, ?, NULLIF(?, ''), NULLIF(?, ''), ?, ?, ?)
+	`, req.Slug, req.Title, req.Description, req.HeroImageURL, req.Position, req.StartsAt, req.EndsAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.LastInsertId()
+}
+
+// UpdateCollection 컬렉션 수정 (컬렉션이 없으면 sql.ErrNoRows)
+func UpdateCollection(db *sql.DB, collectionID int64, req *CollectionRequest) error {
+	if _, err := GetCollection(db, collectionID); err != nil {
+		return err
+	}
+	if err := checkCollectionSlugAvailable(db, req.Slug, collectionID); err != nil {
+		return err
+	}
+
+	_, err := db.Exec(`
+		UPDATE Collections
+		SET slug = ?, title = ?, description = NULLIF(?, ''), hero_image_url = NULLIF(?, ''), position = ?, starts_at = ?, ends_at = ?
+		WHERE id = ?
+	`, req.Slug, req.Title, req.Description, req.HeroImageURL, req.Position, req.StartsAt, req.EndsAt, collectionID)
+	return err
+}
+
+// DeleteCollection 컬렉션 삭제 (컬렉션이 없으면 sql.ErrNoRows)
+func DeleteCollection(db *sql.DB, collectionID int64) error {
+	result, err := db.Exec("DELETE FROM Collections WHERE id = ?", collectionID)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// ReplaceCollectionItems 컬렉션 콘텐츠 전체 교체 (목록 순서가 노출 순서)
+// 중복 ID는 처음 위치만 사용하고, 존재하지 않는 콘텐츠가 있으면 ErrCollectionContentMissing
+func ReplaceCollectionItems(db *sql.DB, collectionID int64, contentIDs []int64) ([]int64, error) {
+	ordered := []int64{}
+	seen := map[int64]bool{}
+	for _, contentID := range contentIDs {
+		if !seen[contentID] {
+			seen[contentID] = true
+			ordered = append(ordered, contentID)
+		}
+	}
+
+	if len(ordered) > 0 {
+		var count int
+		err := db.QueryRow(
+			"SELECT COUNT(*) FROM Contents WHERE id IN ("+inPlaceholders(len(ordered))+")",
+			int64Args(ordered)...,
+		).Scan(&count)
+		if err != nil {
+			return nil, err
+		}
+		if count != len(ordered) {
+			return nil, ErrCollectionContentMissing
+		}
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM CollectionItems WHERE collection_id = ?", collectionID); err != nil {
+		return nil, err
+	}
+	for position, contentID := range ordered {
+		if _, err := tx.Exec(
+			"INSERT INTO CollectionItems (collection_id, content_id, position) VALUES (?, ?, ?)",
+			collectionID, contentID, position,
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	return ordered, tx.Commit()
+}
+
+// ReorderCollections 컬렉션 노출 순서 변경 (목록 순서대로 0부터, 목록에 없는 컬렉션은 그 뒤로 기존 순서 유지)
+func ReorderCollections(db *sql.DB, collectionIDs []int64) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if len(collectionIDs) > 0 {
+		if _, err := tx.Exec(
+			"UPDATE Collections SET position = position + ? WHERE id NOT IN ("+inPlaceholders(len(collectionIDs))+")",
+			append([]interface{}{len(collectionIDs)}, int64Args(collectionIDs)...)...,
+		); err != nil {
+			return err
+		}
+	}
+	for position, collectionID := range collectionIDs {
+		if _, err := tx.Exec("UPDATE Collections SET position = ? WHERE id = ?", position, collectionID); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
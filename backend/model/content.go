@@ -30,6 +30,9 @@ type ContentListResponse struct {
 	ReleaseYear  int      `json:"release_year"`
 	Genres       []string `json:"genres"`
 	IsWishlisted bool     `json:"is_wishlisted"`
+
+	Rating   ContentRatingSummary `json:"rating"`              // 평가 집계
+	MyRating *UserRating          `json:"my_rating,omitempty"` // 내 평가 (로그인하고 평가한 경우)
 }
 
 // ContentDetailResponse 콘텐츠 상세 응답용 모델
@@ -45,6 +48,12 @@ type ContentDetailResponse struct {
 	Genres       []Genre `json:"genres"`
 	IsWishlisted bool    `json:"is_wishlisted"`
 	LastPosition int     `json:"last_position"`
+
+	MaturityLevel int               `json:"maturity_level"` // 시청 등급 (KMRB 기준 연령)
+	Ratings       map[string]string `json:"ratings"`        // 등급 체계별 표시 등급 (KMRB, MPAA)
+
+	Rating   ContentRatingSummary `json:"rating"`              // 평가 집계
+	MyRating *UserRating          `json:"my_rating,omitempty"` // 내 평가 (로그인하고 평가한 경우)
 }
 
 // Genre 장르 모델
@@ -69,144 +78,32 @@ type GenreFilterRequest struct {
 }
 
 // GetContentList 콘텐츠 목록 조회
-func GetContentList(db *sql.DB, userID int64, page, size int) (*PageInfo, error) {
-	// 페이징 설정
-	if page < 0 {
-		page = 0
-	}
-	if size <= 0 {
-		size = 10
-	}
-	offset := page * size
-
-	// 전체 콘텐츠 수 조회
-	var totalElements int
-	err := db.QueryRow("SELECT COUNT(*) FROM Contents").Scan(&totalElements)
-	if err != nil {
-		return nil, err
-	}
-
-	// 기본 콘텐츠 쿼리 (페이징 적용)
-	rows, err := db.Query(`
-		SELECT 
-			c.id, c.title, c.thumbnail_url, c.release_year
-		FROM 
-			Contents c
-		ORDER BY 
-			c.release_year DESC, c.title ASC
-		LIMIT ? OFFSET ?
-	`, size, offset)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	contentList := []ContentListResponse{}
-	contentIDs := []int64{}
-
-	// 기본 콘텐츠 정보 읽기
-	for rows.Next() {
-		var content ContentListResponse
-		if err := rows.Scan(&content.ID, &content.Title, &content.ThumbnailURL, &content.ReleaseYear); err != nil {
-			return nil, err
-		}
-		contentList = append(contentList, content)
-		contentIDs = append(contentIDs, content.ID)
-	}
-
-	// 각 콘텐츠의 장르 정보 조회
-	for i, contentID := range contentIDs {
-		genreRows, err := db.Query(`
-			SELECT 
-				g.name
-			FROM 
-				Genres g
-			JOIN 
-				ContentGenres cg ON g.id = cg.genre_id
-			WHERE 
-				cg.content_id = ?
-		`, contentID)
-		if err != nil {
-			return nil, err
-		}
-
-		var genres []string
-		for genreRows.Next() {
-			var genreName string
-			if err := genreRows.Scan(&genreName); err != nil {
-				genreRows.Close()
-				return nil, err
-			}
-			genres = append(genres, genreName)
-		}
-		genreRows.Close()
-		contentList[i].Genres = genres
-	}
-
-	// 로그인한 사용자가 있는 경우 찜 정보 조회
-	if userID > 0 {
-		for i, contentID := range contentIDs {
-			var count int
-			err := db.QueryRow(`
-				SELECT 
-					COUNT(*)
-				FROM 
-					Wishlists
-				WHERE 
-					user_id = ? AND content_id = ?
-			`, userID, contentID).Scan(&count)
-
-			if err != nil {
-				return nil, err
-			}
-
-			contentList[i].IsWishlisted = count > 0
-		}
-	}
-
-	// 페이징 정보 구성
-	totalPages := (totalElements + size - 1) / size // 올림 계산
-	numberOfElements := len(contentList)
-
-	pageInfo := &PageInfo{
-		Content: contentList,
-		Pageable: Pageable{
-			PageNumber: page,
-			PageSize:   size,
-			Offset:     offset,
-		},
-		TotalPages:       totalPages,
-		TotalElements:    totalElements,
-		Last:             page >= totalPages-1,
-		Size:             size,
-		Number:           page,
-		NumberOfElements: numberOfElements,
-		First:            page == 0,
-		Empty:            numberOfElements == 0,
-	}
-
-	return pageInfo, nil
+func GetContentList(db *sql.DB, viewer Viewer, page, size int, sort string) (*PageInfo, error) {
+	return queryContentListPage(db, viewer, "Contents c", "", nil, page, size, sort)
 }
 
-// GetContentDetail 콘텐츠 상세 정보 조회
-func GetContentDetail(db *sql.DB, contentID, userID int64) (*ContentDetailResponse, error) {
+// GetContentDetail 콘텐츠 상세 정보 조회 (시청자의 선호 언어로 번역)
+func GetContentDetail(db *sql.DB, contentID int64, viewer Viewer) (*ContentDetailResponse, error) {
+	profileID := viewer.ProfileID
+
 	// 콘텐츠 기본 정보 조회
 	var content ContentDetailResponse
 	err := db.QueryRow(`
 		SELECT 
-			id, title, description, thumbnail_url, video_url, duration, release_year
+			id, title, description, thumbnail_url, video_url, duration, release_year, maturity_level
 		FROM 
 			Contents
 		WHERE 
 			id = ?
 	`, contentID).Scan(
 		&content.ID, &content.Title, &content.Description,
-		&content.ThumbnailURL, &content.VideoURL, &content.Duration, &content.ReleaseYear,
+		&content.ThumbnailURL, &content.VideoURL, &content.Duration, &content.ReleaseYear, &content.MaturityLevel,
 	)
 
 	if err != nil {
 		return nil, err
 	}
+	content.Ratings = RatingLabels(content.MaturityLevel)
 
 	// 장르 정보 조회
 	genreRows, err := db.Query(`
@@ -234,8 +131,21 @@ func GetContentDetail(db *sql.DB, contentID, userID int64) (*ContentDetailRespon
 	}
 	content.Genres = genres
 
+	// 평가 집계와 내 평가 조회
+	summaries, myRatings, err := loadContentRatings(db, profileID, []int64{contentID})
+	if err != nil {
+		return nil, err
+	}
+	content.Rating = summaries[contentID]
+	content.MyRating = myRatings[contentID]
+
+	// 선호 언어가 있으면 제목, 설명, 장르 번역
+	if err := localizeContentDetail(db, viewer.Locales, &content); err != nil {
+		return nil, err
+	}
+
 	// 로그인한 사용자가 있는 경우 추가 정보 조회
-	if userID > 0 {
+	if profileID > 0 {
 		// 찜 상태 조회
 		var wishlistCount int
 		err := db.QueryRow(`
@@ -244,8 +154,8 @@ func GetContentDetail(db *sql.DB, contentID, userID int64) (*ContentDetailRespon
 			FROM 
 				Wishlists
 			WHERE 
-				user_id = ? AND content_id = ?
-		`, userID, contentID).Scan(&wishlistCount)
+				profile_id = ? AND content_id = ?
+		`, profileID, contentID).Scan(&wishlistCount)
 		if err != nil {
 			return nil, err
 		}
@@ -259,11 +169,11 @@ func GetContentDetail(db *sql.DB, contentID, userID int64) (*ContentDetailRespon
 			FROM 
 				ViewingHistories
 			WHERE 
-				user_id = ? AND content_id = ?
+				profile_id = ? AND content_id = ?
 			ORDER BY 
 				watched_at DESC
 			LIMIT 1
-		`, userID, contentID).Scan(&lastPosition)
+		`, profileID, contentID).Scan(&lastPosition)
 		if err != nil && err != sql.ErrNoRows {
 			return nil, err
 		}
@@ -276,257 +186,48 @@ func GetContentDetail(db *sql.DB, contentID, userID int64) (*ContentDetailRespon
 	return &content, nil
 }
 
-// SearchContents 콘텐츠 검색 (페이징 지원)
-func SearchContents(db *sql.DB, query string, userID int64, page, size int) (*PageInfo, error) {
-	// 페이징 설정
-	if page < 0 {
-		page = 0
-	}
-	if size <= 0 {
-		size = 10
-	}
-	offset := page * size
-
-	// 전체 검색 결과 수 조회
-	var totalElements int
-	err := db.QueryRow(`
-		SELECT COUNT(*) 
-		FROM Contents c
-		WHERE c.title LIKE ?
-	`, "%"+query+"%").Scan(&totalElements)
-	if err != nil {
-		return nil, err
-	}
-
-	// 검색 쿼리 실행 (페이징 적용)
-	rows, err := db.Query(`
-		SELECT 
-			c.id, c.title, c.thumbnail_url, c.release_year
-		FROM 
-			Contents c
-		WHERE 
-			c.title LIKE ?
-		ORDER BY 
-			c.release_year DESC, c.title ASC
-		LIMIT ? OFFSET ?
-	`, "%"+query+"%", size, offset)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	contentList := []ContentListResponse{}
-	contentIDs := []int64{}
-
-	// 기본 콘텐츠 정보 읽기
-	for rows.Next() {
-		var content ContentListResponse
-		if err := rows.Scan(&content.ID, &content.Title, &content.ThumbnailURL, &content.ReleaseYear); err != nil {
-			return nil, err
-		}
-		contentList = append(contentList, content)
-		contentIDs = append(contentIDs, content.ID)
-	}
-
-	// 각 콘텐츠의 장르 정보 조회
-	for i, contentID := range contentIDs {
-		genreRows, err := db.Query(`
-			SELECT 
-				g.name
-			FROM 
-				Genres g
-			JOIN 
-				ContentGenres cg ON g.id = cg.genre_id
-			WHERE 
-				cg.content_id = ?
-		`, contentID)
-		if err != nil {
-			return nil, err
-		}
-
-		var genres []string
-		for genreRows.Next() {
-			var genreName string
-			if err := genreRows.Scan(&genreName); err != nil {
-				genreRows.Close()
-				return nil, err
-			}
-			genres = append(genres, genreName)
-		}
-		genreRows.Close()
-		contentList[i].Genres = genres
-	}
-
-	// 로그인한 사용자가 있는 경우 찜 정보 조회
-	if userID > 0 {
-		for i, contentID := range contentIDs {
-			var count int
-			err := db.QueryRow(`
-				SELECT 
-					COUNT(*)
-				FROM 
-					Wishlists
-				WHERE 
-					user_id = ? AND content_id = ?
-			`, userID, contentID).Scan(&count)
-
-			if err != nil {
-				return nil, err
-			}
-
-			contentList[i].IsWishlisted = count > 0
-		}
-	}
+// ContentExists 콘텐츠 존재 여부 확인
+func ContentExists(db *sql.DB, contentID int64) (bool, error) {
+	var exists bool
+	err := db.QueryRow("SELECT EXISTS(SELECT 1 FROM Contents WHERE id = ?)", contentID).Scan(&exists)
+	return exists, err
+}
 
-	// 페이징 정보 구성
-	totalPages := (totalElements + size - 1) / size // 올림 계산
-	numberOfElements := len(contentList)
+// searchCondition 원문 제목 또는 번역된 제목으로 검색하는 WHERE 조건 (인자는 searchArgs)
+const searchCondition = "(c.title LIKE ? OR EXISTS (SELECT 1 FROM ContentTranslations ct WHERE ct.content_id = c.id AND ct.title LIKE ?))"
 
-	pageInfo := &PageInfo{
-		Content: contentList,
-		Pageable: Pageable{
-			PageNumber: page,
-			PageSize:   size,
-			Offset:     offset,
-		},
-		TotalPages:       totalPages,
-		TotalElements:    totalElements,
-		Last:             page >= totalPages-1,
-		Size:             size,
-		Number:           page,
-		NumberOfElements: numberOfElements,
-		First:            page == 0,
-		Empty:            numberOfElements == 0,
-	}
+// searchArgs 검색어를 searchCondition 인자로 변환
+func searchArgs(query string) []interface{} {
+	pattern := "%" + query + "%"
+	return []interface{}{pattern, pattern}
+}
 
-	return pageInfo, nil
+// SearchContents 콘텐츠 검색 (페이징 지원)
+func SearchContents(db *sql.DB, query string, viewer Viewer, page, size int, sort string) (*PageInfo, error) {
+	return queryContentListPage(db, viewer, "Contents c", searchCondition, searchArgs(query), page, size, sort)
 }
 
 // GetContentsByGenre 장르별 콘텐츠 조회 (페이징 지원)
-func GetContentsByGenre(db *sql.DB, genreID, userID int64, page, size int) (*PageInfo, error) {
-	// 페이징 설정
-	if page < 0 {
-		page = 0
-	}
-	if size <= 0 {
-		size = 10
-	}
-	offset := page * size
-
-	// 전체 장르별 콘텐츠 수 조회
-	var totalElements int
-	err := db.QueryRow(`
-		SELECT COUNT(*) 
-		FROM Contents c
-		JOIN ContentGenres cg ON c.id = cg.content_id
-		WHERE cg.genre_id = ?
-	`, genreID).Scan(&totalElements)
-	if err != nil {
-		return nil, err
-	}
-
-	// 장르별 콘텐츠 쿼리 실행 (페이징 적용)
-	rows, err := db.Query(`
-		SELECT 
-			c.id, c.title, c.thumbnail_url, c.release_year
-		FROM 
-			Contents c
-		JOIN 
-			ContentGenres cg ON c.id = cg.content_id
-		WHERE 
-			cg.genre_id = ?
-		ORDER BY 
-			c.release_year DESC, c.title ASC
-		LIMIT ? OFFSET ?
-	`, genreID, size, offset)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	contentList := []ContentListResponse{}
-	contentIDs := []int64{}
-
-	// 기본 콘텐츠 정보 읽기
-	for rows.Next() {
-		var content ContentListResponse
-		if err := rows.Scan(&content.ID, &content.Title, &content.ThumbnailURL, &content.ReleaseYear); err != nil {
-			return nil, err
-		}
-		contentList = append(contentList, content)
-		contentIDs = append(contentIDs, content.ID)
-	}
-
-	// 각 콘텐츠의 장르 정보 조회
-	for i, contentID := range contentIDs {
-		genreRows, err := db.Query(`
-			SELECT 
-				g.name
-			FROM 
-				Genres g
-			JOIN 
-				ContentGenres cg ON g.id = cg.genre_id
-			WHERE 
-				cg.content_id = ?
-		`, contentID)
-		if err != nil {
-			return nil, err
-		}
-
-		var genres []string
-		for genreRows.Next() {
-			var genreName string
-			if err := genreRows.Scan(&genreName); err != nil {
-				genreRows.Close()
-				return nil, err
-			}
-			genres = append(genres, genreName)
-		}
-		genreRows.Close()
-		contentList[i].Genres = genres
-	}
-
-	// 로그인한 사용자가 있는 경우 찜 정보 조회
-	if userID > 0 {
-		for i, contentID := range contentIDs {
-			var count int
-			err := db.QueryRow(`
-				SELECT 
-					COUNT(*)
-				FROM 
-					Wishlists
-				WHERE 
-					user_id = ? AND content_id = ?
-			`, userID, contentID).Scan(&count)
-
-			if err != nil {
-				return nil, err
-			}
-
-			contentList[i].IsWishlisted = count > 0
-		}
-	}
+func GetContentsByGenre(db *sql.DB, genreID int64, viewer Viewer, page, size int, sort string) (*PageInfo, error) {
+	return queryContentListPage(db, viewer,
+		"Contents c JOIN ContentGenres cg ON c.id = cg.content_id",
+		"cg.genre_id = ?", []interface{}{genreID}, page, size, sort)
+}
 
-	// 페이징 정보 구성
-	totalPages := (totalElements + size - 1) / size // 올림 계산
-	numberOfElements := len(contentList)
+// GetContentListByCursor 콘텐츠 목록 조회 (커서 페이징)
+func GetContentListByCursor(db *sql.DB, viewer Viewer, cursor string, size int) (*CursorPage, error) {
+	return queryContentListByCursor(db, viewer, cursorScope("contents"), "Contents c", "", nil, cursor, size)
+}
 
-	pageInfo := &PageInfo{
-		Content: contentList,
-		Pageable: Pageable{
-			PageNumber: page,
-			PageSize:   size,
-			Offset:     offset,
-		},
-		TotalPages:       totalPages,
-		TotalElements:    totalElements,
-		Last:             page >= totalPages-1,
-		Size:             size,
-		Number:           page,
-		NumberOfElements: numberOfElements,
-		First:            page == 0,
-		Empty:            numberOfElements == 0,
-	}
+// SearchContentsByCursor 콘텐츠 검색 (커서 페이징)
+func SearchContentsByCursor(db *sql.DB, query string, viewer Viewer, cursor string, size int) (*CursorPage, error) {
+	return queryContentListByCursor(db, viewer, cursorScope("search", query),
+		"Contents c", searchCondition, searchArgs(query), cursor, size)
+}
 
-	return pageInfo, nil
+// GetContentsByGenreByCursor 장르별 콘텐츠 조회 (커서 페이징)
+func GetContentsByGenreByCursor(db *sql.DB, genreID int64, viewer Viewer, cursor string, size int) (*CursorPage, error) {
+	return queryContentListByCursor(db, viewer, cursorScope("genre", genreID),
+		"Contents c JOIN ContentGenres cg ON c.id = cg.content_id",
+		"cg.genre_id = ?", []interface{}{genreID}, cursor, size)
 }
@@ -0,0 +1,307 @@
+package model
+
+import (
+	"database/sql"
+	"strings"
+)
+
+// queryContentListPage 콘텐츠 목록 오프셋 페이징 조회 공통 처리
+// from에는 조회 대상 테이블(조인 포함), filter에는 추가 WHERE 조건을 전달
+func queryContentListPage(db *sql.DB, viewer Viewer, from, filter string, filterArgs []interface{}, page, size int, sort string) (*PageInfo, error) {
+	// 페이징 설정
+	if page < 0 {
+		page = 0
+	}
+	if size <= 0 {
+		size = 10
+	}
+	offset := page * size
+
+	// 시청 등급/이용 가능 지역 조건과 필터 조건 결합
+	where := "WHERE " + catalogCondition("c")
+	whereArgs := viewer.catalogArgs()
+	if filter != "" {
+		where += " AND " + filter
+		whereArgs = append(whereArgs, filterArgs...)
+	}
+
+	// 전체 콘텐츠 수 조회
+	var totalElements int
+	err := db.QueryRow(`
+		SELECT COUNT(*)
+		FROM `+from+`
+		`+where, whereArgs...).Scan(&totalElements)
+	if err != nil {
+		return nil, err
+	}
+
+	// 정렬 옵션 적용 (인기도 순은 인기도 테이블 조인)
+	listFrom := from
+	orderBy := "c.release_year DESC, c.title ASC"
+	if sort == ContentSortPopularity {
+		listFrom += " LEFT JOIN ContentPopularity p ON p.content_id = c.id"
+		orderBy = decayedPopularityExpr("p") + " DESC, " + orderBy
+	}
+
+	// 콘텐츠 쿼리 실행 (페이징 적용)
+	args := append(append([]interface{}{}, whereArgs...), size, offset)
+	rows, err := db.Query(`
+		SELECT
+			c.id, c.title, c.thumbnail_url, c.release_year
+		FROM
+			`+listFrom+`
+		`+where+`
+		ORDER BY
+			`+orderBy+`
+		LIMIT ? OFFSET ?
+	`, args...)
+	if err != nil {
+		return nil, err
+	}
+	contentList, err := scanContentList(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	// 장르 및 찜 정보 추가
+	if err := attachContentListDetails(db, viewer, contentList); err != nil {
+		return nil, err
+	}
+
+	// 페이징 정보 구성
+	return newPageInfo(contentList, len(contentList), totalElements, page, size), nil
+}
+
+// queryContentListByCursor 콘텐츠 목록 키셋 조회 공통 처리
+func queryContentListByCursor(db *sql.DB, viewer Viewer, scope, from, filter string, filterArgs []interface{}, cursorValue string, size int) (*CursorPage, error) {
+	cursor, err := DecodeCursor(cursorValue, scope)
+	if err != nil {
+		return nil, err
+	}
+	size = normalizeCursorSize(size)
+
+	// 시청 등급/이용 가능 지역 조건, 필터 조건, 키셋 조건 결합
+	keysetWhere, keysetArgs, orderBy := contentKeyset(cursor)
+	conditions := []string{catalogCondition("c")}
+	args := viewer.catalogArgs()
+	if filter != "" {
+		conditions = append(conditions, filter)
+		args = append(args, filterArgs...)
+	}
+	if keysetWhere != "" {
+		conditions = append(conditions, keysetWhere)
+		args = append(args, keysetArgs...)
+	}
+	where := "WHERE " + strings.Join(conditions, " AND ")
+	args = append(args, size+1)
+
+	// 다음 페이지 존재 여부 확인을 위해 size+1개 조회
+	rows, err := db.Query(`
+		SELECT
+			c.id, c.title, c.thumbnail_url, c.release_year
+		FROM
+			`+from+`
+		`+where+`
+		ORDER BY
+			`+orderBy+`
+		LIMIT ?
+	`, args...)
+	if err != nil {
+		return nil, err
+	}
+	contentList, err := scanContentList(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	contentList, page := newCursorPage(contentList, size, cursor, scope, contentCursorKey)
+
+	// 장르 및 찜 정보 추가
+	if err := attachContentListDetails(db, viewer, contentList); err != nil {
+		return nil, err
+	}
+
+	page.Content = contentList
+	return page, nil
+}
+
+// queryContentList 콘텐츠 기본 정보를 조회하는 임의의 쿼리를 실행하고 장르, 찜, 평가, 번역 정보를 추가
+// query는 c.id, c.title, c.thumbnail_url, c.release_year 순서로 컬럼을 반환해야 함
+func queryContentList(db *sql.DB, viewer Viewer, query string, args ...interface{}) ([]ContentListResponse, error) {
+	rows, err := db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	contentList, err := scanContentList(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := attachContentListDetails(db, viewer, contentList); err != nil {
+		return nil, err
+	}
+
+	return contentList, nil
+}
+
+// scanContentList 콘텐츠 목록 기본 정보(id, title, thumbnail_url, release_year) 읽기
+func scanContentList(rows *sql.Rows) ([]ContentListResponse, error) {
+	defer rows.Close()
+
+	contentList := []ContentListResponse{}
+	for rows.Next() {
+		var content ContentListResponse
+		if err := rows.Scan(&content.ID, &content.Title, &content.ThumbnailURL, &content.ReleaseYear); err != nil {
+			return nil, err
+		}
+		contentList = append(contentList, content)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return contentList, nil
+}
+
+// attachContentListDetails 콘텐츠 목록 전체의 장르, 찜, 평가, 번역 정보를 일괄 조회하여 추가
+// 각각 한 번의 IN (...) 쿼리로 조회하므로 목록 크기와 무관하게 쿼리 수가 일정함
+func attachContentListDetails(db *sql.DB, viewer Viewer, contentList []ContentListResponse) error {
+	if len(contentList) == 0 {
+		return nil
+	}
+
+	contentIDs := make([]int64, 0, len(contentList))
+	for _, content := range contentList {
+		contentIDs = append(contentIDs, content.ID)
+	}
+
+	// 장르 정보 일괄 조회
+	genreNames, err := loadGenreNames(db, contentIDs)
+	if err != nil {
+		return err
+	}
+	for i := range contentList {
+		contentList[i].Genres = genreNames[contentList[i].ID]
+	}
+
+	// 로그인한 사용자가 있는 경우 찜 정보 일괄 조회 (찜 목록처럼 이미 찜 여부를 아는 콘텐츠는 제외)
+	uncheckedIDs := []int64{}
+	for _, content := range contentList {
+		if !content.IsWishlisted {
+			uncheckedIDs = append(uncheckedIDs, content.ID)
+		}
+	}
+	if viewer.ProfileID > 0 && len(uncheckedIDs) > 0 {
+		wishlisted, err := loadWishlistedContentIDs(db, viewer.ProfileID, uncheckedIDs)
+		if err != nil {
+			return err
+		}
+		for i := range contentList {
+			contentList[i].IsWishlisted = contentList[i].IsWishlisted || wishlisted[contentList[i].ID]
+		}
+	}
+
+	// 평가 집계와 내 평가 일괄 조회
+	summaries, myRatings, err := loadContentRatings(db, viewer.ProfileID, contentIDs)
+	if err != nil {
+		return err
+	}
+	for i := range contentList {
+		contentList[i].Rating = summaries[contentList[i].ID]
+		contentList[i].MyRating = myRatings[contentList[i].ID]
+	}
+
+	// 선호 언어가 있으면 제목과 장르 이름 번역
+	return localizeContentList(db, viewer.Locales, contentList)
+}
+
+// loadGenreNames 콘텐츠 ID별 장르 이름 목록 일괄 조회
+func loadGenreNames(db *sql.DB, contentIDs []int64) (map[int64][]string, error) {
+	genreNames := map[int64][]string{}
+	if len(contentIDs) == 0 {
+		return genreNames, nil
+	}
+
+	rows, err := db.Query(`
+		SELECT
+			cg.content_id, g.name
+		FROM
+			Genres g
+		JOIN
+			ContentGenres cg ON g.id = cg.genre_id
+		WHERE
+			cg.content_id IN (`+inPlaceholders(len(contentIDs))+`)
+		ORDER BY
+			cg.content_id, cg.id
+	`, int64Args(contentIDs)...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var contentID int64
+		var genreName string
+		if err := rows.Scan(&contentID, &genreName); err != nil {
+			return nil, err
+		}
+		genreNames[contentID] = append(genreNames[contentID], genreName)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return genreNames, nil
+}
+
+// loadWishlistedContentIDs 프로필이 찜한 콘텐츠 ID 집합 일괄 조회
+func loadWishlistedContentIDs(db *sql.DB, profileID int64, contentIDs []int64) (map[int64]bool, error) {
+	wishlisted := map[int64]bool{}
+	if len(contentIDs) == 0 {
+		return wishlisted, nil
+	}
+
+	args := append([]interface{}{profileID}, int64Args(contentIDs)...)
+	rows, err := db.Query(`
+		SELECT
+			content_id
+		FROM
+			Wishlists
+		WHERE
+			profile_id = ? AND content_id IN (`+inPlaceholders(len(contentIDs))+`)
+	`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var contentID int64
+		if err := rows.Scan(&contentID); err != nil {
+			return nil, err
+		}
+		wishlisted[contentID] = true
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return wishlisted, nil
+}
+
+// inPlaceholders IN 절에 사용할 플레이스홀더 문자열 생성 (예: "?, ?, ?")
+func inPlaceholders(count int) string {
+	if count <= 0 {
+		return ""
+	}
+	return strings.TrimSuffix(strings.Repeat("?, ", count), ", ")
+}
+
+// int64Args int64 슬라이스를 쿼리 인자로 변환
+func int64Args(values []int64) []interface{} {
+	args := make([]interface{}, 0, len(values))
+	for _, value := range values {
+		args = append(args, value)
+	}
+	return args
+}
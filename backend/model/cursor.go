@@ -0,0 +1,167 @@
+package model
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"strconv"
+	"time"
+)
+
+// 커서 이동 방향
+const (
+	CursorNext = "next" // 다음 페이지 방향
+	CursorPrev = "prev" // 이전 페이지 방향
+)
+
+// ErrInvalidCursor 디코딩할 수 없거나 조회 조건과 맞지 않는 커서
+var ErrInvalidCursor = errors.New("유효하지 않은 커서입니다")
+
+// Cursor 키셋 페이징 커서
+// 클라이언트에는 base64 문자열로만 전달되며 내부 구조는 노출하지 않음
+type Cursor struct {
+	Direction   string     `json:"d"`           // 이동 방향 (next, prev)
+	Scope       string     `json:"s"`           // 커서를 만든 조회 범위 (cursorScope)
+	ReleaseYear int        `json:"y,omitempty"` // 콘텐츠 정렬 키: 개봉 연도
+	Title       string     `json:"t,omitempty"` // 콘텐츠 정렬 키: 제목
+	At          *time.Time `json:"a,omitempty"` // 시간 정렬 키: 찜한 시각, 시청 시각 등
+	ID          int64      `json:"i"`           // 동일 정렬 키 구분용 ID
+}
+
+// EncodeCursor 커서를 불투명 문자열로 변환
+func EncodeCursor(cursor Cursor) string {
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// cursorScope 조회 종류와 필터 값으로 커서 범위 생성
+// 다른 목록이나 다른 검색어/장르에서 만든 커서를 거부하는 데 사용하며, 필터 값은 해시로만 남김
+func cursorScope(kind string, filterArgs ...interface{}) string {
+	if len(filterArgs) == 0 {
+		return kind
+	}
+	hash := fnv.New32a()
+	fmt.Fprint(hash, filterArgs...)
+	return kind + "." + strconv.FormatUint(uint64(hash.Sum32()), 36)
+}
+
+// DecodeCursor 불투명 문자열을 커서로 변환 (빈 문자열은 첫 페이지를 의미하므로 nil 반환)
+// 조회 범위가 scope와 다른 커서는 ErrInvalidCursor
+func DecodeCursor(value, scope string) (*Cursor, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var cursor Cursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	if cursor.Direction != CursorNext && cursor.Direction != CursorPrev {
+		return nil, ErrInvalidCursor
+	}
+	if cursor.Scope != scope || cursor.ID <= 0 {
+		return nil, ErrInvalidCursor
+	}
+
+	return &cursor, nil
+}
+
+// decodeTimeCursor 시간 정렬 목록의 커서 변환 (시간 정렬 키가 없으면 ErrInvalidCursor)
+func decodeTimeCursor(value, scope string) (*Cursor, error) {
+	cursor, err := DecodeCursor(value, scope)
+	if err != nil {
+		return nil, err
+	}
+	if cursor != nil && cursor.At == nil {
+		return nil, ErrInvalidCursor
+	}
+	return cursor, nil
+}
+
+// contentKeyset 콘텐츠 정렬(개봉 연도 DESC, 제목 ASC, ID ASC)에 대한 키셋 조건과 정렬 구문 생성
+func contentKeyset(cursor *Cursor) (string, []interface{}, string) {
+	if cursor == nil {
+		return "", nil, "c.release_year DESC, c.title ASC, c.id ASC"
+	}
+
+	args := []interface{}{cursor.ReleaseYear, cursor.ReleaseYear, cursor.Title, cursor.Title, cursor.ID}
+	if cursor.Direction == CursorPrev {
+		return "(c.release_year > ? OR (c.release_year = ? AND (c.title < ? OR (c.title = ? AND c.id < ?))))",
+			args, "c.release_year ASC, c.title DESC, c.id DESC"
+	}
+	return "(c.release_year < ? OR (c.release_year = ? AND (c.title > ? OR (c.title = ? AND c.id > ?))))",
+		args, "c.release_year DESC, c.title ASC, c.id ASC"
+}
+
+// timeKeyset 시간 역순 정렬(timeColumn DESC, idColumn DESC)에 대한 키셋 조건과 정렬 구문 생성
+func timeKeyset(timeColumn, idColumn string, cursor *Cursor) (string, []interface{}, string) {
+	if cursor == nil {
+		return "", nil, timeColumn + " DESC, " + idColumn + " DESC"
+	}
+
+	args := []interface{}{*cursor.At, *cursor.At, cursor.ID}
+	if cursor.Direction == CursorPrev {
+		return "(" + timeColumn + " > ? OR (" + timeColumn + " = ? AND " + idColumn + " > ?))",
+			args, timeColumn + " ASC, " + idColumn + " ASC"
+	}
+	return "(" + timeColumn + " < ? OR (" + timeColumn + " = ? AND " + idColumn + " < ?))",
+		args, timeColumn + " DESC, " + idColumn + " DESC"
+}
+
+// contentCursorKey 콘텐츠 목록 항목의 정렬 키 추출
+func contentCursorKey(content ContentListResponse) Cursor {
+	return Cursor{ReleaseYear: content.ReleaseYear, Title: content.Title, ID: content.ID}
+}
+
+// newCursorPage size+1개로 조회한 결과를 한 페이지로 정리하고 scope 범위의 이전/다음 커서 생성
+// 이전 방향으로 조회한 경우 역순으로 읽었으므로 원래 정렬 순서로 되돌림
+func newCursorPage[T any](items []T, size int, cursor *Cursor, scope string, keyOf func(T) Cursor) ([]T, *CursorPage) {
+	hasMore := len(items) > size
+	if hasMore {
+		items = items[:size]
+	}
+
+	hasNext, hasPrev := hasMore, cursor != nil
+	if cursor != nil && cursor.Direction == CursorPrev {
+		for i, j := 0, len(items)-1; i < j; i, j = i+1, j-1 {
+			items[i], items[j] = items[j], items[i]
+		}
+		hasNext, hasPrev = true, hasMore
+	}
+
+	page := &CursorPage{
+		Size:             size,
+		NumberOfElements: len(items),
+		HasNext:          hasNext && len(items) > 0,
+		HasPrev:          hasPrev && len(items) > 0,
+		Empty:            len(items) == 0,
+	}
+
+	if page.HasNext {
+		key := keyOf(items[len(items)-1])
+		key.Direction, key.Scope = CursorNext, scope
+		page.NextCursor = EncodeCursor(key)
+	}
+	if page.HasPrev {
+		key := keyOf(items[0])
+		key.Direction, key.Scope = CursorPrev, scope
+		page.PrevCursor = EncodeCursor(key)
+	}
+
+	return items, page
+}
+
+// normalizeCursorSize 커서 페이징 크기 기본값 적용
+func normalizeCursorSize(size int) int {
+	if size <= 0 {
+		return 10
+	}
+	return size
+}
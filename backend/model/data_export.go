@@ -0,0 +1,253 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+// 개인정보 내보내기 상태
+const (
+	DataExportPending   = "pending"   // 생성 중
+	DataExportCompleted = "completed" // 다운로드 가능
+	DataExportFailed    = "failed"    // 생성 실패
+)
+
+// DataExport 개인정보 내보내기 요청 모델
+// @Description 개인정보 내보내기(ZIP) 요청 상태
+type DataExport struct {
+	ID          int64      `json:"id"`                                                            // 내보내기 요청 ID
+	UserID      int64      `json:"-"`                                                             // 계정 ID
+	Status      string     `json:"status" example:"completed"`                                    // 상태 (pending, completed, failed)
+	FilePath    string     `json:"-"`                                                             // 생성된 ZIP 파일 경로
+	FileSize    int64      `json:"file_size"`                                                     // ZIP 파일 크기(바이트)
+	CreatedAt   time.Time  `json:"created_at"`                                                    // 요청일시
+	CompletedAt *time.Time `json:"completed_at"`                                                  // 생성 완료일시
+	ExpiresAt   time.Time  `json:"expires_at"`                                                    // 파일 삭제 예정일시
+	DownloadURL string     `json:"download_url,omitempty" example:"/api/users/export/1/download"` // 다운로드 경로 (완료된 경우)
+}
+
+// ExportedViewingHistory 내보내기용 시청 기록
+type ExportedViewingHistory struct {
+	ProfileID     int64     `json:"profile_id"`
+	ProfileName   string    `json:"profile_name"`
+	ContentID     int64     `json:"content_id"`
+	Title         string    `json:"title"`
+	WatchDuration int       `json:"watch_duration"`
+	LastPosition  int       `json:"last_position"`
+	IsCompleted   bool      `json:"is_completed"`
+	WatchedAt     time.Time `json:"watched_at"`
+}
+
+// ExportedWishlistItem 내보내기용 찜 목록 항목
+type ExportedWishlistItem struct {
+	ProfileID   int64     `json:"profile_id"`
+	ProfileName string    `json:"profile_name"`
+	ContentID   int64     `json:"content_id"`
+	Title       string    `json:"title"`
+	AddedAt     time.Time `json:"added_at"`
+}
+
+// ExportedSession 내보내기용 로그인 세션 (종료된 세션 포함)
+type ExportedSession struct {
+	DeviceName string     `json:"device_name"`
+	UserAgent  string     `json:"user_agent"`
+	IPAddress  string     `json:"ip_address"`
+	CreatedAt  time.Time  `json:"created_at"`
+	LastSeenAt time.Time  `json:"last_seen_at"`
+	RevokedAt  *time.Time `json:"revoked_at"`
+}
+
+// dataExportColumns 내보내기 요청 조회 컬럼 목록
+const dataExportColumns = "id, user_id, status, file_path, file_size, created_at, completed_at, expires_at"
+
+// scanDataExport 내보내기 요청 행 스캔
+func scanDataExport(row interface{ Scan(...interface{}) error }) (*DataExport, error) {
+	export := &DataExport{}
+	var completedAt sql.NullTime
+	if err := row.Scan(&export.ID, &export.UserID, &export.Status, &export.FilePath, &export.FileSize,
+		&export.CreatedAt, &completedAt, &export.ExpiresAt); err != nil {
+		return nil, err
+	}
+	if completedAt.Valid {
+		export.CompletedAt = &completedAt.Time
+	}
+	return export, nil
+}
+
+// CreateDataExport 내보내기 요청 생성 (생성 중 상태)
+func CreateDataExport(db *sql.DB, userID int64, expiresAt time.Time) (*DataExport, error) {
+	now := time.Now()
+	result, err := db.Exec(
+		"INSERT INTO DataExports (user_id, status, created_at, expires_at) VALUES (?, ?, ?, ?)",
+		userID, DataExportPending, now, expiresAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+
+	return &DataExport{ID: id, UserID: userID, Status: DataExportPending, CreatedAt: now, ExpiresAt: expiresAt}, nil
+}
+
+// GetDataExport 계정의 내보내기 요청 조회 (다른 계정의 요청이면 sql.ErrNoRows)
+func GetDataExport(db *sql.DB, userID, exportID int64) (*DataExport, error) {
+	return scanDataExport(db.QueryRow(
+		"SELECT "+dataExportColumns+" FROM DataExports WHERE id = ? AND user_id = ?",
+		exportID, userID,
+	))
+}
+
+// GetPendingDataExport since 이후 요청되어 아직 생성 중인 계정의 내보내기 요청 조회 (없으면 sql.ErrNoRows)
+func GetPendingDataExport(db *sql.DB, userID int64, since time.Time) (*DataExport, error) {
+	return scanDataExport(db.QueryRow(
+		"SELECT "+dataExportColumns+" FROM DataExports WHERE user_id = ? AND status = ? AND created_at > ? ORDER BY id DESC LIMIT 1",
+		userID, DataExportPending, since,
+	))
+}
+
+// CompleteDataExport 내보내기 생성 완료 처리
+func CompleteDataExport(db *sql.DB, exportID int64, filePath string, fileSize int64) error {
+	_, err := db.Exec(
+		"UPDATE DataExports SET status = ?, file_path = ?, file_size = ?, completed_at = ? WHERE id = ?",
+		DataExportCompleted, filePath, fileSize, time.Now(), exportID,
+	)
+	return err
+}
+
+// FailDataExport 내보내기 생성 실패 처리
+func FailDataExport(db *sql.DB, exportID int64) error {
+	_, err := db.Exec("UPDATE DataExports SET status = ? WHERE id = ?", DataExportFailed, exportID)
+	return err
+}
+
+// GetExpiredDataExports 보관 기간이 지난 내보내기 요청 목록 조회
+func GetExpiredDataExports(db *sql.DB) ([]DataExport, error) {
+	return queryDataExports(db, "SELECT "+dataExportColumns+" FROM DataExports WHERE expires_at <= ?", time.Now())
+}
+
+// GetUserDataExports 계정의 모든 내보내기 요청 조회
+func GetUserDataExports(db *sql.DB, userID int64) ([]DataExport, error) {
+	return queryDataExports(db, "SELECT "+dataExportColumns+" FROM DataExports WHERE user_id = ?", userID)
+}
+
+// queryDataExports 내보내기 요청 목록 조회
+func queryDataExports(db *sql.DB, query string, args ...interface{}) ([]DataExport, error) {
+	rows, err := db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	exports := []DataExport{}
+	for rows.Next() {
+		export, err := scanDataExport(rows)
+		if err != nil {
+			return nil, err
+		}
+		exports = append(exports, *export)
+	}
+	return exports, rows.Err()
+}
+
+// DeleteDataExport 내보내기 요청 삭제
+func DeleteDataExport(db *sql.DB, exportID int64) error {
+	_, err := db.Exec("DELETE FROM DataExports WHERE id = ?", exportID)
+	return err
+}
+
+// GetExportedViewingHistories 계정의 모든 프로필 시청 기록 조회
+func GetExportedViewingHistories(db *sql.DB, userID int64) ([]ExportedViewingHistory, error) {
+	rows, err := db.Query(`
+		SELECT
+			p.id, p.name, c.id, c.title, vh.watch_duration, vh.last_position, vh.is_completed, vh.watched_at
+		FROM
+			ViewingHistories vh
+		JOIN
+			Profiles p ON p.id = vh.profile_id
+		JOIN
+			Contents c ON c.id = vh.content_id
+		WHERE
+			p.user_id = ?
+		ORDER BY
+			p.id ASC, vh.watched_at DESC
+	`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	histories := []ExportedViewingHistory{}
+	for rows.Next() {
+		var h ExportedViewingHistory
+		if err := rows.Scan(&h.ProfileID, &h.ProfileName, &h.ContentID, &h.Title,
+			&h.WatchDuration, &h.LastPosition, &h.IsCompleted, &h.WatchedAt); err != nil {
+			return nil, err
+		}
+		histories = append(histories, h)
+	}
+	return histories, rows.Err()
+}
+
+// GetExportedWishlist 계정의 모든 프로필 찜 목록 조회
+func GetExportedWishlist(db *sql.DB, userID int64) ([]ExportedWishlistItem, error) {
+	rows, err := db.Query(`
+		SELECT
+			p.id, p.name, c.id, c.title, w.created_at
+		FROM
+			Wishlists w
+		JOIN
+			Profiles p ON p.id = w.profile_id
+		JOIN
+			Contents c ON c.id = w.content_id
+		WHERE
+			p.user_id = ?
+		ORDER BY
+			p.id ASC, w.created_at DESC
+	`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	items := []ExportedWishlistItem{}
+	for rows.Next() {
+		var item ExportedWishlistItem
+		if err := rows.Scan(&item.ProfileID, &item.ProfileName, &item.ContentID, &item.Title, &item.AddedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, rows.Err()
+}
+
+// GetExportedSessions 계정의 모든 로그인 세션 조회 (종료된 세션 포함, 최근 순)
+func GetExportedSessions(db *sql.DB, userID int64) ([]ExportedSession, error) {
+	rows, err := db.Query(`
+		SELECT device_name, user_agent, ip_address, created_at, last_seen_at, revoked_at
+		FROM UserSessions
+		WHERE user_id = ?
+		ORDER BY created_at DESC, id DESC
+	`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	sessions := []ExportedSession{}
+	for rows.Next() {
+		var session ExportedSession
+		var revokedAt sql.NullTime
+		if err := rows.Scan(&session.DeviceName, &session.UserAgent, &session.IPAddress,
+			&session.CreatedAt, &session.LastSeenAt, &revokedAt); err != nil {
+			return nil, err
+		}
+		if revokedAt.Valid {
+			session.RevokedAt = &revokedAt.Time
+		}
+		sessions = append(sessions, session)
+	}
+	return sessions, rows.Err()
+}
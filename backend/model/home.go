@@ -0,0 +1,225 @@
+package model
+
+import (
+	"database/sql"
+)
+
+// HomeRow 홈 화면 행 모델
+// @Description 홈 화면을 구성하는 하나의 콘텐츠 행
+type HomeRow struct {
+	Key          string      `json:"key" example:"trending"`                               // 행 식별자 (유형 또는 유형:대상 ID/슬러그)
+	Type         string      `json:"type" example:"trending"`                              // 행 유형
+	Title        string      `json:"title" example:"지금 뜨는 콘텐츠"`                            // 행 제목
+	Items        interface{} `json:"items"`                                                // 콘텐츠 목록 (이어보기는 시청 기록 목록)
+	HeroImageURL string      `json:"hero_image_url,omitempty" example:"/heroes/oscar.jpg"` // 대표 이미지 URL (컬렉션 행)
+}
+
+// HomeResponse 홈 화면 응답 모델
+// @Description 홈 화면 구성 응답 모델
+type HomeResponse struct {
+	IsAuthenticated bool      `json:"is_authenticated"` // 로그인 사용자 여부
+	Rows            []HomeRow `json:"rows"`             // 순서대로 정렬된 행 목록
+}
+
+// WatchedContent 시청한 콘텐츠 요약 정보
+type WatchedContent struct {
+	ContentID int64  `json:"content_id"`
+	Title     string `json:"title"`
+}
+
+// GetContinueWatching 이어보기 목록 조회 (시청 중이며 완료하지 않은 콘텐츠)
+func GetContinueWatching(db *sql.DB, viewer Viewer, limit int) ([]ViewingHistoryResponse, error) {
+	args := append([]interface{}{viewer.ProfileID}, viewer.catalogArgs()...)
+	rows, err := db.Query(`
+		SELECT
+			vh.id, vh.content_id, vh.watch_duration, vh.last_position, vh.watched_at, vh.is_completed,
+			c.title, c.thumbnail_url, c.duration
+		FROM
+			ViewingHistories vh
+		JOIN
+			Contents c ON vh.content_id = c.id
+		WHERE
+			vh.profile_id = ? AND vh.is_completed = false AND vh.last_position > 0
+			AND `+catalogCondition("c")+`
+		ORDER BY
+			vh.watched_at DESC
+		LIMIT ?
+	`, append(args, limit)...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	historyList := []ViewingHistoryResponse{}
+	for rows.Next() {
+		var history ViewingHistoryResponse
+		if err := rows.Scan(
+			&history.ID, &history.ContentID, &history.WatchDuration, &history.LastPosition,
+			&history.WatchedAt, &history.IsCompleted, &history.Title, &history.ThumbnailURL, &history.Duration,
+		); err != nil {
+			return nil, err
+		}
+		history.ProgressPercent = calculateProgressPercent(history.WatchDuration, history.Duration)
+		historyList = append(historyList, history)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// 선호 언어가 있으면 제목 번역
+	if err := localizeViewingHistories(db, viewer.Locales, historyList); err != nil {
+		return nil, err
+	}
+
+	return historyList, nil
+}
+
+// GetNewReleases 최근 등록된 콘텐츠 조회
+func GetNewReleases(db *sql.DB, viewer Viewer, limit int) ([]ContentListResponse, error) {
+	return queryContentList(db, viewer, `
+		SELECT
+			c.id, c.title, c.thumbnail_url, c.release_year
+		FROM
+			Contents c
+		WHERE
+			`+catalogCondition("c")+`
+		ORDER BY
+			c.created_at DESC, c.release_year DESC, c.id DESC
+		LIMIT ?
+	`, append(viewer.catalogArgs(), limit)...)
+}
+
+// GetTopGenres 상위 장르 조회 (선호 언어로 번역)
+// 로그인 사용자는 시청 기록이 많은 장르 순, 시청 기록이 없거나 비로그인이면 콘텐츠가 많은 장르 순
+func GetTopGenres(db *sql.DB, viewer Viewer, limit int) ([]Genre, error) {
+	genres := []Genre{}
+
+	if viewer.ProfileID > 0 {
+		rows, err := db.Query(`
+			SELECT
+				g.id, g.name, IFNULL(g.description, '')
+			FROM
+				Genres g
+			JOIN
+				ContentGenres cg ON g.id = cg.genre_id
+			JOIN
+				ViewingHistories vh ON vh.content_id = cg.content_id
+			WHERE
+				vh.profile_id = ?
+			GROUP BY
+				g.id, g.name, g.description
+			ORDER BY
+				COUNT(*) DESC, g.id ASC
+			LIMIT ?
+		`, viewer.ProfileID, limit)
+		if err != nil {
+			return nil, err
+		}
+		if genres, err = scanGenres(rows); err != nil {
+			return nil, err
+		}
+		if len(genres) > 0 {
+			return genres, localizeGenres(db, viewer.Locales, genres)
+		}
+	}
+
+	rows, err := db.Query(`
+		SELECT
+			g.id, g.name, IFNULL(g.description, '')
+		FROM
+			Genres g
+		JOIN
+			ContentGenres cg ON g.id = cg.genre_id
+		GROUP BY
+			g.id, g.name, g.description
+		ORDER BY
+			COUNT(*) DESC, g.id ASC
+		LIMIT ?
+	`, limit)
+	if err != nil {
+		return nil, err
+	}
+	if genres, err = scanGenres(rows); err != nil {
+		return nil, err
+	}
+	return genres, localizeGenres(db, viewer.Locales, genres)
+}
+
+// GetRecentlyWatchedContents 최근 시청한 콘텐츠 중 시청자가 지금 볼 수 있는 콘텐츠 조회 (선호 언어로 번역)
+func GetRecentlyWatchedContents(db *sql.DB, viewer Viewer, limit int) ([]WatchedContent, error) {
+	args := append([]interface{}{viewer.ProfileID}, viewer.catalogArgs()...)
+	rows, err := db.Query(`
+		SELECT
+			c.id, c.title
+		FROM
+			ViewingHistories vh
+		JOIN
+			Contents c ON vh.content_id = c.id
+		WHERE
+			vh.profile_id = ?
+			AND `+catalogCondition("c")+`
+		ORDER BY
+			vh.watched_at DESC, vh.id DESC
+		LIMIT ?
+	`, append(args, limit)...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	watched := []WatchedContent{}
+	for rows.Next() {
+		var content WatchedContent
+		if err := rows.Scan(&content.ContentID, &content.Title); err != nil {
+			return nil, err
+		}
+		watched = append(watched, content)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return watched, localizeWatchedContents(db, viewer.Locales, watched)
+}
+
+// GetBecauseYouWatched 특정 콘텐츠와 장르가 겹치는 콘텐츠 중 프로필이 보지 않은 콘텐츠 조회
+func GetBecauseYouWatched(db *sql.DB, viewer Viewer, contentID int64, limit int) ([]ContentListResponse, error) {
+	args := append([]interface{}{contentID, contentID, viewer.ProfileID}, viewer.catalogArgs()...)
+	return queryContentList(db, viewer, `
+		SELECT
+			c.id, c.title, c.thumbnail_url, c.release_year
+		FROM
+			Contents c
+		JOIN
+			ContentGenres cg ON cg.content_id = c.id
+		WHERE
+			cg.genre_id IN (SELECT genre_id FROM ContentGenres WHERE content_id = ?)
+			AND c.id <> ?
+			AND c.id NOT IN (SELECT content_id FROM ViewingHistories WHERE profile_id = ?)
+			AND `+catalogCondition("c")+`
+		GROUP BY
+			c.id, c.title, c.thumbnail_url, c.release_year
+		ORDER BY
+			COUNT(*) DESC, c.release_year DESC, c.id ASC
+		LIMIT ?
+	`, append(args, limit)...)
+}
+
+// scanGenres 장르 목록(id, name, description) 읽기
+func scanGenres(rows *sql.Rows) ([]Genre, error) {
+	defer rows.Close()
+
+	genres := []Genre{}
+	for rows.Next() {
+		var genre Genre
+		if err := rows.Scan(&genre.ID, &genre.Name, &genre.Description); err != nil {
+			return nil, err
+		}
+		genres = append(genres, genre)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return genres, nil
+}
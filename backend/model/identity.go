@@ -0,0 +1,81 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+// UserIdentity 소셜 로그인(OIDC) 제공자 계정 연결 모델
+type UserIdentity struct {
+	ID          int64     // 연결 고유 ID
+	UserID      int64     // 계정 ID
+	Provider    string    // 제공자 이름
+	Subject     string    // 제공자 내 사용자 식별자 (sub)
+	Email       string    // 연결 당시 제공자 이메일
+	CreatedAt   time.Time // 연결일시
+	LastLoginAt time.Time // 마지막 소셜 로그인 일시
+}
+
+// OIDCProviderResponse 소셜 로그인 제공자 응답 모델
+// @Description 로그인 화면에 표시할 소셜 로그인 제공자
+type OIDCProviderResponse struct {
+	Name        string `json:"name" example:"google"`         // 제공자 이름 (authorize/callback 경로에 사용)
+	DisplayName string `json:"display_name" example:"Google"` // 표시 이름
+}
+
+// OIDCAuthorizeResponse 소셜 로그인 시작 응답 모델
+// @Description 제공자 로그인 페이지 URL과 콜백 때 함께 보내야 하는 상태 토큰
+type OIDCAuthorizeResponse struct {
+	AuthorizationURL string `json:"authorization_url"` // 제공자 로그인 페이지 URL
+	StateToken       string `json:"state_token"`       // 콜백 요청에 그대로 전달할 상태 토큰
+	ExpiresIn        int64  `json:"expires_in"`        // 상태 토큰 유효 시간(초)
+}
+
+// OIDCCallbackRequest 소셜 로그인 콜백 요청 모델
+// @Description 제공자가 redirect_url로 전달한 code/state와 시작 시 받은 상태 토큰
+type OIDCCallbackRequest struct {
+	Code       string `json:"code" binding:"required"`                       // 인가 코드
+	State      string `json:"state" binding:"required"`                      // 제공자가 돌려준 state 값
+	StateToken string `json:"state_token" binding:"required"`                // authorize 응답의 상태 토큰
+	DeviceName string `json:"device_name" binding:"max=100" example:"거실 TV"` // 기기 이름 (세션 목록 표시용, 선택)
+}
+
+// GetUserIdentity 제공자와 제공자 사용자 식별자로 연결된 계정 조회
+func GetUserIdentity(db *sql.DB, provider, subject string) (*UserIdentity, error) {
+	identity := &UserIdentity{}
+	err := db.QueryRow(`
+		SELECT id, user_id, provider, subject, email, created_at, last_login_at
+		FROM UserIdentities
+		WHERE provider = ? AND subject = ?
+	`, provider, subject).Scan(
+		&identity.ID, &identity.UserID, &identity.Provider, &identity.Subject,
+		&identity.Email, &identity.CreatedAt, &identity.LastLoginAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return identity, nil
+}
+
+// CreateUserIdentity 계정에 제공자 계정 연결
+func CreateUserIdentity(db *sql.DB, identity *UserIdentity) error {
+	now := time.Now()
+	result, err := db.Exec(`
+		INSERT INTO UserIdentities (user_id, provider, subject, email, created_at, last_login_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+	`, identity.UserID, identity.Provider, identity.Subject, identity.Email, now, now)
+	if err != nil {
+		return err
+	}
+
+	identity.ID, err = result.LastInsertId()
+	identity.CreatedAt = now
+	identity.LastLoginAt = now
+	return err
+}
+
+// TouchUserIdentity 소셜 로그인 시 마지막 로그인 일시 갱신
+func TouchUserIdentity(db *sql.DB, id int64) error {
+	_, err := db.Exec("UPDATE UserIdentities SET last_login_at = ? WHERE id = ?", time.Now(), id)
+	return err
+}
@@ -0,0 +1,134 @@
+package model
+
+import (
+	"database/sql"
+	"fmt"
+	"math"
+	"time"
+)
+
+// 인기도 이벤트 가중치
+const (
+	PopularityWeightStreamStart = 1.0 // 스트리밍 시작
+	PopularityWeightCompletion  = 3.0 // 시청 완료
+	PopularityWeightWishlistAdd = 2.0 // 찜 추가
+)
+
+// PopularityRepeatWindow 같은 프로필의 재생 시작/시청 완료를 다시 인기도에 반영하기까지의 최소 간격
+const PopularityRepeatWindow = 24 * time.Hour
+
+// PopularityEvent 프로필 단위 인기도 이벤트
+// 같은 프로필/콘텐츠의 같은 이벤트는 Window 안에 한 번만 반영 (Window가 0이면 처음 한 번만)
+type PopularityEvent struct {
+	Name   string
+	Weight float64
+	Window time.Duration
+}
+
+// 프로필 단위 인기도 이벤트 종류
+var (
+	PopularityEventStreamStart = PopularityEvent{Name: "stream_start", Weight: PopularityWeightStreamStart, Window: PopularityRepeatWindow}
+	PopularityEventCompletion  = PopularityEvent{Name: "completion", Weight: PopularityWeightCompletion, Window: PopularityRepeatWindow}
+	PopularityEventWishlistAdd = PopularityEvent{Name: "wishlist_add", Weight: PopularityWeightWishlistAdd}
+)
+
+// PopularityHalfLife 인기도 점수 반감기 (이 시간이 지나면 이벤트 기여도가 절반으로 감소)
+const PopularityHalfLife = 72 * time.Hour
+
+// popularityDecayPerSecond 초당 지수 감쇠율 (ln2 / 반감기)
+var popularityDecayPerSecond = math.Ln2 / PopularityHalfLife.Seconds()
+
+// 콘텐츠 목록 정렬 옵션
+const (
+	ContentSortLatest     = "latest"     // 개봉 연도 최신순 (기본값)
+	ContentSortPopularity = "popularity" // 인기도 순
+)
+
+// IsValidContentSort 지원하는 정렬 옵션인지 확인 (빈 값은 기본 정렬)
+func IsValidContentSort(sort string) bool {
+	return sort == "" || sort == ContentSortLatest || sort == ContentSortPopularity
+}
+
+// decayedPopularityExpr 현재 시각 기준으로 감쇠가 적용된 인기도 점수 SQL 식
+// alias는 ContentPopularity 테이블 별칭
+func decayedPopularityExpr(alias string) string {
+	return fmt.Sprintf("IFNULL(%s.score * EXP(-%.12f * GREATEST(TIMESTAMPDIFF(SECOND, %s.updated_at, NOW()), 0)), 0)",
+		alias, popularityDecayPerSecond, alias)
+}
+
+// DecayPopularityScore 기준 시각의 점수를 지정 시각 기준으로 감쇠 (계산 검증 및 테스트용)
+func DecayPopularityScore(score float64, from, to time.Time) float64 {
+	elapsed := to.Sub(from).Seconds()
+	if elapsed <= 0 {
+		return score
+	}
+	return score * math.Exp(-popularityDecayPerSecond*elapsed)
+}
+
+// RecordPopularityEvent 인기도 이벤트 반영 (기존 점수를 감쇠시킨 뒤 가중치를 더함)
+// 저장된 점수는 updated_at 시점의 값이며, 조회 시 현재 시각까지의 감쇠를 적용함
+func RecordPopularityEvent(db *sql.DB, contentID int64, weight float64, at time.Time) error {
+	_, err := db.Exec(`
+		INSERT INTO ContentPopularity (content_id, score, updated_at)
+		VALUES (?, ?, ?)
+		ON DUPLICATE KEY UPDATE
+			score = score * EXP(-? * GREATEST(TIMESTAMPDIFF(SECOND, updated_at, VALUES(updated_at)), 0)) + VALUES(score),
+			updated_at = GREATEST(updated_at, VALUES(updated_at))
+	`, contentID, weight, at, popularityDecayPerSecond)
+	return err
+}
+
+// RecordProfilePopularityEvent 프로필의 인기도 이벤트 반영 (반복 이벤트로 점수가 부풀지 않도록 반영 기록을 먼저 남김)
+// 이미 반영된 이벤트(Window 안이거나 처음 한 번만 반영하는 이벤트)면 점수를 바꾸지 않고 false 반환
+func RecordProfilePopularityEvent(db *sql.DB, profileID, contentID int64, event PopularityEvent, at time.Time) (bool, error) {
+	claimed, err := claimPopularityEvent(db, profileID, contentID, event, at)
+	if err != nil || !claimed {
+		return false, err
+	}
+	return true, RecordPopularityEvent(db, contentID, event.Weight, at)
+}
+
+// claimPopularityEvent 이벤트 반영 기록 (처음이거나 마지막 반영이 Window보다 오래되었을 때만 true)
+func claimPopularityEvent(db *sql.DB, profileID, contentID int64, event PopularityEvent, at time.Time) (bool, error) {
+	var result sql.Result
+	var err error
+	if event.Window <= 0 {
+		result, err = db.Exec(`
+			INSERT IGNORE INTO PopularityEvents (profile_id, content_id, event, recorded_at)
+			VALUES (?, ?, ?, ?)
+		`, profileID, contentID, event.Name, at)
+	} else {
+		// 바뀐 행이 없으면 RowsAffected가 0이므로 Window 안의 반복 이벤트는 false
+		result, err = db.Exec(`
+			INSERT INTO PopularityEvents (profile_id, content_id, event, recorded_at)
+			VALUES (?, ?, ?, ?)
+			ON DUPLICATE KEY UPDATE
+				recorded_at = IF(recorded_at <= ?, VALUES(recorded_at), recorded_at)
+		`, profileID, contentID, event.Name, at, at.Add(-event.Window))
+	}
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+// GetTrendingContents 인기도 점수(시간 감쇠 적용) 순 콘텐츠 조회
+func GetTrendingContents(db *sql.DB, viewer Viewer, limit int) ([]ContentListResponse, error) {
+	return queryContentList(db, viewer, `
+		SELECT
+			c.id, c.title, c.thumbnail_url, c.release_year
+		FROM
+			Contents c
+		JOIN
+			ContentPopularity p ON p.content_id = c.id
+		WHERE
+			p.score > 0 AND `+catalogCondition("c")+`
+		ORDER BY
+			`+decayedPopularityExpr("p")+` DESC, c.release_year DESC, c.id ASC
+		LIMIT ?
+	`, append(viewer.catalogArgs(), limit)...)
+}
@@ -0,0 +1,237 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+// 프로필 시청 가능 연령 등급
+const (
+	MaturityLevelAll   = 0  // 전체 관람가
+	MaturityLevel12    = 12 // 12세 이상
+	MaturityLevel15    = 15 // 15세 이상
+	MaturityLevelAdult = 19 // 청소년 관람불가 (제한 없음)
+)
+
+// KidsMaxMaturityLevel 키즈 프로필의 최대 시청 등급
+const KidsMaxMaturityLevel = MaturityLevel12
+
+// DefaultProfileLanguage 프로필 기본 언어
+const DefaultProfileLanguage = "ko"
+
+// Profile 시청자 프로필 모델
+// @Description 하나의 계정에 속한 시청자 프로필 정보 (시청 기록/찜 목록은 프로필별로 관리)
+type Profile struct {
+	ID            int64     `db:"id" json:"id"`                         // 프로필 고유 ID
+	UserID        int64     `db:"user_id" json:"user_id"`               // 계정 ID
+	Name          string    `db:"name" json:"name"`                     // 프로필 이름
+	AvatarURL     string    `db:"avatar_url" json:"avatar_url"`         // 아바타 이미지 URL
+	Language      string    `db:"language" json:"language"`             // 선호 언어
+	MaturityLevel int       `db:"maturity_level" json:"maturity_level"` // 시청 가능 연령 등급
+	IsDefault     bool      `db:"is_default" json:"is_default"`         // 계정 기본 프로필 여부
+	IsKids        bool      `db:"is_kids" json:"is_kids"`               // 키즈 프로필 여부
+	CreatedAt     time.Time `db:"created_at" json:"created_at"`         // 생성일시
+	UpdatedAt     time.Time `db:"updated_at" json:"updated_at"`         // 수정일시
+}
+
+// ProfileRequest 프로필 생성/수정 요청 모델
+// @Description 프로필 생성 및 수정 시 클라이언트에서 전송하는 데이터 모델
+type ProfileRequest struct {
+	Name          string `json:"name" binding:"required,max=50" example:"아이"`                      // 프로필 이름
+	AvatarURL     string `json:"avatar_url" binding:"max=255" example:"/avatars/1.png"`            // 아바타 이미지 URL
+	Language      string `json:"language" binding:"max=10" example:"ko"`                           // 선호 언어 (기본값: ko)
+	MaturityLevel *int   `json:"maturity_level" binding:"omitempty,oneof=0 12 15 19" example:"12"` // 시청 가능 연령 등급 (기본값: 19, 키즈 프로필은 12)
+	IsKids        bool   `json:"is_kids" example:"true"`                                           // 키즈 프로필 여부
+}
+
+// ParentalPINRequest 보호자 PIN 설정 요청 모델
+// @Description 보호자 PIN 설정 시 클라이언트에서 전송하는 데이터 모델 (계정 비밀번호 확인 필요)
+type ParentalPINRequest struct {
+	CurrentPassword string `json:"current_password" binding:"required"`                       // 현재 계정 비밀번호
+	PIN             string `json:"pin" binding:"required,numeric,min=4,max=6" example:"1234"` // 보호자 PIN (숫자 4~6자리)
+}
+
+// ProfileSelectResponse 프로필 선택 응답 모델
+// @Description 프로필 선택 시 발급되는 프로필 전용 토큰과 프로필 정보
+type ProfileSelectResponse struct {
+	Token   string  `json:"token"`   // 프로필 전용 JWT 토큰
+	Profile Profile `json:"profile"` // 선택된 프로필 정보
+}
+
+// normalize 요청 값 중 비어 있는 항목에 기본값 적용
+func (r *ProfileRequest) normalize() {
+	if r.Language == "" {
+		r.Language = DefaultProfileLanguage
+	}
+	if r.MaturityLevel == nil {
+		level := MaturityLevelAdult
+		if r.IsKids {
+			level = KidsMaxMaturityLevel
+		}
+		r.MaturityLevel = &level
+	}
+	// 키즈 프로필은 최대 시청 등급을 넘을 수 없음
+	if r.IsKids && *r.MaturityLevel > KidsMaxMaturityLevel {
+		level := KidsMaxMaturityLevel
+		r.MaturityLevel = &level
+	}
+}
+
+// profileColumns 프로필 조회 컬럼 목록
+const profileColumns = "id, user_id, name, avatar_url, language, maturity_level, is_default, is_kids, created_at, updated_at"
+
+// scanProfile 프로필 행 스캔
+func scanProfile(row interface{ Scan(...interface{}) error }) (*Profile, error) {
+	profile := &Profile{}
+	err := row.Scan(&profile.ID, &profile.UserID, &profile.Name, &profile.AvatarURL, &profile.Language,
+		&profile.MaturityLevel, &profile.IsDefault, &profile.IsKids, &profile.CreatedAt, &profile.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return profile, nil
+}
+
+// CreateProfile 새 프로필 생성
+func CreateProfile(db *sql.DB, userID int64, req *ProfileRequest, isDefault bool) (*Profile, error) {
+	req.normalize()
+	now := time.Now()
+
+	result, err := db.Exec(`
+		INSERT INTO Profiles (user_id, name, avatar_url, language, maturity_level, is_default, is_kids, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`, userID, req.Name, req.AvatarURL, req.Language, *req.MaturityLevel, isDefault, req.IsKids, now, now)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Profile{
+		ID:            id,
+		UserID:        userID,
+		Name:          req.Name,
+		AvatarURL:     req.AvatarURL,
+		Language:      req.Language,
+		MaturityLevel: *req.MaturityLevel,
+		IsDefault:     isDefault,
+		IsKids:        req.IsKids,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}, nil
+}
+
+// GetProfilesByUserID 계정의 프로필 목록 조회 (기본 프로필 우선)
+func GetProfilesByUserID(db *sql.DB, userID int64) ([]Profile, error) {
+	rows, err := db.Query(`
+		SELECT `+profileColumns+`
+		FROM Profiles
+		WHERE user_id = ?
+		ORDER BY is_default DESC, id ASC
+	`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	profiles := []Profile{}
+	for rows.Next() {
+		profile, err := scanProfile(rows)
+		if err != nil {
+			return nil, err
+		}
+		profiles = append(profiles, *profile)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return profiles, nil
+}
+
+// GetProfile 계정에 속한 프로필 조회 (다른 계정의 프로필이면 sql.ErrNoRows)
+func GetProfile(db *sql.DB, userID, profileID int64) (*Profile, error) {
+	return scanProfile(db.QueryRow(`
+		SELECT `+profileColumns+`
+		FROM Profiles
+		WHERE id = ? AND user_id = ?
+	`, profileID, userID))
+}
+
+// GetProfileLanguage 프로필 선호 언어 조회
+func GetProfileLanguage(db *sql.DB, profileID int64) (string, error) {
+	var language string
+	err := db.QueryRow("SELECT language FROM Profiles WHERE id = ?", profileID).Scan(&language)
+	return language, err
+}
+
+// GetDefaultProfile 계정의 기본 프로필 조회
+func GetDefaultProfile(db *sql.DB, userID int64) (*Profile, error) {
+	return scanProfile(db.QueryRow(`
+		SELECT `+profileColumns+`
+		FROM Profiles
+		WHERE user_id = ? AND is_default = true
+		ORDER BY id ASC
+		LIMIT 1
+	`, userID))
+}
+
+// CountProfiles 계정의 프로필 수 조회
+func CountProfiles(db *sql.DB, userID int64) (int, error) {
+	var count int
+	err := db.QueryRow("SELECT COUNT(*) FROM Profiles WHERE user_id = ?", userID).Scan(&count)
+	return count, err
+}
+
+// UpdateProfile 프로필 정보 수정
+func UpdateProfile(db *sql.DB, userID, profileID int64, req *ProfileRequest) error {
+	req.normalize()
+
+	_, err := db.Exec(`
+		UPDATE Profiles
+		SET name = ?, avatar_url = ?, language = ?, maturity_level = ?, is_kids = ?, updated_at = ?
+		WHERE id = ? AND user_id = ?
+	`, req.Name, req.AvatarURL, req.Language, *req.MaturityLevel, req.IsKids, time.Now(), profileID, userID)
+	return err
+}
+
+// DeleteProfile 프로필 삭제 (시청 기록/찜 목록/평가는 함께 삭제되며 평가는 콘텐츠 집계에서도 제외)
+func DeleteProfile(db *sql.DB, userID, profileID int64) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := removeProfileRatingStats(tx, userID, profileID); err != nil {
+		return err
+	}
+	if _, err := tx.Exec("DELETE FROM Profiles WHERE id = ? AND user_id = ?", profileID, userID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetParentalPINHash 계정의 보호자 PIN 해시 조회 (설정되지 않았으면 빈 문자열)
+func GetParentalPINHash(db *sql.DB, userID int64) (string, error) {
+	var hash sql.NullString
+	err := db.QueryRow("SELECT parental_pin_hash FROM Users WHERE id = ?", userID).Scan(&hash)
+	if err != nil {
+		return "", err
+	}
+	return hash.String, nil
+}
+
+// SetParentalPIN 계정의 보호자 PIN 설정
+func SetParentalPIN(db *sql.DB, userID int64, pin string) error {
+	hashedPIN, err := HashPassword(pin)
+	if err != nil {
+		return err
+	}
+
+	_, err = db.Exec("UPDATE Users SET parental_pin_hash = ?, updated_at = ? WHERE id = ?", hashedPIN, time.Now(), userID)
+	return err
+}
@@ -0,0 +1,308 @@
+package model
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// ErrInvalidContentSchedule 공개 일정 설정 오류
+var ErrInvalidContentSchedule = errors.New("공개 종료일시는 공개 일시보다 뒤여야 합니다")
+
+// ContentSchedule 콘텐츠 공개 일정 모델
+// @Description 콘텐츠 공개/공개 종료 일시 (null이면 제한 없음, 공개 일시 전에는 공개 예정 목록에만 노출)
+type ContentSchedule struct {
+	PublishAt   *time.Time `json:"publish_at"`   // 공개 일시
+	UnpublishAt *time.Time `json:"unpublish_at"` // 공개 종료 일시
+}
+
+// Validate 공개 일정 검증
+func (s *ContentSchedule) Validate() error {
+	if s.PublishAt != nil && s.UnpublishAt != nil && !s.PublishAt.Before(*s.UnpublishAt) {
+		return ErrInvalidContentSchedule
+	}
+	return nil
+}
+
+// ComingSoonResponse 공개 예정 콘텐츠 응답 모델
+// @Description 공개 예정 콘텐츠 목록 조회 응답에 사용되는 모델
+type ComingSoonResponse struct {
+	ContentListResponse
+	PublishAt     time.Time `json:"publish_at"`      // 공개 일시
+	IsReminderSet bool      `json:"is_reminder_set"` // 공개 알림 신청 여부
+}
+
+// DueContentReminder 발송할 공개 알림
+type DueContentReminder struct {
+	ID           int64
+	ContentID    int64
+	ContentTitle string
+	ProfileName  string
+	Language     string
+	Email        string
+	Attempts     int // 지금까지 발송에 실패한 횟수
+}
+
+// publishedCondition 현재 공개 중인 콘텐츠만 조회하는 WHERE 조건 (인자 없음)
+func publishedCondition(alias string) string {
+	return "(" + alias + ".publish_at IS NULL OR " + alias + ".publish_at <= NOW())" +
+		" AND (" + alias + ".unpublish_at IS NULL OR " + alias + ".unpublish_at > NOW())"
+}
+
+// IsContentPublished 콘텐츠가 현재 공개 중인지 확인 (콘텐츠가 없으면 sql.ErrNoRows)
+func IsContentPublished(db *sql.DB, contentID int64) (bool, error) {
+	var published bool
+	err := db.QueryRow(`
+		SELECT `+publishedCondition("c")+`
+		FROM Contents c
+		WHERE c.id = ?
+	`, contentID).Scan(&published)
+	return published, err
+}
+
+// GetContentSchedule 콘텐츠 공개 일정 조회 (콘텐츠가 없으면 sql.ErrNoRows)
+func GetContentSchedule(db *sql.DB, contentID int64) (*ContentSchedule, error) {
+	var publishAt, unpublishAt sql.NullTime
+	err := db.QueryRow("SELECT publish_at, unpublish_at FROM Contents WHERE id = ?", contentID).Scan(&publishAt, &unpublishAt)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule := &ContentSchedule{}
+	if publishAt.Valid {
+		schedule.PublishAt = &publishAt.Time
+	}
+	if unpublishAt.Valid {
+		schedule.UnpublishAt = &unpublishAt.Time
+	}
+	return schedule, nil
+}
+
+// SetContentSchedule 콘텐츠 공개 일정 변경 (콘텐츠가 없으면 sql.ErrNoRows)
+func SetContentSchedule(db *sql.DB, contentID int64, schedule *ContentSchedule) error {
+	if _, err := db.Exec(
+		"UPDATE Contents SET publish_at = ?, unpublish_at = ? WHERE id = ?",
+		schedule.PublishAt, schedule.UnpublishAt, contentID,
+	); err != nil {
+		return err
+	}
+
+	// 값이 같으면 변경된 행이 0이므로 존재 여부는 따로 확인
+	exists, err := ContentExists(db, contentID)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// GetComingSoonContents 공개 예정 콘텐츠 조회 (공개 일시가 가까운 순)
+// 시청 등급과, 공개 시점에 시청자 국가에서 이용 가능한지를 함께 확인
+func GetComingSoonContents(db *sql.DB, viewer Viewer, limit int) ([]ComingSoonResponse, error) {
+	args := append(viewer.catalogArgs(), limit)
+	rows, err := db.Query(`
+		SELECT
+			c.id, c.title, c.thumbnail_url, c.release_year, c.publish_at
+		FROM
+			Contents c
+		WHERE
+			c.publish_at > NOW() AND (c.unpublish_at IS NULL OR c.unpublish_at > c.publish_at)
+			AND `+maturityCondition("c")+`
+			AND `+availabilityConditionAt("c", "c.publish_at")+`
+		ORDER BY
+			c.publish_at ASC, c.id ASC
+		LIMIT ?
+	`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	contentList := []ContentListResponse{}
+	publishAt := map[int64]time.Time{}
+	for rows.Next() {
+		var content ContentListResponse
+		var at time.Time
+		if err := rows.Scan(&content.ID, &content.Title, &content.ThumbnailURL, &content.ReleaseYear, &at); err != nil {
+			return nil, err
+		}
+		contentList = append(contentList, content)
+		publishAt[content.ID] = at
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// 장르 및 찜 정보 추가
+	if err := attachContentListDetails(db, viewer, contentList); err != nil {
+		return nil, err
+	}
+
+	// 로그인한 경우 공개 알림 신청 여부 추가
+	reminded := map[int64]bool{}
+	if viewer.ProfileID > 0 && len(contentList) > 0 {
+		contentIDs := make([]int64, 0, len(contentList))
+		for _, content := range contentList {
+			contentIDs = append(contentIDs, content.ID)
+		}
+		reminded, err = loadRemindedContentIDs(db, viewer.ProfileID, contentIDs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	comingSoon := make([]ComingSoonResponse, 0, len(contentList))
+	for _, content := range contentList {
+		comingSoon = append(comingSoon, ComingSoonResponse{
+			ContentListResponse: content,
+			PublishAt:           publishAt[content.ID],
+			IsReminderSet:       reminded[content.ID],
+		})
+	}
+	return comingSoon, nil
+}
+
+// loadRemindedContentIDs 프로필이 공개 알림을 신청한(아직 발송 전인) 콘텐츠 ID 집합 일괄 조회
+func loadRemindedContentIDs(db *sql.DB, profileID int64, contentIDs []int64) (map[int64]bool, error) {
+	args := append([]interface{}{profileID}, int64Args(contentIDs)...)
+	rows, err := db.Query(`
+		SELECT
+			content_id
+		FROM
+			ContentReminders
+		WHERE
+			profile_id = ? AND notified_at IS NULL AND content_id IN (`+inPlaceholders(len(contentIDs))+`)
+	`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	reminded := map[int64]bool{}
+	for rows.Next() {
+		var contentID int64
+		if err := rows.Scan(&contentID); err != nil {
+			return nil, err
+		}
+		reminded[contentID] = true
+	}
+	return reminded, rows.Err()
+}
+
+// GetUpcomingContentAccess 공개 예정 콘텐츠를 시청자 프로필의 시청 등급으로 볼 수 있는지와
+// 공개 시점에 시청자 국가에서 이용 가능한지 조회 (공개 예정 목록과 같은 조건, 콘텐츠가 없으면 sql.ErrNoRows)
+func GetUpcomingContentAccess(db *sql.DB, viewer Viewer, contentID int64) (bool, bool, error) {
+	var allowed, available bool
+	args := append(viewer.catalogArgs(), contentID)
+	err := db.QueryRow(`
+		SELECT `+maturityCondition("c")+`, `+availabilityConditionAt("c", "c.publish_at")+`
+		FROM Contents c
+		WHERE c.id = ?
+	`, args...).Scan(&allowed, &available)
+	return allowed, available, err
+}
+
+// SetContentReminder 공개 알림 신청 (이미 신청했으면 실패 기록을 지우고 다시 발송 대기 상태로)
+func SetContentReminder(db *sql.DB, profileID, contentID int64) error {
+	_, err := db.Exec(`
+		INSERT INTO ContentReminders (profile_id, content_id)
+		VALUES (?, ?)
+		ON DUPLICATE KEY UPDATE notified_at = NULL, attempts = 0, next_attempt_at = NULL, claimed_at = NULL, created_at = CURRENT_TIMESTAMP
+	`, profileID, contentID)
+	return err
+}
+
+// DeleteContentReminder 공개 알림 신청 취소
+func DeleteContentReminder(db *sql.DB, profileID, contentID int64) error {
+	_, err := db.Exec("DELETE FROM ContentReminders WHERE profile_id = ? AND content_id = ?", profileID, contentID)
+	return err
+}
+
+// ClaimDueContentReminders 공개된 콘텐츠의 발송 대기 중인 공개 알림을 발송 작업이 가져감 (오래된 신청 순, 최대 limit건)
+// 가져간 알림은 claimed_at을 기록하여 claimTimeout 동안 다른 인스턴스가 다시 가져가지 않으며,
+// 재시도 대기 중이거나 maxAttempts번 실패한 알림과 탈퇴했거나 비활성화된 계정의 알림은 제외
+func ClaimDueContentReminders(db *sql.DB, limit, maxAttempts int, claimTimeout time.Duration) ([]DueContentReminder, error) {
+	tx, err := db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	now := time.Now()
+	rows, err := tx.Query(`
+		SELECT
+			r.id, c.id, c.title, p.name, p.language, u.email, r.attempts
+		FROM
+			ContentReminders r
+		JOIN
+			Contents c ON c.id = r.content_id
+		JOIN
+			Profiles p ON p.id = r.profile_id
+		JOIN
+			Users u ON u.id = p.user_id
+		WHERE
+			r.notified_at IS NULL AND `+publishedCondition("c")+`
+			AND r.attempts < ? AND (r.next_attempt_at IS NULL OR r.next_attempt_at <= ?)
+			AND (r.claimed_at IS NULL OR r.claimed_at <= ?)
+			AND u.is_active = TRUE AND u.deleted_at IS NULL
+		ORDER BY
+			r.id ASC
+		LIMIT ?
+		FOR UPDATE OF r SKIP LOCKED
+	`, maxAttempts, now, now.Add(-claimTimeout), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	reminders := []DueContentReminder{}
+	for rows.Next() {
+		var reminder DueContentReminder
+		if err := rows.Scan(
+			&reminder.ID, &reminder.ContentID, &reminder.ContentTitle,
+			&reminder.ProfileName, &reminder.Language, &reminder.Email, &reminder.Attempts,
+		); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		reminders = append(reminders, reminder)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(reminders) == 0 {
+		return reminders, nil
+	}
+
+	reminderIDs := make([]int64, 0, len(reminders))
+	for _, reminder := range reminders {
+		reminderIDs = append(reminderIDs, reminder.ID)
+	}
+	args := append([]interface{}{now}, int64Args(reminderIDs)...)
+	if _, err := tx.Exec(
+		"UPDATE ContentReminders SET claimed_at = ? WHERE id IN ("+inPlaceholders(len(reminderIDs))+")", args...,
+	); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return reminders, nil
+}
+
+// MarkContentReminderNotified 공개 알림 발송 완료 처리
+func MarkContentReminderNotified(db *sql.DB, reminderID int64) error {
+	_, err := db.Exec("UPDATE ContentReminders SET notified_at = NOW(), claimed_at = NULL WHERE id = ?", reminderID)
+	return err
+}
+
+// MarkContentReminderFailed 공개 알림 발송 실패 기록 (retryAt 이후 다시 발송 대상)
+func MarkContentReminderFailed(db *sql.DB, reminderID int64, retryAt time.Time) error {
+	_, err := db.Exec(
+		"UPDATE ContentReminders SET attempts = attempts + 1, next_attempt_at = ?, claimed_at = NULL WHERE id = ?",
+		retryAt, reminderID,
+	)
+	return err
+}
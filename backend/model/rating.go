@@ -0,0 +1,104 @@
+package model
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// 관람 등급 체계
+const (
+	RatingSystemKMRB = "KMRB" // 영상물등급위원회 (기본)
+	RatingSystemMPAA = "MPAA" // 미국 영화협회
+)
+
+// ErrUnknownRating 지원하지 않는 관람 등급
+var ErrUnknownRating = errors.New("알 수 없는 관람 등급")
+
+// maturityLevels 내부 시청 등급 목록 (낮은 순)
+var maturityLevels = []int{MaturityLevelAll, MaturityLevel12, MaturityLevel15, MaturityLevelAdult}
+
+// ratingLabels 등급 체계별 내부 시청 등급 → 표시 등급
+var ratingLabels = map[string]map[int]string{
+	RatingSystemKMRB: {
+		MaturityLevelAll:   "ALL",
+		MaturityLevel12:    "12",
+		MaturityLevel15:    "15",
+		MaturityLevelAdult: "19",
+	},
+	RatingSystemMPAA: {
+		MaturityLevelAll:   "G",
+		MaturityLevel12:    "PG",
+		MaturityLevel15:    "PG-13",
+		MaturityLevelAdult: "R",
+	},
+}
+
+// ratingAliases 등급 체계별 표시 등급 → 내부 시청 등급 (표시 등급 외 별칭 포함)
+var ratingAliases = map[string]map[string]int{
+	RatingSystemKMRB: {
+		"ALL": MaturityLevelAll, "0": MaturityLevelAll, "전체": MaturityLevelAll,
+		"12": MaturityLevel12,
+		"15": MaturityLevel15,
+		"18": MaturityLevelAdult, "19": MaturityLevelAdult, "청불": MaturityLevelAdult,
+	},
+	RatingSystemMPAA: {
+		"G":     MaturityLevelAll,
+		"PG":    MaturityLevel12,
+		"PG-13": MaturityLevel15,
+		"R":     MaturityLevelAdult,
+		"NC-17": MaturityLevelAdult,
+	},
+}
+
+// IsValidMaturityLevel 지원하는 시청 등급인지 확인
+func IsValidMaturityLevel(level int) bool {
+	for _, known := range maturityLevels {
+		if level == known {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseRating 등급 체계의 표시 등급을 내부 시청 등급으로 변환 (예: MPAA "PG-13" → 15)
+func ParseRating(system, label string) (int, error) {
+	aliases, ok := ratingAliases[strings.ToUpper(system)]
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", ErrUnknownRating, system)
+	}
+	level, ok := aliases[strings.ToUpper(strings.TrimSpace(label))]
+	if !ok {
+		return 0, fmt.Errorf("%w: %s %s", ErrUnknownRating, system, label)
+	}
+	return level, nil
+}
+
+// RatingLabel 내부 시청 등급을 등급 체계의 표시 등급으로 변환
+func RatingLabel(system string, level int) string {
+	return ratingLabels[strings.ToUpper(system)][level]
+}
+
+// RatingLabels 내부 시청 등급의 등급 체계별 표시 등급
+func RatingLabels(level int) map[string]string {
+	labels := map[string]string{}
+	for system := range ratingLabels {
+		labels[system] = RatingLabel(system, level)
+	}
+	return labels
+}
+
+// maturityCondition 프로필 시청 등급 이하의 콘텐츠만 조회하는 WHERE 조건
+// 인자로 프로필 ID 하나가 필요하며, 비로그인(프로필 없음)은 제한하지 않음
+func maturityCondition(alias string) string {
+	return fmt.Sprintf("%s.maturity_level <= IFNULL((SELECT mp.maturity_level FROM Profiles mp WHERE mp.id = ?), %d)",
+		alias, MaturityLevelAdult)
+}
+
+// GetContentMaturityLevel 콘텐츠 시청 등급 조회
+func GetContentMaturityLevel(db *sql.DB, contentID int64) (int, error) {
+	var level int
+	err := db.QueryRow("SELECT maturity_level FROM Contents WHERE id = ?", contentID).Scan(&level)
+	return level, err
+}
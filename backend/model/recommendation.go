@@ -0,0 +1,177 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+// 유사도 계산 근거
+const (
+	SimilaritySourceCoOccurrence = "co_occurrence" // 시청/찜/긍정 평가 동시 발생 기반
+	SimilaritySourceGenre        = "genre"         // 장르 겹침 기반 (콜드 스타트 보완)
+)
+
+// ContentSimilarity 콘텐츠 간 유사도 모델
+type ContentSimilarity struct {
+	ContentID        int64   `db:"content_id" json:"content_id"`
+	SimilarContentID int64   `db:"similar_content_id" json:"similar_content_id"`
+	Score            float64 `db:"score" json:"score"`
+	Source           string  `db:"source" json:"source"`
+}
+
+// LoadProfileInteractions 프로필별 상호작용(시청 기록, 찜 또는 긍정 평가) 콘텐츠 목록 조회
+// 부정 평가한 콘텐츠는 시청했더라도 제외
+func LoadProfileInteractions(db *sql.DB) (map[int64][]int64, error) {
+	rows, err := db.Query(`
+		SELECT i.profile_id, i.content_id FROM (
+			SELECT profile_id, content_id FROM ViewingHistories
+			UNION
+			SELECT profile_id, content_id FROM Wishlists
+			UNION
+			SELECT profile_id, content_id FROM ContentRatings WHERE ` + positiveRatingCondition + `
+		) i
+		WHERE NOT EXISTS (
+			SELECT 1 FROM ContentRatings r
+			WHERE r.profile_id = i.profile_id AND r.content_id = i.content_id AND ` + negativeRatingCondition + `
+		)
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	interactions := map[int64][]int64{}
+	for rows.Next() {
+		var profileID, contentID int64
+		if err := rows.Scan(&profileID, &contentID); err != nil {
+			return nil, err
+		}
+		interactions[profileID] = append(interactions[profileID], contentID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return interactions, nil
+}
+
+// LoadContentGenreIDs 콘텐츠별 장르 ID 목록 조회 (장르가 없는 콘텐츠도 포함)
+func LoadContentGenreIDs(db *sql.DB) (map[int64][]int64, error) {
+	rows, err := db.Query(`
+		SELECT
+			c.id, cg.genre_id
+		FROM
+			Contents c
+		LEFT JOIN
+			ContentGenres cg ON cg.content_id = c.id
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	contentGenres := map[int64][]int64{}
+	for rows.Next() {
+		var contentID int64
+		var genreID sql.NullInt64
+		if err := rows.Scan(&contentID, &genreID); err != nil {
+			return nil, err
+		}
+		if _, ok := contentGenres[contentID]; !ok {
+			contentGenres[contentID] = []int64{}
+		}
+		if genreID.Valid {
+			contentGenres[contentID] = append(contentGenres[contentID], genreID.Int64)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return contentGenres, nil
+}
+
+// ReplaceContentSimilarities 콘텐츠 유사도 테이블 전체 교체 (트랜잭션)
+func ReplaceContentSimilarities(db *sql.DB, similarities []ContentSimilarity) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM ContentSimilarities"); err != nil {
+		return err
+	}
+
+	stmt, err := tx.Prepare(`
+		INSERT INTO ContentSimilarities (content_id, similar_content_id, score, source, computed_at)
+		VALUES (?, ?, ?, ?, ?)
+	`)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	now := time.Now()
+	for _, similarity := range similarities {
+		if _, err := stmt.Exec(
+			similarity.ContentID, similarity.SimilarContentID, similarity.Score, similarity.Source, now,
+		); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// GetSimilarContents 특정 콘텐츠와 유사한 콘텐츠 조회 (유사도 순)
+func GetSimilarContents(db *sql.DB, contentID int64, viewer Viewer, limit int) ([]ContentListResponse, error) {
+	args := append([]interface{}{contentID}, viewer.catalogArgs()...)
+	return queryContentList(db, viewer, `
+		SELECT
+			c.id, c.title, c.thumbnail_url, c.release_year
+		FROM
+			ContentSimilarities cs
+		JOIN
+			Contents c ON c.id = cs.similar_content_id
+		WHERE
+			cs.content_id = ? AND `+catalogCondition("c")+`
+		ORDER BY
+			cs.score DESC, c.id ASC
+		LIMIT ?
+	`, append(args, limit)...)
+}
+
+// GetRecommendations 프로필의 시청/찜/긍정 평가 콘텐츠와 유사한 콘텐츠 중 아직 보거나 평가하지 않은 콘텐츠 추천
+// 부정 평가한 콘텐츠는 추천 근거에서 제외
+func GetRecommendations(db *sql.DB, viewer Viewer, limit int) ([]ContentListResponse, error) {
+	profileID := viewer.ProfileID
+	args := []interface{}{profileID, profileID, profileID, profileID, profileID, profileID, profileID}
+	args = append(args, viewer.catalogArgs()...)
+	return queryContentList(db, viewer, `
+		SELECT
+			c.id, c.title, c.thumbnail_url, c.release_year
+		FROM
+			ContentSimilarities cs
+		JOIN
+			Contents c ON c.id = cs.similar_content_id
+		WHERE
+			cs.content_id IN (
+				SELECT content_id FROM ViewingHistories WHERE profile_id = ?
+				UNION
+				SELECT content_id FROM Wishlists WHERE profile_id = ?
+				UNION
+				SELECT content_id FROM ContentRatings WHERE profile_id = ? AND `+positiveRatingCondition+`
+			)
+			AND cs.content_id NOT IN (SELECT content_id FROM ContentRatings WHERE profile_id = ? AND `+negativeRatingCondition+`)
+			AND cs.similar_content_id NOT IN (SELECT content_id FROM ViewingHistories WHERE profile_id = ?)
+			AND cs.similar_content_id NOT IN (SELECT content_id FROM Wishlists WHERE profile_id = ?)
+			AND cs.similar_content_id NOT IN (SELECT content_id FROM ContentRatings WHERE profile_id = ?)
+			AND `+catalogCondition("c")+`
+		GROUP BY
+			c.id, c.title, c.thumbnail_url, c.release_year
+		ORDER BY
+			SUM(cs.score) DESC, c.id ASC
+		LIMIT ?
+	`, append(args, limit)...)
+}
@@ -0,0 +1,211 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+// RefreshToken 리프레시 토큰 모델
+// 로그인 한 번이 하나의 토큰 계열(family)을 이루며, 갱신할 때마다 같은 계열의 새 토큰으로 교체됨
+type RefreshToken struct {
+	ID              int64        // 리프레시 토큰 고유 ID
+	UserID          int64        // 계정 ID
+	ProfileID       int64        // 현재 선택된 프로필 ID
+	FamilyID        string       // 토큰 계열 ID (로그인 세션 식별자)
+	TokenHash       string       // 리프레시 토큰 SHA-256 해시
+	AccessJTI       string       // 함께 발급된 액세스 토큰 ID
+	AccessExpiresAt time.Time    // 함께 발급된 액세스 토큰 만료 시각
+	ExpiresAt       time.Time    // 리프레시 토큰 만료 시각
+	RevokedAt       sql.NullTime // 교체/폐기 시각 (사용 가능한 토큰은 NULL)
+	CreatedAt       time.Time    // 발급일시
+}
+
+// RefreshTokenRequest 토큰 갱신 요청 모델
+// @Description 액세스 토큰 갱신 시 클라이언트에서 전송하는 데이터 모델
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token" binding:"required"` // 리프레시 토큰
+}
+
+// TokenResponse 토큰 발급 응답 모델
+// @Description 액세스 토큰과 리프레시 토큰 (리프레시 토큰은 한 번만 사용 가능)
+type TokenResponse struct {
+	Token        string `json:"token"`         // 단기 액세스 토큰 (JWT)
+	RefreshToken string `json:"refresh_token"` // 리프레시 토큰
+	ExpiresIn    int64  `json:"expires_in"`    // 액세스 토큰 유효 시간(초)
+}
+
+// IsActive 교체/폐기되지 않았고 만료되지 않은 토큰인지 확인
+func (t *RefreshToken) IsActive(now time.Time) bool {
+	return !t.RevokedAt.Valid && now.Before(t.ExpiresAt)
+}
+
+// refreshTokenColumns 리프레시 토큰 조회 컬럼 목록
+const refreshTokenColumns = "id, user_id, profile_id, family_id, token_hash, access_jti, access_expires_at, expires_at, revoked_at, created_at"
+
+// scanRefreshToken 리프레시 토큰 조회 결과 스캔
+func scanRefreshToken(row *sql.Row) (*RefreshToken, error) {
+	token := &RefreshToken{}
+	err := row.Scan(
+		&token.ID, &token.UserID, &token.ProfileID, &token.FamilyID, &token.TokenHash,
+		&token.AccessJTI, &token.AccessExpiresAt, &token.ExpiresAt, &token.RevokedAt, &token.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+// refreshTokenExecer 리프레시 토큰 저장에 사용하는 DB/트랜잭션 공통 인터페이스
+type refreshTokenExecer interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+}
+
+// insertRefreshToken 리프레시 토큰 저장
+func insertRefreshToken(exec refreshTokenExecer, token *RefreshToken) error {
+	token.CreatedAt = time.Now()
+	result, err := exec.Exec(`
+		INSERT INTO RefreshTokens
+			(user_id, profile_id, family_id, token_hash, access_jti, access_expires_at, expires_at, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+	`, token.UserID, token.ProfileID, token.FamilyID, token.TokenHash,
+		token.AccessJTI, token.AccessExpiresAt, token.ExpiresAt, token.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	token.ID, err = result.LastInsertId()
+	return err
+}
+
+// CreateRefreshToken 새 리프레시 토큰 저장
+func CreateRefreshToken(db *sql.DB, token *RefreshToken) error {
+	return insertRefreshToken(db, token)
+}
+
+// GetRefreshTokenByHash 해시로 리프레시 토큰 조회 (교체/폐기된 토큰 포함)
+func GetRefreshTokenByHash(db *sql.DB, tokenHash string) (*RefreshToken, error) {
+	return scanRefreshToken(db.QueryRow(
+		"SELECT "+refreshTokenColumns+" FROM RefreshTokens WHERE token_hash = ?", tokenHash,
+	))
+}
+
+// GetActiveRefreshTokenByAccessJTI 액세스 토큰과 함께 발급된 사용 가능한 리프레시 토큰 조회
+func GetActiveRefreshTokenByAccessJTI(db *sql.DB, accessJTI string) (*RefreshToken, error) {
+	return scanRefreshToken(db.QueryRow(
+		"SELECT "+refreshTokenColumns+" FROM RefreshTokens WHERE access_jti = ? AND revoked_at IS NULL", accessJTI,
+	))
+}
+
+// RotateRefreshToken 기존 리프레시 토큰을 교체 처리하고 새 토큰 저장 (트랜잭션)
+// 기존 토큰이 이미 교체/폐기된 경우(동시 갱신 포함) false 반환
+func RotateRefreshToken(db *sql.DB, oldID int64, next *RefreshToken) (bool, error) {
+	tx, err := db.Begin()
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.Exec(
+		"UPDATE RefreshTokens SET revoked_at = ? WHERE id = ? AND revoked_at IS NULL", time.Now(), oldID,
+	)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	if affected == 0 {
+		return false, nil
+	}
+
+	if err := insertRefreshToken(tx, next); err != nil {
+		return false, err
+	}
+
+	return true, tx.Commit()
+}
+
+// UpdateRefreshTokenAccess 프로필 전환 시 세션의 프로필과 액세스 토큰 정보 갱신
+func UpdateRefreshTokenAccess(db *sql.DB, id, profileID int64, accessJTI string, accessExpiresAt time.Time) error {
+	_, err := db.Exec(
+		"UPDATE RefreshTokens SET profile_id = ?, access_jti = ?, access_expires_at = ? WHERE id = ?",
+		profileID, accessJTI, accessExpiresAt, id,
+	)
+	return err
+}
+
+// RevokeRefreshTokenFamily 토큰 계열(로그인 세션) 전체 폐기 (발급된 액세스 토큰도 함께 폐기)
+func RevokeRefreshTokenFamily(db *sql.DB, familyID string) error {
+	return revokeRefreshTokens(db, "family_id", familyID)
+}
+
+// RevokeUserRefreshTokens 계정의 모든 로그인 세션 폐기 (발급된 액세스 토큰도 함께 폐기)
+func RevokeUserRefreshTokens(db *sql.DB, userID int64) error {
+	return revokeRefreshTokens(db, "user_id", userID)
+}
+
+// revokeRefreshTokens 조건에 맞는 세션의 리프레시 토큰과 만료되지 않은 액세스 토큰 폐기 (트랜잭션)
+func revokeRefreshTokens(db *sql.DB, column string, value interface{}) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	now := time.Now()
+	if _, err := tx.Exec(`
+		INSERT IGNORE INTO RevokedTokens (jti, expires_at)
+		SELECT access_jti, access_expires_at FROM RefreshTokens
+		WHERE `+column+` = ? AND access_expires_at > ?
+	`, value, now); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(
+		"UPDATE RefreshTokens SET revoked_at = ? WHERE "+column+" = ? AND revoked_at IS NULL", now, value,
+	); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(
+		"UPDATE UserSessions SET revoked_at = ? WHERE "+column+" = ? AND revoked_at IS NULL", now, value,
+	); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// RevokeAccessToken 액세스 토큰 폐기 (만료 시각까지 거부 목록에 보관)
+func RevokeAccessToken(db *sql.DB, jti string, expiresAt time.Time) error {
+	_, err := db.Exec("INSERT IGNORE INTO RevokedTokens (jti, expires_at) VALUES (?, ?)", jti, expiresAt)
+	return err
+}
+
+// IsAccessTokenRevoked 폐기된 액세스 토큰인지 확인
+func IsAccessTokenRevoked(db *sql.DB, jti string) (bool, error) {
+	var count int
+	err := db.QueryRow("SELECT COUNT(*) FROM RevokedTokens WHERE jti = ?", jti).Scan(&count)
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// PurgeExpiredTokens 만료된 리프레시 토큰, 거부 목록 항목 및 토큰이 남지 않은 세션 삭제
+func PurgeExpiredTokens(db *sql.DB) error {
+	now := time.Now()
+	if _, err := db.Exec("DELETE FROM RevokedTokens WHERE expires_at < ?", now); err != nil {
+		return err
+	}
+	if _, err := db.Exec("DELETE FROM RefreshTokens WHERE expires_at < ?", now); err != nil {
+		return err
+	}
+	_, err := db.Exec(`
+		DELETE s FROM UserSessions s
+		LEFT JOIN RefreshTokens r ON r.family_id = s.family_id
+		WHERE r.id IS NULL
+	`)
+	return err
+}
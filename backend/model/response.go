@@ -43,6 +43,27 @@ type PageInfo struct {
 	Empty            bool        `json:"empty"`
 }
 
+// newPageInfo 조회 결과와 전체 건수로 페이징 정보 구성
+func newPageInfo(content interface{}, numberOfElements, totalElements, page, size int) *PageInfo {
+	totalPages := (totalElements + size - 1) / size // 올림 계산
+	return &PageInfo{
+		Content: content,
+		Pageable: Pageable{
+			PageNumber: page,
+			PageSize:   size,
+			Offset:     page * size,
+		},
+		TotalPages:       totalPages,
+		TotalElements:    totalElements,
+		Last:             page >= totalPages-1,
+		Size:             size,
+		Number:           page,
+		NumberOfElements: numberOfElements,
+		First:            page == 0,
+		Empty:            numberOfElements == 0,
+	}
+}
+
 // 페이징 세부 정보
 // @Description 페이징 세부 정보 구조체
 type Pageable struct {
@@ -50,3 +71,31 @@ type Pageable struct {
 	PageSize   int `json:"pageSize"`
 	Offset     int `json:"offset"`
 }
+
+// 커서 페이징 정보
+// @Description 커서(키셋) 기반 페이징 정보 구조체
+type CursorPage struct {
+	Content          interface{} `json:"content"`
+	NextCursor       string      `json:"next_cursor,omitempty"`
+	PrevCursor       string      `json:"prev_cursor,omitempty"`
+	HasNext          bool        `json:"has_next"`
+	HasPrev          bool        `json:"has_prev"`
+	Size             int         `json:"size"`
+	NumberOfElements int         `json:"numberOfElements"`
+	Empty            bool        `json:"empty"`
+}
+
+// 필드 검증 오류
+// @Description 요청 필드별 검증 오류
+type FieldError struct {
+	Field   string `json:"field" example:"password"`                // 오류가 발생한 요청 필드
+	Code    string `json:"code" example:"too_short"`                // 오류 코드
+	Message string `json:"message" example:"비밀번호는 최소 8자 이상이어야 합니다"` // 오류 메시지
+}
+
+// 검증 오류 응답 구조체
+// @Description 필드별 검증 오류를 포함한 API 응답 구조체
+type ValidationErrorResponse struct {
+	Error  string       `json:"error" example:"비밀번호가 보안 정책을 충족하지 않습니다"`
+	Fields []FieldError `json:"fields"`
+}
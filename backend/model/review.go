@@ -0,0 +1,620 @@
+package model
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+)
+
+// 리뷰 상태
+const (
+	ReviewStatusPublished = "published" // 공개
+	ReviewStatusPending   = "pending"   // 검토 대기 (스팸 의심 또는 신고 누적)
+	ReviewStatusHidden    = "hidden"    // 숨김 (관리자 처리)
+)
+
+// 리뷰 정렬 옵션
+const (
+	ReviewSortLatest  = "latest"  // 최신순 (기본값)
+	ReviewSortHelpful = "helpful" // 도움이 됐어요 순 (도움이 됐어요 - 도움이 안 됐어요)
+	ReviewSortVotes   = "votes"   // 추천 참여 수 순
+)
+
+// 리뷰 검토 처리
+const (
+	ReviewActionApprove = "approve" // 승인 (공개)
+	ReviewActionHide    = "hide"    // 숨김
+	ReviewActionBan     = "ban"     // 작성자 리뷰 작성 금지 (작성자의 모든 리뷰 숨김)
+)
+
+// 리뷰 추천 값
+const (
+	ReviewVoteHelpful    = "helpful"     // 도움이 됐어요
+	ReviewVoteNotHelpful = "not_helpful" // 도움이 안 됐어요
+)
+
+// 신고 누적으로 검토 대기 처리된 리뷰의 사유
+const reviewReportFlagReason = "신고 누적"
+
+// 리뷰 관련 오류
+var (
+	ErrReviewExists          = errors.New("이미 이 콘텐츠에 리뷰를 작성했습니다")
+	ErrReviewAlreadyReported = errors.New("이미 신고한 리뷰입니다")
+	ErrOwnReview             = errors.New("본인 리뷰는 추천하거나 신고할 수 없습니다")
+	ErrReviewVoteNotFound    = errors.New("추천 기록이 없습니다")
+)
+
+// Review 리뷰 모델
+// @Description 콘텐츠 리뷰 (작성자 이름은 작성 프로필 이름)
+type Review struct {
+	ID              int64     `json:"id" example:"1"`
+	ContentID       int64     `json:"content_id" example:"1"`
+	AuthorName      string    `json:"author_name" example:"민수"`            // 작성자 이름
+	Body            string    `json:"body" example:"마지막 장면이 오래 기억에 남네요"`   // 리뷰 내용
+	Status          string    `json:"status" example:"published"`          // 상태 (published, pending, hidden)
+	HelpfulCount    int       `json:"helpful_count" example:"12"`          // 도움이 됐어요 수
+	NotHelpfulCount int       `json:"not_helpful_count" example:"1"`       // 도움이 안 됐어요 수
+	MyVote          string    `json:"my_vote,omitempty" example:"helpful"` // 내 추천 (helpful, not_helpful, 로그인 시)
+	IsMine          bool      `json:"is_mine" example:"false"`             // 내가 작성한 리뷰 여부
+	CreatedAt       time.Time `json:"created_at"`                          // 작성 일시
+	UpdatedAt       time.Time `json:"updated_at"`                          // 수정 일시
+}
+
+// ReviewRequest 리뷰 작성/수정 요청 모델
+// @Description 리뷰 내용 (최대 2000자)
+type ReviewRequest struct {
+	Body string `json:"body" binding:"required,max=2000" example:"마지막 장면이 오래 기억에 남네요"` // 리뷰 내용
+}
+
+// ReviewVoteRequest 리뷰 추천 요청 모델
+// @Description 리뷰가 도움이 됐는지 여부 (기존 추천은 교체)
+type ReviewVoteRequest struct {
+	Helpful *bool `json:"helpful" binding:"required" example:"true"` // 도움이 됐으면 true
+}
+
+// ReviewReportRequest 리뷰 신고 요청 모델
+// @Description 리뷰 신고 사유
+type ReviewReportRequest struct {
+	Reason string `json:"reason" binding:"required,oneof=spam abuse spoiler other" example:"spoiler"` // 신고 사유 (spam, abuse, spoiler, other)
+	Detail string `json:"detail" binding:"max=500" example:"결말을 그대로 적어 두었습니다"`                        // 상세 내용 (선택)
+}
+
+// ReviewVoteResponse 리뷰 추천 응답 모델
+// @Description 갱신된 추천 수와 내 추천
+type ReviewVoteResponse struct {
+	HelpfulCount    int    `json:"helpful_count" example:"13"`          // 도움이 됐어요 수
+	NotHelpfulCount int    `json:"not_helpful_count" example:"1"`       // 도움이 안 됐어요 수
+	MyVote          string `json:"my_vote,omitempty" example:"helpful"` // 내 추천 (삭제된 경우 생략)
+}
+
+// ReviewReportResponse 리뷰 신고 응답 모델
+// @Description 신고 접수 결과
+type ReviewReportResponse struct {
+	Message string `json:"message" example:"신고가 접수되었습니다"`
+}
+
+// ReviewModerationItem 리뷰 검토 대기열 항목
+// @Description 검토 대기 중이거나 처리되지 않은 신고가 있는 리뷰
+type ReviewModerationItem struct {
+	ID            int64     `json:"id" example:"1"`
+	ContentID     int64     `json:"content_id" example:"1"`
+	ContentTitle  string    `json:"content_title" example:"인셉션"`
+	UserID        int64     `json:"user_id" example:"3"`
+	AuthorName    string    `json:"author_name" example:"민수"`
+	Body          string    `json:"body"`
+	Status        string    `json:"status" example:"pending"`              // 상태 (published, pending, hidden)
+	FlagReason    string    `json:"flag_reason,omitempty" example:"링크 포함"` // 자동 검토 대기 사유
+	ReportCount   int       `json:"report_count" example:"3"`              // 처리되지 않은 신고 수
+	ReportReasons []string  `json:"report_reasons" example:"spam,abuse"`   // 처리되지 않은 신고 사유
+	AuthorBanned  bool      `json:"author_banned" example:"false"`         // 작성자 리뷰 작성 금지 여부
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// IsValidReviewSort 지원하는 리뷰 정렬 옵션인지 확인 (빈 값은 기본 정렬)
+func IsValidReviewSort(sort string) bool {
+	return sort == "" || sort == ReviewSortLatest || sort == ReviewSortHelpful || sort == ReviewSortVotes
+}
+
+// IsValidReviewAction 지원하는 리뷰 검토 처리인지 확인
+func IsValidReviewAction(action string) bool {
+	return action == ReviewActionApprove || action == ReviewActionHide || action == ReviewActionBan
+}
+
+// reviewOrderBy 정렬 옵션별 ORDER BY 절
+func reviewOrderBy(sort string) string {
+	switch sort {
+	case ReviewSortHelpful:
+		return "(r.helpful_count - r.not_helpful_count) DESC, r.helpful_count DESC, r.created_at DESC, r.id DESC"
+	case ReviewSortVotes:
+		return "(r.helpful_count + r.not_helpful_count) DESC, r.created_at DESC, r.id DESC"
+	}
+	return "r.created_at DESC, r.id DESC"
+}
+
+// reviewVoteValue 저장된 추천 값을 응답 값으로 변환 (추천하지 않았으면 빈 값)
+func reviewVoteValue(helpful sql.NullBool) string {
+	if !helpful.Valid {
+		return ""
+	}
+	if helpful.Bool {
+		return ReviewVoteHelpful
+	}
+	return ReviewVoteNotHelpful
+}
+
+// reviewVoteDelta 추천 하나가 추천 수에 기여하는 값 (도움이 됐어요, 도움이 안 됐어요)
+func reviewVoteDelta(helpful sql.NullBool) (int, int) {
+	if !helpful.Valid {
+		return 0, 0
+	}
+	if helpful.Bool {
+		return 1, 0
+	}
+	return 0, 1
+}
+
+// reviewColumns 리뷰 조회 공통 컬럼 (인자: 조회 사용자 ID 2개, 비로그인은 0)
+const reviewColumns = `
+	SELECT
+		r.id, r.content_id, IFNULL(p.name, u.name), r.body, r.status,
+		r.helpful_count, r.not_helpful_count, v.helpful, r.user_id = ?, r.created_at, r.updated_at
+	FROM
+		Reviews r
+	JOIN
+		Users u ON u.id = r.user_id
+	LEFT JOIN
+		Profiles p ON p.id = r.profile_id
+	LEFT JOIN
+		ReviewVotes v ON v.review_id = r.id AND v.user_id = ?
+`
+
+// scanReview 리뷰 조회 결과 한 행 변환
+func scanReview(scanner interface{ Scan(...interface{}) error }) (*Review, error) {
+	var review Review
+	var vote sql.NullBool
+	if err := scanner.Scan(
+		&review.ID, &review.ContentID, &review.AuthorName, &review.Body, &review.Status,
+		&review.HelpfulCount, &review.NotHelpfulCount, &vote, &review.IsMine, &review.CreatedAt, &review.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	review.MyVote = reviewVoteValue(vote)
+	return &review, nil
+}
+
+// GetContentReviews 콘텐츠의 공개 리뷰 목록 조회 (페이징, userID가 0이면 비로그인)
+func GetContentReviews(db *sql.DB, contentID, userID int64, page, size int, sort string) (*PageInfo, error) {
+	if page < 0 {
+		page = 0
+	}
+	if size <= 0 {
+		size = 10
+	}
+
+	var totalElements int
+	if err := db.QueryRow(
+		"SELECT COUNT(*) FROM Reviews r WHERE r.content_id = ? AND r.status = ?",
+		contentID, ReviewStatusPublished,
+	).Scan(&totalElements); err != nil {
+		return nil, err
+	}
+
+	rows, err := db.Query(reviewColumns+`
+		WHERE
+			r.content_id = ? AND r.status = ?
+		ORDER BY
+			`+reviewOrderBy(sort)+`
+		LIMIT ? OFFSET ?
+	`, userID, userID, contentID, ReviewStatusPublished, size, page*size)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	reviews := []Review{}
+	for rows.Next() {
+		review, err := scanReview(rows)
+		if err != nil {
+			return nil, err
+		}
+		reviews = append(reviews, *review)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return newPageInfo(reviews, len(reviews), totalElements, page, size), nil
+}
+
+// GetMyReview 사용자가 콘텐츠에 작성한 리뷰 조회 (상태와 관계없이, 없으면 sql.ErrNoRows)
+func GetMyReview(db *sql.DB, userID, contentID int64) (*Review, error) {
+	return scanReview(db.QueryRow(reviewColumns+`
+		WHERE r.user_id = ? AND r.content_id = ?
+	`, userID, userID, userID, contentID))
+}
+
+// CreateReview 리뷰 작성 (계정당 콘텐츠별 1건, 이미 있으면 ErrReviewExists)
+// status와 flagReason은 필터 검사 결과 (검토 대기가 아니면 flagReason은 빈 값)
+func CreateReview(db *sql.DB, userID, profileID, contentID int64, body, status, flagReason string) (*Review, error) {
+	now := time.Now()
+	result, err := db.Exec(`
+		INSERT IGNORE INTO Reviews (content_id, user_id, profile_id, body, status, flag_reason, created_at, updated_at)
+		VALUES (?, ?, NULLIF(?, 0), ?, ?, NULLIF(?, ''), ?, ?)
+	`, contentID, userID, profileID, body, status, flagReason, now, now)
+	if err != nil {
+		return nil, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	if affected == 0 {
+		return nil, ErrReviewExists
+	}
+
+	return GetMyReview(db, userID, contentID)
+}
+
+// UpdateReview 사용자가 작성한 리뷰 수정 (없으면 sql.ErrNoRows)
+// 숨김 또는 검토 대기 중인 리뷰는 수정해도 상태가 유지되고, 공개 리뷰는 필터 검사 결과에 따라 검토 대기로 바뀜
+func UpdateReview(db *sql.DB, userID, contentID int64, body, status, flagReason string) (*Review, error) {
+	tx, err := db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var id int64
+	var currentStatus string
+	if err := tx.QueryRow(
+		"SELECT id, status FROM Reviews WHERE user_id = ? AND content_id = ? FOR UPDATE",
+		userID, contentID,
+	).Scan(&id, &currentStatus); err != nil {
+		return nil, err
+	}
+
+	query := "UPDATE Reviews SET body = ?, updated_at = ? WHERE id = ?"
+	args := []interface{}{body, time.Now(), id}
+	if currentStatus == ReviewStatusPublished && status != ReviewStatusPublished {
+		query = "UPDATE Reviews SET body = ?, updated_at = ?, status = ?, flag_reason = ? WHERE id = ?"
+		args = []interface{}{body, time.Now(), status, flagReason, id}
+	}
+	if _, err := tx.Exec(query, args...); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return GetMyReview(db, userID, contentID)
+}
+
+// DeleteReview 사용자가 작성한 리뷰 삭제 (없으면 sql.ErrNoRows, 추천과 신고도 함께 삭제)
+func DeleteReview(db *sql.DB, userID, contentID int64) error {
+	result, err := db.Exec("DELETE FROM Reviews WHERE user_id = ? AND content_id = ?", userID, contentID)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// reviewForUpdate 트랜잭션 안에서 공개 리뷰 조회 후 잠금 (없거나 공개 상태가 아니면 sql.ErrNoRows)
+// 본인 리뷰이면 ErrOwnReview
+func reviewForUpdate(tx *sql.Tx, userID, reviewID int64) (helpfulCount, notHelpfulCount int, err error) {
+	var authorID int64
+	var status string
+	err = tx.QueryRow(`
+		SELECT user_id, status, helpful_count, not_helpful_count
+		FROM Reviews
+		WHERE id = ?
+		FOR UPDATE
+	`, reviewID).Scan(&authorID, &status, &helpfulCount, &notHelpfulCount)
+	if err != nil {
+		return 0, 0, err
+	}
+	if status != ReviewStatusPublished {
+		return 0, 0, sql.ErrNoRows
+	}
+	if authorID == userID {
+		return 0, 0, ErrOwnReview
+	}
+	return helpfulCount, notHelpfulCount, nil
+}
+
+// getReviewVoteForUpdate 트랜잭션 안에서 사용자의 리뷰 추천 조회 후 잠금 (없으면 Valid가 false)
+func getReviewVoteForUpdate(tx *sql.Tx, userID, reviewID int64) (sql.NullBool, error) {
+	var helpful sql.NullBool
+	err := tx.QueryRow(
+		"SELECT helpful FROM ReviewVotes WHERE review_id = ? AND user_id = ? FOR UPDATE",
+		reviewID, userID,
+	).Scan(&helpful)
+	if err == sql.ErrNoRows {
+		return sql.NullBool{}, nil
+	}
+	return helpful, err
+}
+
+// applyReviewVote 기존 추천과의 차이만큼 리뷰 추천 수 갱신 후 응답 구성
+func applyReviewVote(tx *sql.Tx, reviewID int64, helpfulCount, notHelpfulCount int, previous, current sql.NullBool) (*ReviewVoteResponse, error) {
+	previousHelpful, previousNotHelpful := reviewVoteDelta(previous)
+	currentHelpful, currentNotHelpful := reviewVoteDelta(current)
+	helpfulDelta := currentHelpful - previousHelpful
+	notHelpfulDelta := currentNotHelpful - previousNotHelpful
+
+	if _, err := tx.Exec(`
+		UPDATE Reviews
+		SET helpful_count = helpful_count + ?, not_helpful_count = not_helpful_count + ?
+		WHERE id = ?
+	`, helpfulDelta, notHelpfulDelta, reviewID); err != nil {
+		return nil, err
+	}
+
+	return &ReviewVoteResponse{
+		HelpfulCount:    helpfulCount + helpfulDelta,
+		NotHelpfulCount: notHelpfulCount + notHelpfulDelta,
+		MyVote:          reviewVoteValue(current),
+	}, nil
+}
+
+// SetReviewVote 리뷰 추천 저장 (기존 추천은 교체, 추천 수는 같은 트랜잭션에서 갱신)
+func SetReviewVote(db *sql.DB, userID, reviewID int64, helpful bool) (*ReviewVoteResponse, error) {
+	tx, err := db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	helpfulCount, notHelpfulCount, err := reviewForUpdate(tx, userID, reviewID)
+	if err != nil {
+		return nil, err
+	}
+	previous, err := getReviewVoteForUpdate(tx, userID, reviewID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.Exec(`
+		INSERT INTO ReviewVotes (review_id, user_id, helpful, created_at)
+		VALUES (?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE helpful = VALUES(helpful)
+	`, reviewID, userID, helpful, time.Now()); err != nil {
+		return nil, err
+	}
+	response, err := applyReviewVote(tx, reviewID, helpfulCount, notHelpfulCount, previous, sql.NullBool{Bool: helpful, Valid: true})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// DeleteReviewVote 리뷰 추천 취소 (리뷰가 없으면 sql.ErrNoRows, 추천하지 않았으면 ErrReviewVoteNotFound)
+func DeleteReviewVote(db *sql.DB, userID, reviewID int64) (*ReviewVoteResponse, error) {
+	tx, err := db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	helpfulCount, notHelpfulCount, err := reviewForUpdate(tx, userID, reviewID)
+	if err != nil {
+		return nil, err
+	}
+	previous, err := getReviewVoteForUpdate(tx, userID, reviewID)
+	if err != nil {
+		return nil, err
+	}
+	if !previous.Valid {
+		return nil, ErrReviewVoteNotFound
+	}
+
+	if _, err := tx.Exec("DELETE FROM ReviewVotes WHERE review_id = ? AND user_id = ?", reviewID, userID); err != nil {
+		return nil, err
+	}
+	response, err := applyReviewVote(tx, reviewID, helpfulCount, notHelpfulCount, previous, sql.NullBool{})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// ReportReview 리뷰 신고 (계정당 리뷰별 1건, 이미 신고했으면 ErrReviewAlreadyReported)
+// 처리되지 않은 신고가 hideThreshold 이상이 되면 검토 전까지 숨김(검토 대기) 처리하고 true 반환
+func ReportReview(db *sql.DB, userID, reviewID int64, req *ReviewReportRequest, hideThreshold int) (bool, error) {
+	tx, err := db.Begin()
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	if _, _, err := reviewForUpdate(tx, userID, reviewID); err != nil {
+		return false, err
+	}
+
+	result, err := tx.Exec(`
+		INSERT IGNORE INTO ReviewReports (review_id, user_id, reason, detail, created_at)
+		VALUES (?, ?, ?, NULLIF(?, ''), ?)
+	`, reviewID, userID, req.Reason, strings.TrimSpace(req.Detail), time.Now())
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	if affected == 0 {
+		return false, ErrReviewAlreadyReported
+	}
+
+	var openReports int
+	if err := tx.QueryRow(
+		"SELECT COUNT(*) FROM ReviewReports WHERE review_id = ? AND resolved_at IS NULL",
+		reviewID,
+	).Scan(&openReports); err != nil {
+		return false, err
+	}
+
+	hidden := openReports >= hideThreshold
+	if hidden {
+		if _, err := tx.Exec(
+			"UPDATE Reviews SET status = ?, flag_reason = ? WHERE id = ?",
+			ReviewStatusPending, reviewReportFlagReason, reviewID,
+		); err != nil {
+			return false, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, err
+	}
+	return hidden, nil
+}
+
+// moderationQueueCondition 검토 대기열 조건 (검토 대기 상태이거나 처리되지 않은 신고가 있는 리뷰, 인자: 검토 대기 상태)
+const moderationQueueCondition = `
+	r.status = ? OR EXISTS (SELECT 1 FROM ReviewReports rr WHERE rr.review_id = r.id AND rr.resolved_at IS NULL)
+`
+
+// GetModerationQueue 리뷰 검토 대기열 조회 (처리되지 않은 신고가 많은 순, 오래된 순)
+func GetModerationQueue(db *sql.DB, page, size int) (*PageInfo, error) {
+	if page < 0 {
+		page = 0
+	}
+	if size <= 0 {
+		size = 20
+	}
+
+	var totalElements int
+	if err := db.QueryRow(
+		"SELECT COUNT(*) FROM Reviews r WHERE "+moderationQueueCondition,
+		ReviewStatusPending,
+	).Scan(&totalElements); err != nil {
+		return nil, err
+	}
+
+	rows, err := db.Query(`
+		SELECT
+			q.id, q.content_id, q.title, q.user_id, q.author_name, q.body, q.status, q.flag_reason,
+			q.report_count, q.report_reasons, q.author_banned, q.created_at, q.updated_at
+		FROM (
+			SELECT
+				r.id, r.content_id, c.title, r.user_id, IFNULL(p.name, u.name) AS author_name, r.body, r.status,
+				IFNULL(r.flag_reason, '') AS flag_reason,
+				(SELECT COUNT(*) FROM ReviewReports rr WHERE rr.review_id = r.id AND rr.resolved_at IS NULL) AS report_count,
+				(SELECT IFNULL(GROUP_CONCAT(DISTINCT rr.reason ORDER BY rr.reason), '') FROM ReviewReports rr
+					WHERE rr.review_id = r.id AND rr.resolved_at IS NULL) AS report_reasons,
+				u.review_banned_at IS NOT NULL AS author_banned, r.created_at, r.updated_at
+			FROM
+				Reviews r
+			JOIN
+				Contents c ON c.id = r.content_id
+			JOIN
+				Users u ON u.id = r.user_id
+			LEFT JOIN
+				Profiles p ON p.id = r.profile_id
+			WHERE
+				`+moderationQueueCondition+`
+		) q
+		ORDER BY
+			q.report_count DESC, q.updated_at ASC, q.id ASC
+		LIMIT ? OFFSET ?
+	`, ReviewStatusPending, size, page*size)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	items := []ReviewModerationItem{}
+	for rows.Next() {
+		var item ReviewModerationItem
+		var reasons string
+		if err := rows.Scan(
+			&item.ID, &item.ContentID, &item.ContentTitle, &item.UserID, &item.AuthorName, &item.Body,
+			&item.Status, &item.FlagReason, &item.ReportCount, &reasons, &item.AuthorBanned,
+			&item.CreatedAt, &item.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		item.ReportReasons = []string{}
+		if reasons != "" {
+			item.ReportReasons = strings.Split(reasons, ",")
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return newPageInfo(items, len(items), totalElements, page, size), nil
+}
+
+// ModerateReview 리뷰 검토 처리 (없으면 sql.ErrNoRows)
+// 승인은 공개, 숨김은 숨김 처리하고 해당 리뷰의 신고를 처리 완료로 기록
+// 작성 금지는 작성자의 리뷰 작성을 막고 작성자의 모든 리뷰와 신고를 함께 처리
+func ModerateReview(db *sql.DB, reviewID int64, action string) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var authorID int64
+	if err := tx.QueryRow("SELECT user_id FROM Reviews WHERE id = ? FOR UPDATE", reviewID).Scan(&authorID); err != nil {
+		return err
+	}
+
+	type statement struct {
+		query string
+		args  []interface{}
+	}
+	now := time.Now()
+	var statements []statement
+	if action == ReviewActionBan {
+		statements = []statement{
+			{"UPDATE Users SET review_banned_at = ? WHERE id = ? AND review_banned_at IS NULL", []interface{}{now, authorID}},
+			{"UPDATE Reviews SET status = ? WHERE user_id = ?", []interface{}{ReviewStatusHidden, authorID}},
+			{`UPDATE ReviewReports rr JOIN Reviews r ON r.id = rr.review_id
+			SET rr.resolved_at = ?, rr.resolution = ?
+			WHERE r.user_id = ? AND rr.resolved_at IS NULL`, []interface{}{now, action, authorID}},
+		}
+	} else {
+		status := ReviewStatusPublished
+		if action == ReviewActionHide {
+			status = ReviewStatusHidden
+		}
+		statements = []statement{
+			{"UPDATE Reviews SET status = ? WHERE id = ?", []interface{}{status, reviewID}},
+			{"UPDATE ReviewReports SET resolved_at = ?, resolution = ? WHERE review_id = ? AND resolved_at IS NULL", []interface{}{now, action, reviewID}},
+		}
+	}
+	for _, stmt := range statements {
+		if _, err := tx.Exec(stmt.query, stmt.args...); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// IsReviewBanned 사용자의 리뷰 작성 금지 여부 확인
+func IsReviewBanned(db *sql.DB, userID int64) (bool, error) {
+	var banned bool
+	err := db.QueryRow("SELECT review_banned_at IS NOT NULL FROM Users WHERE id = ?", userID).Scan(&banned)
+	return banned, err
+}
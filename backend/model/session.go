@@ -0,0 +1,127 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+// 세션 기기 정보 최대 길이
+const (
+	maxDeviceNameLength = 100
+	maxUserAgentLength  = 255
+)
+
+// UserSession 로그인 세션 모델 (리프레시 토큰 계열 하나가 세션 하나에 해당)
+// @Description 로그인한 기기별 세션 정보
+type UserSession struct {
+	ID         int64     `json:"id"`           // 세션 고유 ID
+	UserID     int64     `json:"-"`            // 계정 ID
+	FamilyID   string    `json:"-"`            // 리프레시 토큰 계열 ID
+	DeviceName string    `json:"device_name"`  // 기기 이름
+	UserAgent  string    `json:"user_agent"`   // 마지막 접속 User-Agent
+	IPAddress  string    `json:"ip_address"`   // 마지막 접속 IP
+	CreatedAt  time.Time `json:"created_at"`   // 로그인 일시
+	LastSeenAt time.Time `json:"last_seen_at"` // 마지막 접속 일시 (토큰 갱신 기준)
+	Current    bool      `json:"current"`      // 현재 요청을 보낸 세션 여부
+}
+
+// SessionClient 세션을 생성/갱신한 클라이언트 정보
+type SessionClient struct {
+	DeviceName string // 기기 이름 (로그인 시 클라이언트가 전달)
+	UserAgent  string // User-Agent 헤더
+	IPAddress  string // 클라이언트 IP
+}
+
+// normalize 컬럼 길이에 맞게 클라이언트 정보 자르기
+func (c SessionClient) normalize() SessionClient {
+	c.DeviceName = truncateRunes(c.DeviceName, maxDeviceNameLength)
+	c.UserAgent = truncateRunes(c.UserAgent, maxUserAgentLength)
+	return c
+}
+
+// truncateRunes 문자열을 최대 max 글자로 자르기
+func truncateRunes(s string, max int) string {
+	runes := []rune(s)
+	if len(runes) <= max {
+		return s
+	}
+	return string(runes[:max])
+}
+
+// CreateUserSession 로그인 세션 생성
+func CreateUserSession(db *sql.DB, userID int64, familyID string, client SessionClient) error {
+	client = client.normalize()
+	now := time.Now()
+	_, err := db.Exec(`
+		INSERT INTO UserSessions (user_id, family_id, device_name, user_agent, ip_address, created_at, last_seen_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+	`, userID, familyID, client.DeviceName, client.UserAgent, client.IPAddress, now, now)
+	return err
+}
+
+// TouchUserSession 토큰 갱신 시 세션의 마지막 접속 정보 갱신
+func TouchUserSession(db *sql.DB, familyID string, client SessionClient) error {
+	client = client.normalize()
+	_, err := db.Exec(
+		"UPDATE UserSessions SET user_agent = ?, ip_address = ?, last_seen_at = ? WHERE family_id = ?",
+		client.UserAgent, client.IPAddress, time.Now(), familyID,
+	)
+	return err
+}
+
+// GetUserSessions 계정의 활성 세션 목록 조회 (최근 접속 순, currentJTI로 현재 세션 표시)
+func GetUserSessions(db *sql.DB, userID int64, currentJTI string) ([]UserSession, error) {
+	rows, err := db.Query(`
+		SELECT
+			s.id, s.user_id, s.family_id, s.device_name, s.user_agent, s.ip_address, s.created_at, s.last_seen_at,
+			EXISTS (
+				SELECT 1 FROM RefreshTokens cr
+				WHERE cr.family_id = s.family_id AND cr.access_jti = ? AND cr.revoked_at IS NULL
+			) AS current
+		FROM
+			UserSessions s
+		WHERE
+			s.user_id = ? AND s.revoked_at IS NULL
+			AND EXISTS (
+				SELECT 1 FROM RefreshTokens r
+				WHERE r.family_id = s.family_id AND r.revoked_at IS NULL AND r.expires_at > ?
+			)
+		ORDER BY
+			s.last_seen_at DESC, s.id DESC
+	`, currentJTI, userID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	sessions := []UserSession{}
+	for rows.Next() {
+		var session UserSession
+		if err := rows.Scan(
+			&session.ID, &session.UserID, &session.FamilyID, &session.DeviceName, &session.UserAgent,
+			&session.IPAddress, &session.CreatedAt, &session.LastSeenAt, &session.Current,
+		); err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, session)
+	}
+
+	return sessions, rows.Err()
+}
+
+// GetUserSession 계정에 속한 활성 세션 조회
+func GetUserSession(db *sql.DB, userID, sessionID int64) (*UserSession, error) {
+	session := &UserSession{}
+	err := db.QueryRow(`
+		SELECT id, user_id, family_id, device_name, user_agent, ip_address, created_at, last_seen_at
+		FROM UserSessions
+		WHERE id = ? AND user_id = ? AND revoked_at IS NULL
+	`, sessionID, userID).Scan(
+		&session.ID, &session.UserID, &session.FamilyID, &session.DeviceName, &session.UserAgent,
+		&session.IPAddress, &session.CreatedAt, &session.LastSeenAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return session, nil
+}
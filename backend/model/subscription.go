@@ -0,0 +1,241 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+// 요금제 코드
+const (
+	PlanBasic    = "basic"    // 베이직
+	PlanStandard = "standard" // 스탠다드
+	PlanPremium  = "premium"  // 프리미엄
+)
+
+// Plan 요금제 모델
+// @Description 요금제별 최대 화질, 동시 시청 수, 저장(다운로드) 가능 수
+type Plan struct {
+	ID                   int64  `json:"id"`                                 // 요금제 ID
+	Code                 string `json:"code" example:"standard"`            // 요금제 코드 (basic, standard, premium)
+	Name                 string `json:"name" example:"스탠다드"`                // 요금제 이름
+	MaxResolution        int    `json:"max_resolution" example:"1080"`      // 최대 화질 (세로 해상도, 예: 720, 1080, 2160)
+	MaxConcurrentStreams int    `json:"max_concurrent_streams" example:"2"` // 동시 시청 가능한 프로필 수
+	DownloadLimit        int    `json:"download_limit" example:"10"`        // 저장 가능한 콘텐츠 수 (0이면 저장 불가)
+	MonthlyPrice         int    `json:"monthly_price" example:"13500"`      // 월 요금 (원)
+}
+
+// Subscription 구독 모델
+// @Description 계정의 요금제 구독 기간
+type Subscription struct {
+	ID          int64      `json:"id"`           // 구독 ID
+	UserID      int64      `json:"-"`            // 계정 ID
+	Plan        Plan       `json:"plan"`         // 구독 요금제
+	StartsAt    time.Time  `json:"starts_at"`    // 구독 시작일시
+	EndsAt      time.Time  `json:"ends_at"`      // 구독 종료일시
+	CancelledAt *time.Time `json:"cancelled_at"` // 해지 신청일시 (종료일까지는 이용 가능)
+	CreatedAt   time.Time  `json:"created_at"`   // 생성일시
+}
+
+// IsActive 주어진 시각에 구독이 유효한지 확인
+func (s *Subscription) IsActive(now time.Time) bool {
+	return !now.Before(s.StartsAt) && now.Before(s.EndsAt)
+}
+
+// GrantSubscriptionRequest 구독 부여 요청 모델 (관리자)
+// @Description 관리자가 계정에 요금제 구독을 부여할 때 전송하는 데이터 모델
+type GrantSubscriptionRequest struct {
+	Plan string `json:"plan" binding:"required,oneof=basic standard premium" example:"standard"` // 요금제 코드
+	Days int    `json:"days" binding:"required,min=1,max=366" example:"30"`                      // 구독 기간(일)
+}
+
+// planColumns 요금제 조회 컬럼 목록
+const planColumns = "p.id, p.code, p.name, p.max_resolution, p.max_concurrent_streams, p.download_limit, p.monthly_price"
+
+// scanPlan 요금제 행 스캔
+func scanPlan(row interface{ Scan(...interface{}) error }) (*Plan, error) {
+	plan := &Plan{}
+	err := row.Scan(&plan.ID, &plan.Code, &plan.Name, &plan.MaxResolution, &plan.MaxConcurrentStreams,
+		&plan.DownloadLimit, &plan.MonthlyPrice)
+	if err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+// GetPlans 요금제 목록 조회 (요금 순)
+func GetPlans(db *sql.DB) ([]Plan, error) {
+	rows, err := db.Query("SELECT " + planColumns + " FROM Plans p ORDER BY p.monthly_price ASC, p.id ASC")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	plans := []Plan{}
+	for rows.Next() {
+		plan, err := scanPlan(rows)
+		if err != nil {
+			return nil, err
+		}
+		plans = append(plans, *plan)
+	}
+	return plans, rows.Err()
+}
+
+// GetPlanByCode 코드로 요금제 조회
+func GetPlanByCode(db *sql.DB, code string) (*Plan, error) {
+	return scanPlan(db.QueryRow("SELECT "+planColumns+" FROM Plans p WHERE p.code = ?", code))
+}
+
+// GetLatestSubscription 이미 시작된 구독 중 종료일이 가장 늦은 구독 조회 (구독한 적이 없으면 sql.ErrNoRows)
+func GetLatestSubscription(db *sql.DB, userID int64) (*Subscription, error) {
+	subscription := &Subscription{}
+	var cancelledAt sql.NullTime
+	err := db.QueryRow(`
+		SELECT
+			s.id, s.user_id, s.starts_at, s.ends_at, s.cancelled_at, s.created_at, `+planColumns+`
+		FROM
+			Subscriptions s
+		JOIN
+			Plans p ON p.id = s.plan_id
+		WHERE
+			s.user_id = ? AND s.starts_at <= ?
+		ORDER BY
+			s.ends_at DESC, s.id DESC
+		LIMIT 1
+	`, userID, time.Now()).Scan(
+		&subscription.ID, &subscription.UserID, &subscription.StartsAt, &subscription.EndsAt, &cancelledAt, &subscription.CreatedAt,
+		&subscription.Plan.ID, &subscription.Plan.Code, &subscription.Plan.Name, &subscription.Plan.MaxResolution,
+		&subscription.Plan.MaxConcurrentStreams, &subscription.Plan.DownloadLimit, &subscription.Plan.MonthlyPrice,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if cancelledAt.Valid {
+		subscription.CancelledAt = &cancelledAt.Time
+	}
+	return subscription, nil
+}
+
+// AppendSubscription 계정의 모든 구독(시작 예정 포함) 중 가장 늦은 종료일부터 이어서 days일 구독 생성 (남은 구독이 없으면 지금부터)
+func AppendSubscription(db *sql.DB, userID int64, plan *Plan, days int) (*Subscription, error) {
+	tx, err := db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	subscription, err := appendSubscriptionTx(tx, userID, plan.ID, 0, days)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	subscription.Plan = *plan
+	return subscription, nil
+}
+
+// appendSubscriptionTx 트랜잭션 안에서 가장 늦은 종료일(남은 구독이 없으면 지금)부터 months개월 days일 구독 생성 (요금제 정보는 채우지 않음)
+// 같은 계정의 구독이 동시에 생성되며 기간이 겹치지 않도록 계정 행을 잠근 뒤 처리
+func appendSubscriptionTx(tx *sql.Tx, userID, planID int64, months, days int) (*Subscription, error) {
+	var lockedID int64
+	if err := tx.QueryRow("SELECT id FROM Users WHERE id = ? FOR UPDATE", userID).Scan(&lockedID); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	var latestEnd sql.NullTime
+	if err := tx.QueryRow("SELECT MAX(ends_at) FROM Subscriptions WHERE user_id = ?", userID).Scan(&latestEnd); err != nil {
+		return nil, err
+	}
+	startsAt := now
+	if latestEnd.Valid && latestEnd.Time.After(now) {
+		startsAt = latestEnd.Time
+	}
+	endsAt := startsAt.AddDate(0, months, days)
+
+	result, err := tx.Exec(
+		"INSERT INTO Subscriptions (user_id, plan_id, starts_at, ends_at, created_at) VALUES (?, ?, ?, ?, ?)",
+		userID, planID, startsAt, endsAt, now,
+	)
+	if err != nil {
+		return nil, err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+	return &Subscription{ID: id, UserID: userID, StartsAt: startsAt, EndsAt: endsAt, CreatedAt: now}, nil
+}
+
+// GetProfileUserID 프로필이 속한 계정 ID 조회
+func GetProfileUserID(db *sql.DB, profileID int64) (int64, error) {
+	var userID int64
+	err := db.QueryRow("SELECT user_id FROM Profiles WHERE id = ?", profileID).Scan(&userID)
+	return userID, err
+}
+
+// StartStream 계정 행을 잠근 트랜잭션에서 since 이후 재생이 확인된 재생 세션 수를 세고,
+// maxStreams보다 적을 때만 새 재생 세션 기록 (제한에 걸리면 false)
+// 같은 프로필이라도 기기마다 재생 세션이 따로 기록되어 동시 시청 수에 포함
+func StartStream(db *sql.DB, streamID string, userID, profileID, contentID int64, maxStreams int, since time.Time) (bool, error) {
+	tx, err := db.Begin()
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	// 같은 계정의 재생 요청이 동시에 들어와도 제한을 넘지 않도록 계정 잠금
+	var lockedID int64
+	if err := tx.QueryRow("SELECT id FROM Users WHERE id = ? FOR UPDATE", userID).Scan(&lockedID); err != nil {
+		return false, err
+	}
+
+	// 재생 확인이 끊긴 재생 세션 정리
+	if _, err := tx.Exec("DELETE FROM ActiveStreams WHERE user_id = ? AND last_seen_at <= ?", userID, since); err != nil {
+		return false, err
+	}
+
+	var count int
+	if err := tx.QueryRow(
+		"SELECT COUNT(*) FROM ActiveStreams WHERE user_id = ? AND last_seen_at > ?", userID, since,
+	).Scan(&count); err != nil {
+		return false, err
+	}
+	if count >= maxStreams {
+		return false, nil
+	}
+
+	now := time.Now()
+	if _, err := tx.Exec(`
+		INSERT INTO ActiveStreams (id, profile_id, user_id, content_id, started_at, last_seen_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+	`, streamID, profileID, userID, contentID, now, now); err != nil {
+		return false, err
+	}
+	return true, tx.Commit()
+}
+
+// TouchStream 재생 세션의 마지막 재생 확인 시각 갱신
+// 재생 세션 ID가 없으면 프로필의 해당 콘텐츠 재생 세션 전체를 갱신
+func TouchStream(db *sql.DB, profileID, contentID int64, streamID string) error {
+	var err error
+	if streamID != "" {
+		_, err = db.Exec("UPDATE ActiveStreams SET last_seen_at = ? WHERE id = ? AND profile_id = ?", time.Now(), streamID, profileID)
+	} else {
+		_, err = db.Exec("UPDATE ActiveStreams SET last_seen_at = ? WHERE profile_id = ? AND content_id = ?", time.Now(), profileID, contentID)
+	}
+	return err
+}
+
+// EndStream 재생 세션 종료
+// 재생 세션 ID가 없으면 프로필의 해당 콘텐츠 재생 세션 전체를 종료
+func EndStream(db *sql.DB, profileID, contentID int64, streamID string) error {
+	var err error
+	if streamID != "" {
+		_, err = db.Exec("DELETE FROM ActiveStreams WHERE id = ? AND profile_id = ?", streamID, profileID)
+	} else {
+		_, err = db.Exec("DELETE FROM ActiveStreams WHERE profile_id = ? AND content_id = ?", profileID, contentID)
+	}
+	return err
+}
@@ -0,0 +1,363 @@
+package model
+
+import (
+	"database/sql"
+	"sort"
+)
+
+// ContentTranslation 콘텐츠 번역 모델
+// @Description 언어별 콘텐츠 제목과 설명 (설명이 비어 있으면 원문 설명 사용)
+type ContentTranslation struct {
+	Locale      string `json:"locale" example:"ko"`                                        // 언어 태그 (BCP 47)
+	Title       string `json:"title" binding:"required,max=200" example:"쇼생크 탈출"`          // 제목
+	Description string `json:"description" example:"1940년대, 아내와 그 애인을 살해한 혐의로 수감된 은행가..."` // 설명
+}
+
+// GenreTranslation 장르 번역 모델
+// @Description 언어별 장르 이름과 설명 (설명이 비어 있으면 원문 설명 사용)
+type GenreTranslation struct {
+	Locale      string `json:"locale" example:"en"`                               // 언어 태그 (BCP 47)
+	Name        string `json:"name" binding:"required,max=50" example:"Action"`   // 이름
+	Description string `json:"description" example:"Thrilling, adventure-packed"` // 설명
+}
+
+// localeRank 선호 언어 순서에서의 위치 (없으면 -1)
+func localeRank(locales []string, locale string) int {
+	for i, l := range locales {
+		if l == locale {
+			return i
+		}
+	}
+	return -1
+}
+
+// stringArgs 문자열 슬라이스를 쿼리 인자로 변환
+func stringArgs(values []string) []interface{} {
+	args := make([]interface{}, 0, len(values))
+	for _, value := range values {
+		args = append(args, value)
+	}
+	return args
+}
+
+// loadContentTranslations 콘텐츠별로 선호 언어 순서상 가장 앞선 번역 일괄 조회
+func loadContentTranslations(db *sql.DB, contentIDs []int64, locales []string) (map[int64]ContentTranslation, error) {
+	translations := map[int64]ContentTranslation{}
+	if len(contentIDs) == 0 || len(locales) == 0 {
+		return translations, nil
+	}
+
+	args := append(int64Args(contentIDs), stringArgs(locales)...)
+	rows, err := db.Query(`
+		SELECT
+			content_id, locale, title, IFNULL(description, '')
+		FROM
+			ContentTranslations
+		WHERE
+			content_id IN (`+inPlaceholders(len(contentIDs))+`) AND locale IN (`+inPlaceholders(len(locales))+`)
+	`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var contentID int64
+		var translation ContentTranslation
+		if err := rows.Scan(&contentID, &translation.Locale, &translation.Title, &translation.Description); err != nil {
+			return nil, err
+		}
+		current, ok := translations[contentID]
+		if !ok || localeRank(locales, translation.Locale) < localeRank(locales, current.Locale) {
+			translations[contentID] = translation
+		}
+	}
+	return translations, rows.Err()
+}
+
+// loadGenreTranslations 장르 이름별로 선호 언어 순서상 가장 앞선 번역 일괄 조회 (장르 이름은 고유)
+func loadGenreTranslations(db *sql.DB, names []string, locales []string) (map[string]GenreTranslation, error) {
+	translations := map[string]GenreTranslation{}
+	if len(names) == 0 || len(locales) == 0 {
+		return translations, nil
+	}
+
+	args := append(stringArgs(names), stringArgs(locales)...)
+	rows, err := db.Query(`
+		SELECT
+			g.name, gt.locale, gt.name, IFNULL(gt.description, '')
+		FROM
+			GenreTranslations gt
+		JOIN
+			Genres g ON g.id = gt.genre_id
+		WHERE
+			g.name IN (`+inPlaceholders(len(names))+`) AND gt.locale IN (`+inPlaceholders(len(locales))+`)
+	`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var original string
+		var translation GenreTranslation
+		if err := rows.Scan(&original, &translation.Locale, &translation.Name, &translation.Description); err != nil {
+			return nil, err
+		}
+		current, ok := translations[original]
+		if !ok || localeRank(locales, translation.Locale) < localeRank(locales, current.Locale) {
+			translations[original] = translation
+		}
+	}
+	return translations, rows.Err()
+}
+
+// localizeContentList 콘텐츠 목록의 제목과 장르 이름을 선호 언어로 번역
+func localizeContentList(db *sql.DB, locales []string, contentList []ContentListResponse) error {
+	if len(locales) == 0 || len(contentList) == 0 {
+		return nil
+	}
+
+	contentIDs := make([]int64, 0, len(contentList))
+	genreNames := []string{}
+	seenGenres := map[string]bool{}
+	for _, content := range contentList {
+		contentIDs = append(contentIDs, content.ID)
+		for _, genre := range content.Genres {
+			if !seenGenres[genre] {
+				seenGenres[genre] = true
+				genreNames = append(genreNames, genre)
+			}
+		}
+	}
+
+	translations, err := loadContentTranslations(db, contentIDs, locales)
+	if err != nil {
+		return err
+	}
+	genreTranslations, err := loadGenreTranslations(db, genreNames, locales)
+	if err != nil {
+		return err
+	}
+
+	for i := range contentList {
+		if translation, ok := translations[contentList[i].ID]; ok {
+			contentList[i].Title = translation.Title
+		}
+		for j, genre := range contentList[i].Genres {
+			if translation, ok := genreTranslations[genre]; ok {
+				contentList[i].Genres[j] = translation.Name
+			}
+		}
+	}
+	return nil
+}
+
+// localizeGenres 장르 목록의 이름과 설명을 선호 언어로 번역
+func localizeGenres(db *sql.DB, locales []string, genres []Genre) error {
+	if len(locales) == 0 || len(genres) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(genres))
+	for _, genre := range genres {
+		names = append(names, genre.Name)
+	}
+	translations, err := loadGenreTranslations(db, names, locales)
+	if err != nil {
+		return err
+	}
+
+	for i := range genres {
+		if translation, ok := translations[genres[i].Name]; ok {
+			genres[i].Name = translation.Name
+			if translation.Description != "" {
+				genres[i].Description = translation.Description
+			}
+		}
+	}
+	return nil
+}
+
+// localizeContentDetail 콘텐츠 상세 정보의 제목, 설명, 장르를 선호 언어로 번역
+func localizeContentDetail(db *sql.DB, locales []string, content *ContentDetailResponse) error {
+	if len(locales) == 0 {
+		return nil
+	}
+
+	translations, err := loadContentTranslations(db, []int64{content.ID}, locales)
+	if err != nil {
+		return err
+	}
+	if translation, ok := translations[content.ID]; ok {
+		content.Title = translation.Title
+		if translation.Description != "" {
+			content.Description = translation.Description
+		}
+	}
+	return localizeGenres(db, locales, content.Genres)
+}
+
+// localizeViewingHistories 시청 기록 목록의 콘텐츠 제목을 선호 언어로 번역
+func localizeViewingHistories(db *sql.DB, locales []string, histories []ViewingHistoryResponse) error {
+	if len(locales) == 0 || len(histories) == 0 {
+		return nil
+	}
+
+	contentIDs := make([]int64, 0, len(histories))
+	for _, history := range histories {
+		contentIDs = append(contentIDs, history.ContentID)
+	}
+	translations, err := loadContentTranslations(db, contentIDs, locales)
+	if err != nil {
+		return err
+	}
+
+	for i := range histories {
+		if translation, ok := translations[histories[i].ContentID]; ok {
+			histories[i].Title = translation.Title
+		}
+	}
+	return nil
+}
+
+// localizeWatchedContents 최근 시청 콘텐츠 목록의 제목을 선호 언어로 번역
+func localizeWatchedContents(db *sql.DB, locales []string, watched []WatchedContent) error {
+	if len(locales) == 0 || len(watched) == 0 {
+		return nil
+	}
+
+	contentIDs := make([]int64, 0, len(watched))
+	for _, content := range watched {
+		contentIDs = append(contentIDs, content.ContentID)
+	}
+	translations, err := loadContentTranslations(db, contentIDs, locales)
+	if err != nil {
+		return err
+	}
+
+	for i := range watched {
+		if translation, ok := translations[watched[i].ContentID]; ok {
+			watched[i].Title = translation.Title
+		}
+	}
+	return nil
+}
+
+// GetGenres 장르 목록 조회 (선호 언어로 번역 후 이름순)
+func GetGenres(db *sql.DB, locales []string) ([]Genre, error) {
+	rows, err := db.Query("SELECT id, name, IFNULL(description, '') FROM Genres ORDER BY name")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	genres := []Genre{}
+	for rows.Next() {
+		var genre Genre
+		if err := rows.Scan(&genre.ID, &genre.Name, &genre.Description); err != nil {
+			return nil, err
+		}
+		genres = append(genres, genre)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(locales) > 0 {
+		if err := localizeGenres(db, locales, genres); err != nil {
+			return nil, err
+		}
+		sort.SliceStable(genres, func(i, j int) bool {
+			return genres[i].Name < genres[j].Name
+		})
+	}
+	return genres, nil
+}
+
+// GetContentTranslations 콘텐츠의 모든 번역 조회 (관리자)
+func GetContentTranslations(db *sql.DB, contentID int64) ([]ContentTranslation, error) {
+	rows, err := db.Query(`
+		SELECT locale, title, IFNULL(description, '')
+		FROM ContentTranslations
+		WHERE content_id = ?
+		ORDER BY locale ASC
+	`, contentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	translations := []ContentTranslation{}
+	for rows.Next() {
+		var translation ContentTranslation
+		if err := rows.Scan(&translation.Locale, &translation.Title, &translation.Description); err != nil {
+			return nil, err
+		}
+		translations = append(translations, translation)
+	}
+	return translations, rows.Err()
+}
+
+// SetContentTranslation 콘텐츠 번역 저장 (같은 언어의 번역이 있으면 교체)
+func SetContentTranslation(db *sql.DB, contentID int64, translation *ContentTranslation) error {
+	_, err := db.Exec(`
+		INSERT INTO ContentTranslations (content_id, locale, title, description)
+		VALUES (?, ?, ?, NULLIF(?, ''))
+		ON DUPLICATE KEY UPDATE title = VALUES(title), description = VALUES(description)
+	`, contentID, translation.Locale, translation.Title, translation.Description)
+	return err
+}
+
+// DeleteContentTranslation 콘텐츠 번역 삭제
+func DeleteContentTranslation(db *sql.DB, contentID int64, locale string) error {
+	_, err := db.Exec("DELETE FROM ContentTranslations WHERE content_id = ? AND locale = ?", contentID, locale)
+	return err
+}
+
+// GetGenreTranslations 장르의 모든 번역 조회 (관리자)
+func GetGenreTranslations(db *sql.DB, genreID int64) ([]GenreTranslation, error) {
+	rows, err := db.Query(`
+		SELECT locale, name, IFNULL(description, '')
+		FROM GenreTranslations
+		WHERE genre_id = ?
+		ORDER BY locale ASC
+	`, genreID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	translations := []GenreTranslation{}
+	for rows.Next() {
+		var translation GenreTranslation
+		if err := rows.Scan(&translation.Locale, &translation.Name, &translation.Description); err != nil {
+			return nil, err
+		}
+		translations = append(translations, translation)
+	}
+	return translations, rows.Err()
+}
+
+// SetGenreTranslation 장르 번역 저장 (같은 언어의 번역이 있으면 교체)
+func SetGenreTranslation(db *sql.DB, genreID int64, translation *GenreTranslation) error {
+	_, err := db.Exec(`
+		INSERT INTO GenreTranslations (genre_id, locale, name, description)
+		VALUES (?, ?, ?, NULLIF(?, ''))
+		ON DUPLICATE KEY UPDATE name = VALUES(name), description = VALUES(description)
+	`, genreID, translation.Locale, translation.Name, translation.Description)
+	return err
+}
+
+// DeleteGenreTranslation 장르 번역 삭제
+func DeleteGenreTranslation(db *sql.DB, genreID int64, locale string) error {
+	_, err := db.Exec("DELETE FROM GenreTranslations WHERE genre_id = ? AND locale = ?", genreID, locale)
+	return err
+}
+
+// GenreExists 장르 존재 여부 확인
+func GenreExists(db *sql.DB, genreID int64) (bool, error) {
+	var exists bool
+	err := db.QueryRow("SELECT EXISTS(SELECT 1 FROM Genres WHERE id = ?)", genreID).Scan(&exists)
+	return exists, err
+}
@@ -0,0 +1,158 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+// RecoveryCodeCount 발급하는 복구 코드 수
+const RecoveryCodeCount = 10
+
+// TwoFactorState 계정의 2단계 인증 상태
+type TwoFactorState struct {
+	Secret   string // TOTP 비밀 키 (등록 중이거나 활성화된 경우)
+	Enabled  bool   // 2단계 인증 활성화 여부
+	LastStep int64  // 마지막으로 사용된 TOTP 주기 번호 (코드 재사용 방지)
+}
+
+// TwoFactorEnrollResponse 2단계 인증 등록 응답 모델
+// @Description 인증 앱에 등록할 비밀 키와 otpauth URI
+type TwoFactorEnrollResponse struct {
+	Secret     string `json:"secret"`      // TOTP 비밀 키 (base32, 수동 입력용)
+	OTPAuthURI string `json:"otpauth_uri"` // 인증 앱 등록용 URI (QR 코드로 표시)
+}
+
+// TwoFactorCodeRequest 2단계 인증 코드 확인 요청 모델
+// @Description 인증 앱에 표시된 6자리 코드
+type TwoFactorCodeRequest struct {
+	Code string `json:"code" binding:"required" example:"123456"` // TOTP 코드
+}
+
+// TwoFactorDisableRequest 2단계 인증 해제 요청 모델
+// @Description 계정 비밀번호와 TOTP 코드 또는 복구 코드
+type TwoFactorDisableRequest struct {
+	CurrentPassword string `json:"current_password" binding:"required"` // 현재 계정 비밀번호
+	Code            string `json:"code"`                                // TOTP 코드
+	RecoveryCode    string `json:"recovery_code"`                       // 복구 코드 (TOTP 코드 대신 사용)
+}
+
+// TwoFactorLoginRequest 2단계 인증 로그인 요청 모델
+// @Description 로그인 1단계에서 받은 대기 토큰과 TOTP 코드 또는 복구 코드
+type TwoFactorLoginRequest struct {
+	ChallengeToken string `json:"challenge_token" binding:"required"`            // 2단계 인증 대기 토큰
+	Code           string `json:"code"`                                          // TOTP 코드
+	RecoveryCode   string `json:"recovery_code"`                                 // 복구 코드 (TOTP 코드 대신 사용)
+	DeviceName     string `json:"device_name" binding:"max=100" example:"거실 TV"` // 기기 이름 (세션 목록 표시용, 선택)
+}
+
+// TwoFactorChallengeResponse 2단계 인증이 필요한 로그인 응답 모델
+// @Description 비밀번호 확인 후 발급되는 2단계 인증 대기 토큰
+type TwoFactorChallengeResponse struct {
+	TwoFactorRequired bool   `json:"two_factor_required"` // 항상 true
+	ChallengeToken    string `json:"challenge_token"`     // 2단계 인증 대기 토큰 (/auth/login/two-factor에 전달)
+	ExpiresIn         int64  `json:"expires_in"`          // 대기 토큰 유효 시간(초)
+}
+
+// RecoveryCodesResponse 복구 코드 응답 모델
+// @Description 인증 앱을 사용할 수 없을 때 한 번씩 사용할 수 있는 복구 코드 (다시 조회할 수 없음)
+type RecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"` // 복구 코드 목록
+}
+
+// GetTwoFactorState 계정의 2단계 인증 상태 조회
+func GetTwoFactorState(db *sql.DB, userID int64) (*TwoFactorState, error) {
+	var secret sql.NullString
+	state := &TwoFactorState{}
+	err := db.QueryRow(
+		"SELECT totp_secret, totp_enabled, totp_last_step FROM Users WHERE id = ?", userID,
+	).Scan(&secret, &state.Enabled, &state.LastStep)
+	if err != nil {
+		return nil, err
+	}
+	state.Secret = secret.String
+	return state, nil
+}
+
+// SetPendingTOTPSecret 2단계 인증 등록 시작 (코드 확인 전까지 비활성 상태)
+func SetPendingTOTPSecret(db *sql.DB, userID int64, secret string) error {
+	_, err := db.Exec(
+		"UPDATE Users SET totp_secret = ?, totp_enabled = false, totp_last_step = 0, updated_at = ? WHERE id = ?",
+		secret, time.Now(), userID,
+	)
+	return err
+}
+
+// EnableTwoFactor 2단계 인증 활성화 및 복구 코드 교체 (트랜잭션)
+func EnableTwoFactor(db *sql.DB, userID, step int64, recoveryCodeHashes []string) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	now := time.Now()
+	if _, err := tx.Exec(
+		"UPDATE Users SET totp_enabled = true, totp_last_step = ?, updated_at = ? WHERE id = ?", step, now, userID,
+	); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec("DELETE FROM RecoveryCodes WHERE user_id = ?", userID); err != nil {
+		return err
+	}
+	for _, hash := range recoveryCodeHashes {
+		if _, err := tx.Exec(
+			"INSERT INTO RecoveryCodes (user_id, code_hash, created_at) VALUES (?, ?, ?)", userID, hash, now,
+		); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// DisableTwoFactor 2단계 인증 해제 (비밀 키와 복구 코드 삭제)
+func DisableTwoFactor(db *sql.DB, userID int64) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(
+		"UPDATE Users SET totp_secret = NULL, totp_enabled = false, totp_last_step = 0, updated_at = ? WHERE id = ?",
+		time.Now(), userID,
+	); err != nil {
+		return err
+	}
+	if _, err := tx.Exec("DELETE FROM RecoveryCodes WHERE user_id = ?", userID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// UseTOTPStep TOTP 주기 번호 사용 처리 (이미 같거나 이후 주기의 코드가 사용되었으면 false)
+func UseTOTPStep(db *sql.DB, userID, step int64) (bool, error) {
+	result, err := db.Exec(
+		"UPDATE Users SET totp_last_step = ? WHERE id = ? AND totp_last_step < ?", step, userID, step,
+	)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	return affected > 0, err
+}
+
+// UseRecoveryCode 사용되지 않은 복구 코드 사용 처리 (일치하는 코드가 없으면 false)
+func UseRecoveryCode(db *sql.DB, userID int64, codeHash string) (bool, error) {
+	result, err := db.Exec(
+		"UPDATE RecoveryCodes SET used_at = ? WHERE user_id = ? AND code_hash = ? AND used_at IS NULL",
+		time.Now(), userID, codeHash,
+	)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	return affected > 0, err
+}
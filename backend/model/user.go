@@ -17,6 +17,8 @@ type User struct {
 	CreatedAt time.Time `db:"created_at" json:"created_at"`                    // 가입일시
 	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`                    // 정보 수정일시
 	IsActive  bool      `db:"is_active" json:"is_active"`                      // 계정 활성화 상태
+
+	EmailVerified bool `db:"email_verified" json:"email_verified"` // 이메일 인증 여부
 }
 
 // UserResponse 사용자 응답 모델 (비밀번호 제외)
@@ -27,28 +29,36 @@ type UserResponse struct {
 	Name      string    `json:"name"`       // 사용자 이름
 	CreatedAt time.Time `json:"created_at"` // 가입일시
 	IsActive  bool      `json:"is_active"`  // 계정 활성화 상태
+
+	EmailVerified bool `json:"email_verified"` // 이메일 인증 여부
 }
 
 // LoginRequest 로그인 요청 모델
 // @Description 로그인 시 클라이언트에서 전송하는 데이터 모델
 type LoginRequest struct {
-	Email    string `json:"email" binding:"required,email"` // 사용자 이메일
-	Password string `json:"password" binding:"required"`    // 사용자 비밀번호
+	Email      string `json:"email" binding:"required,email"`                // 사용자 이메일
+	Password   string `json:"password" binding:"required"`                   // 사용자 비밀번호
+	DeviceName string `json:"device_name" binding:"max=100" example:"거실 TV"` // 기기 이름 (세션 목록 표시용, 선택)
 }
 
 // LoginResponse 로그인 응답 모델
 // @Description 로그인 성공 시 반환되는 데이터 모델
 type LoginResponse struct {
-	Token string       `json:"token"` // JWT 토큰
-	User  UserResponse `json:"user"`  // 사용자 정보
+	Token        string       `json:"token"`         // 단기 액세스 토큰 (기본 프로필 기준)
+	RefreshToken string       `json:"refresh_token"` // 리프레시 토큰
+	ExpiresIn    int64        `json:"expires_in"`    // 액세스 토큰 유효 시간(초)
+	User         UserResponse `json:"user"`          // 사용자 정보
+	Profile      Profile      `json:"profile"`       // 기본 프로필 정보
+
+	DeletionCancelled bool `json:"deletion_cancelled,omitempty"` // 예약된 회원 탈퇴가 이번 로그인으로 취소되었는지 여부
 }
 
 // RegisterRequest 회원가입 요청 모델
 // @Description 회원가입 시 클라이언트에서 전송하는 데이터 모델
 type RegisterRequest struct {
-	Email    string `json:"email" binding:"required,email"`    // 사용자 이메일
-	Password string `json:"password" binding:"required,min=6"` // 사용자 비밀번호 (최소 6자)
-	Name     string `json:"name" binding:"required"`           // 사용자 이름
+	Email    string `json:"email" binding:"required,email"` // 사용자 이메일
+	Password string `json:"password" binding:"required"`    // 사용자 비밀번호 (비밀번호 정책 적용)
+	Name     string `json:"name" binding:"required"`        // 사용자 이름
 }
 
 // UpdateProfileRequest 사용자 정보 업데이트 요청 모델
@@ -67,6 +77,8 @@ func (u *User) ToUserResponse() UserResponse {
 		Name:      u.Name,
 		CreatedAt: u.CreatedAt,
 		IsActive:  u.IsActive,
+
+		EmailVerified: u.EmailVerified,
 	}
 }
 
@@ -120,13 +132,16 @@ func CreateUser(db *sql.DB, user *RegisterRequest) (*User, error) {
 	}, nil
 }
 
+// userColumns 사용자 조회 컬럼 목록
+const userColumns = "id, email, password_hash, name, created_at, updated_at, is_active, email_verified_at IS NOT NULL"
+
 // GetUserByEmail 이메일로 사용자 조회
 func GetUserByEmail(db *sql.DB, email string) (*User, error) {
 	user := &User{}
 	err := db.QueryRow(
-		"SELECT id, email, password_hash, name, created_at, updated_at, is_active FROM Users WHERE email = ?",
+		"SELECT "+userColumns+" FROM Users WHERE email = ?",
 		email,
-	).Scan(&user.ID, &user.Email, &user.Password, &user.Name, &user.CreatedAt, &user.UpdatedAt, &user.IsActive)
+	).Scan(&user.ID, &user.Email, &user.Password, &user.Name, &user.CreatedAt, &user.UpdatedAt, &user.IsActive, &user.EmailVerified)
 
 	if err != nil {
 		return nil, err
@@ -139,9 +154,9 @@ func GetUserByEmail(db *sql.DB, email string) (*User, error) {
 func GetUserByID(db *sql.DB, id int64) (*User, error) {
 	user := &User{}
 	err := db.QueryRow(
-		"SELECT id, email, password_hash, name, created_at, updated_at, is_active FROM Users WHERE id = ?",
+		"SELECT "+userColumns+" FROM Users WHERE id = ?",
 		id,
-	).Scan(&user.ID, &user.Email, &user.Password, &user.Name, &user.CreatedAt, &user.UpdatedAt, &user.IsActive)
+	).Scan(&user.ID, &user.Email, &user.Password, &user.Name, &user.CreatedAt, &user.UpdatedAt, &user.IsActive, &user.EmailVerified)
 
 	if err != nil {
 		return nil, err
@@ -177,3 +192,34 @@ func UpdateUser(db *sql.DB, id int64, update *UpdateProfileRequest) error {
 	)
 	return err
 }
+
+// SetUserPassword 비밀번호 변경 (비밀번호 재설정)
+func SetUserPassword(db *sql.DB, id int64, password string) error {
+	hashedPassword, err := HashPassword(password)
+	if err != nil {
+		return err
+	}
+
+	_, err = db.Exec("UPDATE Users SET password_hash = ?, updated_at = ? WHERE id = ?", hashedPassword, time.Now(), id)
+	return err
+}
+
+// MarkEmailVerified 이메일 인증 완료 처리 (이미 인증된 경우 최초 인증 시각 유지)
+func MarkEmailVerified(db *sql.DB, id int64) error {
+	_, err := db.Exec("UPDATE Users SET email_verified_at = IFNULL(email_verified_at, ?) WHERE id = ?", time.Now(), id)
+	return err
+}
+
+// IsEmailVerified 이메일 인증 여부 확인
+func IsEmailVerified(db *sql.DB, id int64) (bool, error) {
+	var verified bool
+	err := db.QueryRow("SELECT email_verified_at IS NOT NULL FROM Users WHERE id = ?", id).Scan(&verified)
+	return verified, err
+}
+
+// IsUserAdmin 관리자 계정인지 확인 (비활성화된 계정은 관리자로 보지 않음)
+func IsUserAdmin(db *sql.DB, id int64) (bool, error) {
+	var isAdmin bool
+	err := db.QueryRow("SELECT is_admin AND is_active FROM Users WHERE id = ?", id).Scan(&isAdmin)
+	return isAdmin, err
+}
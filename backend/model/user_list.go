@@ -0,0 +1,458 @@
+package model
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+)
+
+// DefaultUserListName 기본 목록(찜 목록) 이름
+const DefaultUserListName = "찜 목록"
+
+// SharedUserListPath 공유 목록 조회 경로 (뒤에 공유 토큰)
+const SharedUserListPath = "/api/shared-lists/"
+
+// 사용자 목록 관련 오류
+var (
+	ErrUserListNameTaken    = errors.New("이미 같은 이름의 목록이 있습니다")
+	ErrUserListLimit        = errors.New("더 이상 목록을 만들 수 없습니다")
+	ErrDefaultUserList      = errors.New("기본 찜 목록은 이름을 바꾸거나 삭제할 수 없습니다")
+	ErrDefaultUserListOrder = errors.New("기본 찜 목록은 찜한 순서대로 정렬되어 순서를 바꿀 수 없습니다")
+	ErrUserListSameTarget   = errors.New("같은 목록으로는 옮길 수 없습니다")
+)
+
+// UserList 사용자 목록 모델
+// @Description 프로필별 이름 있는 콘텐츠 목록 (기본 목록은 찜 목록)
+type UserList struct {
+	ID         int64     `json:"id" example:"1"`
+	ProfileID  int64     `json:"-"`
+	Name       string    `json:"name" example:"주말에 볼 영화"`                                   // 목록 이름
+	IsDefault  bool      `json:"is_default" example:"false"`                                // 기본 목록(찜 목록) 여부
+	Position   int       `json:"position" example:"0"`                                      // 목록 순서 (작을수록 먼저, 기본 목록은 항상 처음)
+	ItemCount  int       `json:"item_count" example:"12"`                                   // 담긴 콘텐츠 수
+	ShareToken string    `json:"-"`                                                         // 공유 링크 토큰 (공유하지 않으면 빈 값)
+	SharePath  string    `json:"share_path,omitempty" example:"/api/shared-lists/3q2-7wLk"` // 공유 링크 경로 (공유 중인 경우)
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// UserListRequest 목록 생성/이름 변경 요청 모델
+// @Description 목록 이름 (최대 50자)
+type UserListRequest struct {
+	Name string `json:"name" binding:"required,max=50" example:"주말에 볼 영화"`
+}
+
+// UserListOrderRequest 목록 순서 변경 요청 모델
+// @Description 목록 ID 목록 (목록 순서가 노출 순서, 빠진 목록은 뒤로, 기본 목록은 항상 처음)
+type UserListOrderRequest struct {
+	ListIDs []int64 `json:"list_ids" binding:"required,dive,gt=0" example:"3,2"`
+}
+
+// UserListItemRequest 목록 콘텐츠 추가 요청 모델
+// @Description 목록에 추가할 콘텐츠 (목록 끝에 추가)
+type UserListItemRequest struct {
+	ContentID int64 `json:"content_id" binding:"required,gt=0" example:"1"`
+}
+
+// UserListItemsOrderRequest 목록 콘텐츠 순서 변경 요청 모델
+// @Description 콘텐츠 ID 목록 (목록 순서가 노출 순서, 빠진 콘텐츠는 뒤로)
+type UserListItemsOrderRequest struct {
+	ContentIDs []int64 `json:"content_ids" binding:"required,dive,gt=0" example:"3,1,2"`
+}
+
+// UserListMoveRequest 목록 콘텐츠 이동 요청 모델
+// @Description 콘텐츠를 옮길 대상 목록 (대상 목록 끝에 추가)
+type UserListMoveRequest struct {
+	TargetListID int64 `json:"target_list_id" binding:"required,gt=0" example:"2"`
+}
+
+// UserListDetailResponse 목록 상세 응답 모델
+// @Description 목록 정보와 순서대로 정렬된 콘텐츠 목록
+type UserListDetailResponse struct {
+	UserList
+	Items []ContentListResponse `json:"items"` // 콘텐츠 목록 (장르, 찜 여부 포함)
+}
+
+// SharedUserListResponse 공유 목록 응답 모델
+// @Description 공유 링크로 조회한 읽기 전용 목록
+type SharedUserListResponse struct {
+	Name      string                `json:"name" example:"주말에 볼 영화"` // 목록 이름
+	OwnerName string                `json:"owner_name" example:"민수"` // 목록을 만든 프로필 이름
+	UpdatedAt time.Time             `json:"updated_at"`
+	Items     []ContentListResponse `json:"items"` // 콘텐츠 목록 (시청자의 시청 등급/지역에 맞는 콘텐츠만)
+}
+
+// userListExecer 목록 항목 변경에 사용하는 DB/트랜잭션 공통 인터페이스
+type userListExecer interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+}
+
+// userListColumns 목록 조회 공통 컬럼 (기본 목록의 항목 수는 찜 목록 기준)
+const userListColumns = `
+	SELECT
+		l.id, l.profile_id, l.name, l.is_default, l.position, IFNULL(l.share_token, ''), l.created_at, l.updated_at,
+		IF(l.is_default,
+			(SELECT COUNT(*) FROM Wishlists w WHERE w.profile_id = l.profile_id),
+			(SELECT COUNT(*) FROM UserListItems li WHERE li.list_id = l.id))
+	FROM
+		UserLists l
+`
+
+// scanUserList 목록 조회 결과 한 행 변환
+func scanUserList(scanner interface{ Scan(...interface{}) error }) (*UserList, error) {
+	var list UserList
+	if err := scanner.Scan(
+		&list.ID, &list.ProfileID, &list.Name, &list.IsDefault, &list.Position, &list.ShareToken,
+		&list.CreatedAt, &list.UpdatedAt, &list.ItemCount,
+	); err != nil {
+		return nil, err
+	}
+	if list.ShareToken != "" {
+		list.SharePath = SharedUserListPath + list.ShareToken
+	}
+	return &list, nil
+}
+
+// EnsureDefaultUserList 프로필의 기본 목록 ID 조회 (없으면 생성)
+func EnsureDefaultUserList(db *sql.DB, profileID int64) (int64, error) {
+	if _, err := db.Exec(`
+		INSERT IGNORE INTO UserLists (profile_id, name, is_default, position, created_at, updated_at)
+		VALUES (?, ?, TRUE, 0, ?, ?)
+	`, profileID, DefaultUserListName, time.Now(), time.Now()); err != nil {
+		return 0, err
+	}
+
+	var listID int64
+	err := db.QueryRow("SELECT id FROM UserLists WHERE profile_id = ? AND is_default = TRUE", profileID).Scan(&listID)
+	return listID, err
+}
+
+// GetUserLists 프로필의 목록 조회 (기본 목록이 처음, 나머지는 순서대로)
+func GetUserLists(db *sql.DB, profileID int64) ([]UserList, error) {
+	rows, err := db.Query(userListColumns+`
+		WHERE l.profile_id = ?
+		ORDER BY l.is_default DESC, l.position ASC, l.id ASC
+	`, profileID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	lists := []UserList{}
+	for rows.Next() {
+		list, err := scanUserList(rows)
+		if err != nil {
+			return nil, err
+		}
+		lists = append(lists, *list)
+	}
+	return lists, rows.Err()
+}
+
+// GetUserList 프로필의 목록 조회 (다른 프로필의 목록이거나 없으면 sql.ErrNoRows)
+func GetUserList(db *sql.DB, profileID, listID int64) (*UserList, error) {
+	return scanUserList(db.QueryRow(userListColumns+`
+		WHERE l.id = ? AND l.profile_id = ?
+	`, listID, profileID))
+}
+
+// GetSharedUserList 공유 토큰으로 목록과 목록을 만든 프로필 이름 조회 (없으면 sql.ErrNoRows)
+func GetSharedUserList(db *sql.DB, token string) (*UserList, string, error) {
+	list, err := scanUserList(db.QueryRow(userListColumns+`
+		WHERE l.share_token = ?
+	`, token))
+	if err != nil {
+		return nil, "", err
+	}
+
+	var ownerName string
+	if err := db.QueryRow("SELECT name FROM Profiles WHERE id = ?", list.ProfileID).Scan(&ownerName); err != nil {
+		return nil, "", err
+	}
+	return list, ownerName, nil
+}
+
+// checkUserListNameAvailable 프로필의 다른 목록이 이름을 사용 중이면 ErrUserListNameTaken (기본 목록 이름은 예약)
+func checkUserListNameAvailable(db *sql.DB, profileID, listID int64, name string) error {
+	if name == DefaultUserListName {
+		return ErrUserListNameTaken
+	}
+	var taken bool
+	err := db.QueryRow(
+		"SELECT EXISTS(SELECT 1 FROM UserLists WHERE profile_id = ? AND name = ? AND id <> ?)",
+		profileID, name, listID,
+	).Scan(&taken)
+	if err != nil {
+		return err
+	}
+	if taken {
+		return ErrUserListNameTaken
+	}
+	return nil
+}
+
+// CreateUserList 목록 생성 (목록 끝에 추가, 기본 목록을 제외한 목록이 maxLists개 이상이면 ErrUserListLimit)
+func CreateUserList(db *sql.DB, profileID int64, name string, maxLists int) (*UserList, error) {
+	name = strings.TrimSpace(name)
+	if err := checkUserListNameAvailable(db, profileID, 0, name); err != nil {
+		return nil, err
+	}
+
+	var count, nextPosition int
+	if err := db.QueryRow(
+		"SELECT COUNT(*), IFNULL(MAX(position) + 1, 0) FROM UserLists WHERE profile_id = ? AND is_default = FALSE",
+		profileID,
+	).Scan(&count, &nextPosition); err != nil {
+		return nil, err
+	}
+	if count >= maxLists {
+		return nil, ErrUserListLimit
+	}
+
+	now := time.Now()
+	result, err := db.Exec(`
+		INSERT INTO UserLists (profile_id, name, is_default, position, created_at, updated_at)
+		VALUES (?, ?, FALSE, ?, ?, ?)
+	`, profileID, name, nextPosition, now, now)
+	if err != nil {
+		return nil, err
+	}
+	listID, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+
+	return GetUserList(db, profileID, listID)
+}
+
+// RenameUserList 목록 이름 변경 (기본 목록은 ErrDefaultUserList)
+func RenameUserList(db *sql.DB, list *UserList, name string) (*UserList, error) {
+	if list.IsDefault {
+		return nil, ErrDefaultUserList
+	}
+	name = strings.TrimSpace(name)
+	if err := checkUserListNameAvailable(db, list.ProfileID, list.ID, name); err != nil {
+		return nil, err
+	}
+
+	if _, err := db.Exec("UPDATE UserLists SET name = ?, updated_at = ? WHERE id = ?", name, time.Now(), list.ID); err != nil {
+		return nil, err
+	}
+	return GetUserList(db, list.ProfileID, list.ID)
+}
+
+// DeleteUserList 목록 삭제 (담긴 콘텐츠도 함께 삭제, 기본 목록은 ErrDefaultUserList)
+func DeleteUserList(db *sql.DB, list *UserList) error {
+	if list.IsDefault {
+		return ErrDefaultUserList
+	}
+	_, err := db.Exec("DELETE FROM UserLists WHERE id = ?", list.ID)
+	return err
+}
+
+// ReorderUserLists 목록 순서 변경 (목록 순서대로 0부터, 목록에 없는 목록은 그 뒤로 기존 순서 유지)
+// 다른 프로필의 목록 ID는 무시
+func ReorderUserLists(db *sql.DB, profileID int64, listIDs []int64) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if len(listIDs) > 0 {
+		if _, err := tx.Exec(
+			"UPDATE UserLists SET position = position + ? WHERE profile_id = ? AND id NOT IN ("+inPlaceholders(len(listIDs))+")",
+			append([]interface{}{len(listIDs), profileID}, int64Args(listIDs)...)...,
+		); err != nil {
+			return err
+		}
+	}
+	for position, listID := range listIDs {
+		if _, err := tx.Exec(
+			"UPDATE UserLists SET position = ? WHERE id = ? AND profile_id = ?",
+			position, listID, profileID,
+		); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// GetUserListItems 목록의 콘텐츠 조회 (기본 목록은 찜한 시각 역순, 나머지는 목록 순서)
+// 시청자의 시청 등급/이용 가능 지역에 맞는 콘텐츠만 포함
+func GetUserListItems(db *sql.DB, viewer Viewer, list *UserList) ([]ContentListResponse, error) {
+	if list.IsDefault && list.ProfileID == viewer.ProfileID {
+		return GetWishlist(db, viewer)
+	}
+
+	args := append([]interface{}{list.ID}, viewer.catalogArgs()...)
+	if list.IsDefault {
+		args[0] = list.ProfileID
+		return queryContentList(db, viewer, `
+			SELECT
+				c.id, c.title, c.thumbnail_url, c.release_year
+			FROM
+				Wishlists w
+			JOIN
+				Contents c ON c.id = w.content_id
+			WHERE
+				w.profile_id = ? AND `+catalogCondition("c")+`
+			ORDER BY
+				w.created_at DESC
+		`, args...)
+	}
+
+	return queryContentList(db, viewer, `
+		SELECT
+			c.id, c.title, c.thumbnail_url, c.release_year
+		FROM
+			UserListItems li
+		JOIN
+			Contents c ON c.id = li.content_id
+		WHERE
+			li.list_id = ? AND `+catalogCondition("c")+`
+		ORDER BY
+			li.position ASC, li.added_at ASC
+	`, args...)
+}
+
+// addUserListItem 목록 끝에 콘텐츠 추가 (이미 있으면 false)
+func addUserListItem(exec userListExecer, list *UserList, contentID int64) (bool, error) {
+	var result sql.Result
+	var err error
+	if list.IsDefault {
+		result, err = exec.Exec(
+			"INSERT IGNORE INTO Wishlists (profile_id, content_id, created_at) VALUES (?, ?, ?)",
+			list.ProfileID, contentID, time.Now(),
+		)
+	} else {
+		result, err = exec.Exec(`
+			INSERT IGNORE INTO UserListItems (list_id, content_id, position, added_at)
+			SELECT ?, ?, IFNULL(MAX(position) + 1, 0), ? FROM UserListItems WHERE list_id = ?
+		`, list.ID, contentID, time.Now(), list.ID)
+	}
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+// removeUserListItem 목록에서 콘텐츠 제거 (없으면 sql.ErrNoRows)
+func removeUserListItem(exec userListExecer, list *UserList, contentID int64) error {
+	var result sql.Result
+	var err error
+	if list.IsDefault {
+		result, err = exec.Exec("DELETE FROM Wishlists WHERE profile_id = ? AND content_id = ?", list.ProfileID, contentID)
+	} else {
+		result, err = exec.Exec("DELETE FROM UserListItems WHERE list_id = ? AND content_id = ?", list.ID, contentID)
+	}
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// touchUserList 목록 수정 시각 갱신
+func touchUserList(exec userListExecer, listID int64) error {
+	_, err := exec.Exec("UPDATE UserLists SET updated_at = ? WHERE id = ?", time.Now(), listID)
+	return err
+}
+
+// AddUserListItem 목록 끝에 콘텐츠 추가 (이미 있으면 그대로 두고 false)
+func AddUserListItem(db *sql.DB, list *UserList, contentID int64) (bool, error) {
+	added, err := addUserListItem(db, list, contentID)
+	if err != nil || !added {
+		return added, err
+	}
+	return true, touchUserList(db, list.ID)
+}
+
+// RemoveUserListItem 목록에서 콘텐츠 제거 (없으면 sql.ErrNoRows)
+func RemoveUserListItem(db *sql.DB, list *UserList, contentID int64) error {
+	if err := removeUserListItem(db, list, contentID); err != nil {
+		return err
+	}
+	return touchUserList(db, list.ID)
+}
+
+// MoveUserListItem 콘텐츠를 다른 목록으로 이동 (원래 목록에 없으면 sql.ErrNoRows, 대상 목록에 이미 있으면 원래 목록에서만 제거)
+func MoveUserListItem(db *sql.DB, source, target *UserList, contentID int64) error {
+	if source.ID == target.ID {
+		return ErrUserListSameTarget
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := removeUserListItem(tx, source, contentID); err != nil {
+		return err
+	}
+	if _, err := addUserListItem(tx, target, contentID); err != nil {
+		return err
+	}
+	for _, listID := range []int64{source.ID, target.ID} {
+		if err := touchUserList(tx, listID); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// ReorderUserListItems 목록 콘텐츠 순서 변경 (목록 순서대로 0부터, 목록에 없는 콘텐츠는 그 뒤로 기존 순서 유지)
+// 기본 목록은 찜한 순서대로 정렬되므로 ErrDefaultUserListOrder
+func ReorderUserListItems(db *sql.DB, list *UserList, contentIDs []int64) error {
+	if list.IsDefault {
+		return ErrDefaultUserListOrder
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if len(contentIDs) > 0 {
+		if _, err := tx.Exec(
+			"UPDATE UserListItems SET position = position + ? WHERE list_id = ? AND content_id NOT IN ("+inPlaceholders(len(contentIDs))+")",
+			append([]interface{}{len(contentIDs), list.ID}, int64Args(contentIDs)...)...,
+		); err != nil {
+			return err
+		}
+	}
+	for position, contentID := range contentIDs {
+		if _, err := tx.Exec(
+			"UPDATE UserListItems SET position = ? WHERE list_id = ? AND content_id = ?",
+			position, list.ID, contentID,
+		); err != nil {
+			return err
+		}
+	}
+	if err := touchUserList(tx, list.ID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// SetUserListShareToken 목록 공유 토큰 설정 (빈 값이면 공유 해제)
+func SetUserListShareToken(db *sql.DB, listID int64, token string) error {
+	_, err := db.Exec("UPDATE UserLists SET share_token = NULLIF(?, '') WHERE id = ?", token, listID)
+	return err
+}
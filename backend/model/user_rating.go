@@ -0,0 +1,317 @@
+package model
+
+import (
+	"database/sql"
+	"errors"
+	"math"
+	"time"
+)
+
+// 엄지 평가 값
+const (
+	ThumbUp   = "up"   // 좋아요
+	ThumbDown = "down" // 싫어요
+)
+
+// 추천에 반영하는 평가 조건 (ContentRatings 테이블 기준, 인자 없음)
+const (
+	positiveRatingCondition = "(thumb = 1 OR stars >= 4)"  // 좋아요 또는 별점 4점 이상
+	negativeRatingCondition = "(thumb = -1 OR stars <= 2)" // 싫어요 또는 별점 2점 이하
+)
+
+// ErrEmptyUserRating 엄지 평가와 별점이 모두 비어 있음
+var ErrEmptyUserRating = errors.New("엄지 평가 또는 별점 중 하나 이상이 필요합니다")
+
+// UserRating 프로필의 콘텐츠 평가 모델
+// @Description 엄지 평가와 별점 중 하나 또는 둘 다 지정
+type UserRating struct {
+	Thumb     string    `json:"thumb,omitempty" example:"up"` // 엄지 평가 (up, down)
+	Stars     int       `json:"stars,omitempty" example:"4"`  // 별점 (1~5)
+	UpdatedAt time.Time `json:"updated_at"`                   // 마지막 평가 일시
+}
+
+// UserRatingRequest 콘텐츠 평가 요청 모델
+// @Description 콘텐츠 평가 저장 요청 (기존 평가를 통째로 교체)
+type UserRatingRequest struct {
+	Thumb string `json:"thumb" binding:"omitempty,oneof=up down" example:"up"` // 엄지 평가 (up, down, 생략 가능)
+	Stars int    `json:"stars" binding:"omitempty,min=1,max=5" example:"4"`    // 별점 (1~5, 생략 가능)
+}
+
+// Validate 평가 요청 검증 (엄지 평가와 별점 중 하나 이상 필요)
+func (r *UserRatingRequest) Validate() error {
+	if r.Thumb == "" && r.Stars == 0 {
+		return ErrEmptyUserRating
+	}
+	return nil
+}
+
+// ContentRatingSummary 콘텐츠 평가 집계 모델
+// @Description 콘텐츠의 별점 평균과 엄지 평가 수
+type ContentRatingSummary struct {
+	AverageStars float64 `json:"average_stars" example:"4.2"` // 평균 별점 (소수 첫째 자리, 별점이 없으면 0)
+	StarCount    int     `json:"star_count" example:"120"`    // 별점 수
+	ThumbsUp     int     `json:"thumbs_up" example:"98"`      // 좋아요 수
+	ThumbsDown   int     `json:"thumbs_down" example:"7"`     // 싫어요 수
+}
+
+// UserRatingResponse 콘텐츠 평가 저장 응답 모델
+// @Description 저장된 평가와 갱신된 콘텐츠 평가 집계
+type UserRatingResponse struct {
+	MyRating *UserRating          `json:"my_rating"` // 내 평가 (삭제된 경우 null)
+	Rating   ContentRatingSummary `json:"rating"`    // 콘텐츠 평가 집계
+}
+
+// ratingStats ContentRatings 한 행 또는 Contents 집계 컬럼의 증감 값
+type ratingStats struct {
+	thumbsUp   int
+	thumbsDown int
+	starCount  int
+	starSum    int
+}
+
+// summary 집계 값을 응답 모델로 변환
+func (s ratingStats) summary() ContentRatingSummary {
+	summary := ContentRatingSummary{
+		StarCount:  s.starCount,
+		ThumbsUp:   s.thumbsUp,
+		ThumbsDown: s.thumbsDown,
+	}
+	if s.starCount > 0 {
+		summary.AverageStars = math.Round(float64(s.starSum)/float64(s.starCount)*10) / 10
+	}
+	return summary
+}
+
+// minus 두 집계 값의 차이
+func (s ratingStats) minus(other ratingStats) ratingStats {
+	return ratingStats{
+		thumbsUp:   s.thumbsUp - other.thumbsUp,
+		thumbsDown: s.thumbsDown - other.thumbsDown,
+		starCount:  s.starCount - other.starCount,
+		starSum:    s.starSum - other.starSum,
+	}
+}
+
+// userRatingStats 평가 하나가 집계에 기여하는 값 (평가가 없으면 0)
+func userRatingStats(rating *UserRating) ratingStats {
+	var stats ratingStats
+	if rating == nil {
+		return stats
+	}
+	switch rating.Thumb {
+	case ThumbUp:
+		stats.thumbsUp = 1
+	case ThumbDown:
+		stats.thumbsDown = 1
+	}
+	if rating.Stars > 0 {
+		stats.starCount = 1
+		stats.starSum = rating.Stars
+	}
+	return stats
+}
+
+// thumbValue 엄지 평가를 저장 값으로 변환 (up: 1, down: -1, 없으면 NULL)
+func thumbValue(thumb string) sql.NullInt64 {
+	switch thumb {
+	case ThumbUp:
+		return sql.NullInt64{Int64: 1, Valid: true}
+	case ThumbDown:
+		return sql.NullInt64{Int64: -1, Valid: true}
+	}
+	return sql.NullInt64{}
+}
+
+// newUserRating 저장 값으로부터 평가 모델 생성
+func newUserRating(thumb, stars sql.NullInt64, updatedAt time.Time) *UserRating {
+	rating := &UserRating{UpdatedAt: updatedAt}
+	if thumb.Valid {
+		rating.Thumb = ThumbDown
+		if thumb.Int64 > 0 {
+			rating.Thumb = ThumbUp
+		}
+	}
+	if stars.Valid {
+		rating.Stars = int(stars.Int64)
+	}
+	return rating
+}
+
+// nullStars 별점을 저장 값으로 변환 (0이면 NULL)
+func nullStars(stars int) sql.NullInt64 {
+	return sql.NullInt64{Int64: int64(stars), Valid: stars > 0}
+}
+
+// getUserRatingForUpdate 트랜잭션 안에서 평가 조회 후 잠금 (없으면 nil)
+func getUserRatingForUpdate(tx *sql.Tx, profileID, contentID int64) (*UserRating, error) {
+	var thumb, stars sql.NullInt64
+	var updatedAt time.Time
+	err := tx.QueryRow(`
+		SELECT thumb, stars, updated_at
+		FROM ContentRatings
+		WHERE profile_id = ? AND content_id = ?
+		FOR UPDATE
+	`, profileID, contentID).Scan(&thumb, &stars, &updatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return newUserRating(thumb, stars, updatedAt), nil
+}
+
+// applyRatingStats 콘텐츠 평가 집계 컬럼에 증감 값 반영
+func applyRatingStats(tx *sql.Tx, contentID int64, delta ratingStats) error {
+	_, err := tx.Exec(`
+		UPDATE Contents
+		SET thumbs_up_count = thumbs_up_count + ?, thumbs_down_count = thumbs_down_count + ?,
+			star_rating_count = star_rating_count + ?, star_rating_sum = star_rating_sum + ?
+		WHERE id = ?
+	`, delta.thumbsUp, delta.thumbsDown, delta.starCount, delta.starSum, contentID)
+	return err
+}
+
+// getRatingStats 트랜잭션 안에서 콘텐츠 평가 집계 조회
+func getRatingStats(tx *sql.Tx, contentID int64) (ratingStats, error) {
+	var stats ratingStats
+	err := tx.QueryRow(`
+		SELECT thumbs_up_count, thumbs_down_count, star_rating_count, star_rating_sum
+		FROM Contents
+		WHERE id = ?
+	`, contentID).Scan(&stats.thumbsUp, &stats.thumbsDown, &stats.starCount, &stats.starSum)
+	return stats, err
+}
+
+// SetUserRating 프로필의 콘텐츠 평가 저장 (기존 평가는 교체)
+// 기존 평가와의 차이만큼 콘텐츠 집계 컬럼을 같은 트랜잭션에서 갱신
+func SetUserRating(db *sql.DB, profileID, contentID int64, req *UserRatingRequest) (*UserRatingResponse, error) {
+	tx, err := db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	previous, err := getUserRatingForUpdate(tx, profileID, contentID)
+	if err != nil {
+		return nil, err
+	}
+
+	rating := &UserRating{Thumb: req.Thumb, Stars: req.Stars, UpdatedAt: time.Now()}
+	if _, err := tx.Exec(`
+		INSERT INTO ContentRatings (profile_id, content_id, thumb, stars, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE thumb = VALUES(thumb), stars = VALUES(stars), updated_at = VALUES(updated_at)
+	`, profileID, contentID, thumbValue(rating.Thumb), nullStars(rating.Stars), rating.UpdatedAt, rating.UpdatedAt); err != nil {
+		return nil, err
+	}
+
+	if err := applyRatingStats(tx, contentID, userRatingStats(rating).minus(userRatingStats(previous))); err != nil {
+		return nil, err
+	}
+	stats, err := getRatingStats(tx, contentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return &UserRatingResponse{MyRating: rating, Rating: stats.summary()}, nil
+}
+
+// DeleteUserRating 프로필의 콘텐츠 평가 삭제 후 갱신된 집계 반환 (평가가 없으면 sql.ErrNoRows)
+func DeleteUserRating(db *sql.DB, profileID, contentID int64) (*UserRatingResponse, error) {
+	tx, err := db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	previous, err := getUserRatingForUpdate(tx, profileID, contentID)
+	if err != nil {
+		return nil, err
+	}
+	if previous == nil {
+		return nil, sql.ErrNoRows
+	}
+
+	if _, err := tx.Exec("DELETE FROM ContentRatings WHERE profile_id = ? AND content_id = ?", profileID, contentID); err != nil {
+		return nil, err
+	}
+	if err := applyRatingStats(tx, contentID, ratingStats{}.minus(userRatingStats(previous))); err != nil {
+		return nil, err
+	}
+	stats, err := getRatingStats(tx, contentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return &UserRatingResponse{Rating: stats.summary()}, nil
+}
+
+// removeProfileRatingStats 프로필 삭제 전 해당 프로필의 평가를 콘텐츠 집계에서 제외
+func removeProfileRatingStats(tx *sql.Tx, userID, profileID int64) error {
+	_, err := tx.Exec(`
+		UPDATE Contents c
+		JOIN ContentRatings r ON r.content_id = c.id
+		JOIN Profiles p ON p.id = r.profile_id
+		SET c.thumbs_up_count = c.thumbs_up_count - (r.thumb <=> 1),
+			c.thumbs_down_count = c.thumbs_down_count - (r.thumb <=> -1),
+			c.star_rating_count = c.star_rating_count - (r.stars IS NOT NULL),
+			c.star_rating_sum = c.star_rating_sum - IFNULL(r.stars, 0)
+		WHERE r.profile_id = ? AND p.user_id = ?
+	`, profileID, userID)
+	return err
+}
+
+// loadContentRatings 콘텐츠 ID별 평가 집계와 프로필의 평가 일괄 조회 (profileID가 0이면 평가 없음)
+func loadContentRatings(db *sql.DB, profileID int64, contentIDs []int64) (map[int64]ContentRatingSummary, map[int64]*UserRating, error) {
+	summaries := map[int64]ContentRatingSummary{}
+	myRatings := map[int64]*UserRating{}
+	if len(contentIDs) == 0 {
+		return summaries, myRatings, nil
+	}
+
+	args := append([]interface{}{profileID}, int64Args(contentIDs)...)
+	rows, err := db.Query(`
+		SELECT
+			c.id, c.thumbs_up_count, c.thumbs_down_count, c.star_rating_count, c.star_rating_sum,
+			r.thumb, r.stars, r.updated_at
+		FROM
+			Contents c
+		LEFT JOIN
+			ContentRatings r ON r.content_id = c.id AND r.profile_id = ?
+		WHERE
+			c.id IN (`+inPlaceholders(len(contentIDs))+`)
+	`, args...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var contentID int64
+		var stats ratingStats
+		var thumb, stars sql.NullInt64
+		var updatedAt sql.NullTime
+		if err := rows.Scan(
+			&contentID, &stats.thumbsUp, &stats.thumbsDown, &stats.starCount, &stats.starSum,
+			&thumb, &stars, &updatedAt,
+		); err != nil {
+			return nil, nil, err
+		}
+		summaries[contentID] = stats.summary()
+		if updatedAt.Valid {
+			myRatings[contentID] = newUserRating(thumb, stars, updatedAt.Time)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	return summaries, myRatings, nil
+}
@@ -0,0 +1,102 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+// 일회용 토큰 용도
+const (
+	UserTokenPasswordReset     = "password_reset"     // 비밀번호 재설정
+	UserTokenEmailVerification = "email_verification" // 이메일 인증
+)
+
+// ForgotPasswordRequest 비밀번호 재설정 메일 요청 모델
+// @Description 비밀번호 재설정 링크를 받을 이메일
+type ForgotPasswordRequest struct {
+	Email string `json:"email" binding:"required,email"` // 가입한 이메일
+}
+
+// ResetPasswordRequest 비밀번호 재설정 요청 모델
+// @Description 메일로 받은 토큰과 새 비밀번호
+type ResetPasswordRequest struct {
+	Token       string `json:"token" binding:"required"`        // 비밀번호 재설정 토큰
+	NewPassword string `json:"new_password" binding:"required"` // 새 비밀번호 (비밀번호 정책 적용)
+}
+
+// VerifyEmailRequest 이메일 인증 요청 모델
+// @Description 메일로 받은 이메일 인증 토큰
+type VerifyEmailRequest struct {
+	Token string `json:"token" binding:"required"` // 이메일 인증 토큰
+}
+
+// CreateUserToken 일회용 토큰 저장 (같은 용도의 사용되지 않은 기존 토큰은 무효화)
+func CreateUserToken(db *sql.DB, userID int64, purpose, tokenHash string, expiresAt time.Time) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	now := time.Now()
+	if _, err := tx.Exec(
+		"UPDATE UserTokens SET used_at = ? WHERE user_id = ? AND purpose = ? AND used_at IS NULL",
+		now, userID, purpose,
+	); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(
+		"INSERT INTO UserTokens (user_id, purpose, token_hash, expires_at, created_at) VALUES (?, ?, ?, ?, ?)",
+		userID, purpose, tokenHash, expiresAt, now,
+	); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetUserTokenOwner 만료/사용되지 않은 일회용 토큰의 계정 ID 조회 (토큰은 사용 처리하지 않음)
+// 토큰이 없거나 만료/사용된 경우 sql.ErrNoRows
+func GetUserTokenOwner(db *sql.DB, purpose, tokenHash string) (int64, error) {
+	var userID int64
+	err := db.QueryRow(`
+		SELECT user_id FROM UserTokens
+		WHERE token_hash = ? AND purpose = ? AND used_at IS NULL AND expires_at > ?
+	`, tokenHash, purpose, time.Now()).Scan(&userID)
+	return userID, err
+}
+
+// ConsumeUserToken 만료되지 않은 일회용 토큰을 사용 처리하고 계정 ID 반환
+// 토큰이 없거나 만료/사용된 경우 sql.ErrNoRows
+func ConsumeUserToken(db *sql.DB, purpose, tokenHash string) (int64, error) {
+	var id, userID int64
+	err := db.QueryRow(`
+		SELECT id, user_id FROM UserTokens
+		WHERE token_hash = ? AND purpose = ? AND used_at IS NULL AND expires_at > ?
+	`, tokenHash, purpose, time.Now()).Scan(&id, &userID)
+	if err != nil {
+		return 0, err
+	}
+
+	// 동시에 같은 토큰을 사용하는 경우 한 번만 성공
+	result, err := db.Exec("UPDATE UserTokens SET used_at = ? WHERE id = ? AND used_at IS NULL", time.Now(), id)
+	if err != nil {
+		return 0, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	if affected == 0 {
+		return 0, sql.ErrNoRows
+	}
+
+	return userID, nil
+}
+
+// PurgeExpiredUserTokens 만료되었거나 사용된 일회용 토큰 삭제
+func PurgeExpiredUserTokens(db *sql.DB) error {
+	_, err := db.Exec("DELETE FROM UserTokens WHERE expires_at < ? OR used_at IS NOT NULL", time.Now())
+	return err
+}
@@ -8,7 +8,7 @@ import (
 // ViewingHistory 시청 기록 모델
 type ViewingHistory struct {
 	ID            int64     `db:"id" json:"id"`
-	UserID        int64     `db:"user_id" json:"user_id"`
+	ProfileID     int64     `db:"profile_id" json:"profile_id"`
 	ContentID     int64     `db:"content_id" json:"content_id"`
 	WatchDuration int       `db:"watch_duration" json:"watch_duration"`
 	LastPosition  int       `db:"last_position" json:"last_position"`
@@ -31,6 +31,11 @@ type StreamingResponse struct {
 	StreamingURL string `json:"streaming_url" example:"/assets/videos/sample1.mp4"` // 스트리밍 URL
 	Duration     int    `json:"duration" example:"3600"`                            // 총 재생 시간(초)
 	LastPosition int    `json:"last_position" example:"120"`                        // 마지막 시청 위치(초)
+
+	Plan          string `json:"plan" example:"standard"`       // 적용된 요금제 코드
+	MaxResolution int    `json:"max_resolution" example:"1080"` // 요금제에 따른 최대 화질 (세로 해상도)
+
+	StreamID string `json:"stream_id" example:"Jm2Yt1Xx0b0cQv5bW8m1Hw"` // 재생 세션 ID (재생 위치 업데이트/최종 위치 저장 시 전달)
 }
 
 // PlaybackPositionRequest 재생 위치 업데이트 요청 모델
@@ -40,6 +45,8 @@ type PlaybackPositionRequest struct {
 	CurrentPosition int   `json:"current_position" binding:"required" example:"180"` // 현재 재생 위치(초)
 	WatchDuration   int   `json:"watch_duration" binding:"required" example:"180"`   // 누적 시청 시간(초)
 	IsCompleted     bool  `json:"is_completed" example:"false"`                      // 시청 완료 여부
+
+	StreamID string `json:"stream_id" example:"Jm2Yt1Xx0b0cQv5bW8m1Hw"` // 재생 세션 ID (없으면 프로필의 해당 콘텐츠 재생 세션 전체에 적용)
 }
 
 // FinalPositionRequest 최종 재생 위치 저장 요청 모델
@@ -49,10 +56,12 @@ type FinalPositionRequest struct {
 	FinalPosition int   `json:"final_position" binding:"required" example:"3540"` // 최종 재생 위치(초)
 	WatchDuration int   `json:"watch_duration" binding:"required" example:"3540"` // 총 시청 시간(초)
 	IsCompleted   bool  `json:"is_completed" example:"true"`                      // 시청 완료 여부
+
+	StreamID string `json:"stream_id" example:"Jm2Yt1Xx0b0cQv5bW8m1Hw"` // 재생 세션 ID (없으면 프로필의 해당 콘텐츠 재생 세션 전체에 적용)
 }
 
 // UpdateViewingHistory 시청 기록 업데이트
-func UpdateViewingHistory(db *sql.DB, userID int64, req *ViewingHistoryRequest) error {
+func UpdateViewingHistory(db *sql.DB, profileID int64, req *ViewingHistoryRequest) error {
 	// 현재 시간
 	now := time.Now()
 
@@ -61,8 +70,8 @@ func UpdateViewingHistory(db *sql.DB, userID int64, req *ViewingHistoryRequest)
 	var count int
 	err := db.QueryRow(`
 		SELECT COUNT(*), IFNULL(id, 0) FROM ViewingHistories 
-		WHERE user_id = ? AND content_id = ?
-	`, userID, req.ContentID).Scan(&count, &historyID)
+		WHERE profile_id = ? AND content_id = ?
+	`, profileID, req.ContentID).Scan(&count, &historyID)
 	if err != nil {
 		return err
 	}
@@ -80,14 +89,14 @@ func UpdateViewingHistory(db *sql.DB, userID int64, req *ViewingHistoryRequest)
 	// 기존 기록이 없으면 새로 생성
 	_, err = db.Exec(`
 		INSERT INTO ViewingHistories 
-		(user_id, content_id, watch_duration, last_position, watched_at, is_completed) 
+		(profile_id, content_id, watch_duration, last_position, watched_at, is_completed) 
 		VALUES (?, ?, ?, ?, ?, ?)
-	`, userID, req.ContentID, req.WatchDuration, req.LastPosition, now, req.IsCompleted)
+	`, profileID, req.ContentID, req.WatchDuration, req.LastPosition, now, req.IsCompleted)
 	return err
 }
 
-// GetViewingHistory 사용자의 시청 기록 조회
-func GetViewingHistory(db *sql.DB, userID int64) ([]ViewingHistoryResponse, error) {
+// GetViewingHistory 프로필의 시청 기록 조회
+func GetViewingHistory(db *sql.DB, profileID int64) ([]ViewingHistoryResponse, error) {
 	rows, err := db.Query(`
 		SELECT 
 			vh.id, vh.content_id, vh.watch_duration, vh.last_position, vh.watched_at, vh.is_completed,
@@ -97,16 +106,16 @@ func GetViewingHistory(db *sql.DB, userID int64) ([]ViewingHistoryResponse, erro
 		JOIN 
 			Contents c ON vh.content_id = c.id
 		WHERE 
-			vh.user_id = ? AND
+			vh.profile_id = ? AND
 			vh.id IN (
 				SELECT MAX(id) 
 				FROM ViewingHistories 
-				WHERE user_id = ?
+				WHERE profile_id = ?
 				GROUP BY content_id
 			)
 		ORDER BY 
 			vh.watched_at DESC
-	`, userID, userID)
+	`, profileID, profileID)
 	if err != nil {
 		return nil, err
 	}
@@ -124,12 +133,7 @@ func GetViewingHistory(db *sql.DB, userID int64) ([]ViewingHistoryResponse, erro
 		}
 
 		// 시청 진행률 계산 (퍼센트)
-		if history.Duration > 0 {
-			history.ProgressPercent = int(float64(history.WatchDuration) / float64(history.Duration) * 100)
-			if history.ProgressPercent > 100 {
-				history.ProgressPercent = 100
-			}
-		}
+		history.ProgressPercent = calculateProgressPercent(history.WatchDuration, history.Duration)
 
 		historyList = append(historyList, history)
 	}
@@ -137,6 +141,80 @@ func GetViewingHistory(db *sql.DB, userID int64) ([]ViewingHistoryResponse, erro
 	return historyList, nil
 }
 
+// GetViewingHistoryByCursor 프로필의 시청 기록 조회 (커서 페이징, 시청 시각 역순)
+func GetViewingHistoryByCursor(db *sql.DB, profileID int64, cursorValue string, size int) (*CursorPage, error) {
+	scope := cursorScope("history", profileID)
+	cursor, err := decodeTimeCursor(cursorValue, scope)
+	if err != nil {
+		return nil, err
+	}
+	size = normalizeCursorSize(size)
+
+	keysetWhere, keysetArgs, orderBy := timeKeyset("vh.watched_at", "vh.id", cursor)
+	where := "vh.profile_id = ?"
+	args := []interface{}{profileID}
+	if keysetWhere != "" {
+		where += " AND " + keysetWhere
+		args = append(args, keysetArgs...)
+	}
+	args = append(args, size+1)
+
+	rows, err := db.Query(`
+		SELECT 
+			vh.id, vh.content_id, vh.watch_duration, vh.last_position, vh.watched_at, vh.is_completed,
+			c.title, c.thumbnail_url, c.duration
+		FROM 
+			ViewingHistories vh
+		JOIN 
+			Contents c ON vh.content_id = c.id
+		WHERE 
+			`+where+`
+		ORDER BY 
+			`+orderBy+`
+		LIMIT ?
+	`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	historyList := []ViewingHistoryResponse{}
+	for rows.Next() {
+		var history ViewingHistoryResponse
+		if err := rows.Scan(
+			&history.ID, &history.ContentID, &history.WatchDuration, &history.LastPosition,
+			&history.WatchedAt, &history.IsCompleted, &history.Title, &history.ThumbnailURL, &history.Duration,
+		); err != nil {
+			return nil, err
+		}
+		history.ProgressPercent = calculateProgressPercent(history.WatchDuration, history.Duration)
+		historyList = append(historyList, history)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	historyList, page := newCursorPage(historyList, size, cursor, scope, func(history ViewingHistoryResponse) Cursor {
+		watchedAt := history.WatchedAt
+		return Cursor{At: &watchedAt, ID: history.ID}
+	})
+
+	page.Content = historyList
+	return page, nil
+}
+
+// calculateProgressPercent 시청 진행률 계산 (퍼센트)
+func calculateProgressPercent(watchDuration, duration int) int {
+	if duration <= 0 {
+		return 0
+	}
+	percent := int(float64(watchDuration) / float64(duration) * 100)
+	if percent > 100 {
+		percent = 100
+	}
+	return percent
+}
+
 // ViewingHistoryResponse 시청 기록 응답 모델
 type ViewingHistoryResponse struct {
 	ID              int64     `json:"id"`
@@ -9,7 +9,7 @@ import (
 // @Description 찜 목록 데이터 모델
 type Wishlist struct {
 	ID        int64     `db:"id" json:"id"`
-	UserID    int64     `db:"user_id" json:"user_id"`
+	ProfileID int64     `db:"profile_id" json:"profile_id"`
 	ContentID int64     `db:"content_id" json:"content_id"`
 	CreatedAt time.Time `db:"created_at" json:"created_at"`
 }
@@ -22,13 +22,13 @@ type WishlistToggleResponse struct {
 }
 
 // ToggleWishlist 찜하기/취소하기
-func ToggleWishlist(db *sql.DB, userID, contentID int64) (bool, error) {
+func ToggleWishlist(db *sql.DB, profileID, contentID int64) (bool, error) {
 	// 현재 찜 상태 확인
 	var count int
 	err := db.QueryRow(`
 		SELECT COUNT(*) FROM Wishlists 
-		WHERE user_id = ? AND content_id = ?
-	`, userID, contentID).Scan(&count)
+		WHERE profile_id = ? AND content_id = ?
+	`, profileID, contentID).Scan(&count)
 	if err != nil {
 		return false, err
 	}
@@ -37,21 +37,22 @@ func ToggleWishlist(db *sql.DB, userID, contentID int64) (bool, error) {
 	if count > 0 {
 		_, err := db.Exec(`
 			DELETE FROM Wishlists 
-			WHERE user_id = ? AND content_id = ?
-		`, userID, contentID)
+			WHERE profile_id = ? AND content_id = ?
+		`, profileID, contentID)
 		return false, err
 	}
 
 	// 찜하지 않은 경우 (추가)
 	_, err = db.Exec(`
-		INSERT INTO Wishlists (user_id, content_id, created_at) 
+		INSERT INTO Wishlists (profile_id, content_id, created_at) 
 		VALUES (?, ?, ?)
-	`, userID, contentID, time.Now())
+	`, profileID, contentID, time.Now())
 	return true, err
 }
 
-// GetWishlist 사용자의 찜 목록 조회
-func GetWishlist(db *sql.DB, userID int64) ([]ContentListResponse, error) {
+// GetWishlist 프로필의 찜 목록 조회
+func GetWishlist(db *sql.DB, viewer Viewer) ([]ContentListResponse, error) {
+	args := append([]interface{}{viewer.ProfileID}, viewer.catalogArgs()...)
 	rows, err := db.Query(`
 		SELECT 
 			c.id, c.title, c.thumbnail_url, c.release_year
@@ -60,57 +61,102 @@ func GetWishlist(db *sql.DB, userID int64) ([]ContentListResponse, error) {
 		JOIN 
 			Wishlists w ON c.id = w.content_id
 		WHERE 
-			w.user_id = ?
+			w.profile_id = ? AND `+catalogCondition("c")+`
 		ORDER BY 
 			w.created_at DESC
-	`, userID)
+	`, args...)
+	if err != nil {
+		return nil, err
+	}
+	wishlist, err := scanContentList(rows)
+	if err != nil {
+		return nil, err
+	}
+	for i := range wishlist {
+		wishlist[i].IsWishlisted = true // 찜 목록이므로 모두 true
+	}
+
+	// 장르, 평가 정보 일괄 조회 (찜 여부는 이미 true로 설정됨)
+	if err := attachContentListDetails(db, viewer, wishlist); err != nil {
+		return nil, err
+	}
+
+	return wishlist, nil
+}
+
+// GetWishlistByCursor 프로필의 찜 목록 조회 (커서 페이징, 찜한 시각 역순)
+func GetWishlistByCursor(db *sql.DB, viewer Viewer, cursorValue string, size int) (*CursorPage, error) {
+	scope := cursorScope("wishlist", viewer.ProfileID)
+	cursor, err := decodeTimeCursor(cursorValue, scope)
+	if err != nil {
+		return nil, err
+	}
+	size = normalizeCursorSize(size)
+
+	keysetWhere, keysetArgs, orderBy := timeKeyset("w.created_at", "w.id", cursor)
+	where := "w.profile_id = ? AND " + catalogCondition("c")
+	args := append([]interface{}{viewer.ProfileID}, viewer.catalogArgs()...)
+	if keysetWhere != "" {
+		where += " AND " + keysetWhere
+		args = append(args, keysetArgs...)
+	}
+	args = append(args, size+1)
+
+	rows, err := db.Query(`
+		SELECT 
+			c.id, c.title, c.thumbnail_url, c.release_year, w.id, w.created_at
+		FROM 
+			Contents c
+		JOIN 
+			Wishlists w ON c.id = w.content_id
+		WHERE 
+			`+where+`
+		ORDER BY 
+			`+orderBy+`
+		LIMIT ?
+	`, args...)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	wishlist := []ContentListResponse{}
-	contentIDs := []int64{}
+	// 커서 생성을 위해 찜 항목의 ID와 생성 시각을 함께 보관
+	type wishlistRow struct {
+		content   ContentListResponse
+		id        int64
+		createdAt time.Time
+	}
 
-	// 기본 콘텐츠 정보 읽기
+	items := []wishlistRow{}
 	for rows.Next() {
-		var content ContentListResponse
-		if err := rows.Scan(&content.ID, &content.Title, &content.ThumbnailURL, &content.ReleaseYear); err != nil {
+		var item wishlistRow
+		if err := rows.Scan(
+			&item.content.ID, &item.content.Title, &item.content.ThumbnailURL, &item.content.ReleaseYear,
+			&item.id, &item.createdAt,
+		); err != nil {
 			return nil, err
 		}
-		content.IsWishlisted = true // 찜 목록이므로 모두 true
-		wishlist = append(wishlist, content)
-		contentIDs = append(contentIDs, content.ID)
+		item.content.IsWishlisted = true // 찜 목록이므로 모두 true
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
 	}
 
-	// 각 콘텐츠의 장르 정보 조회
-	for i, contentID := range contentIDs {
-		genreRows, err := db.Query(`
-			SELECT 
-				g.name
-			FROM 
-				Genres g
-			JOIN 
-				ContentGenres cg ON g.id = cg.genre_id
-			WHERE 
-				cg.content_id = ?
-		`, contentID)
-		if err != nil {
-			return nil, err
-		}
+	items, page := newCursorPage(items, size, cursor, scope, func(item wishlistRow) Cursor {
+		return Cursor{At: &item.createdAt, ID: item.id}
+	})
 
-		var genres []string
-		for genreRows.Next() {
-			var genreName string
-			if err := genreRows.Scan(&genreName); err != nil {
-				genreRows.Close()
-				return nil, err
-			}
-			genres = append(genres, genreName)
-		}
-		genreRows.Close()
-		wishlist[i].Genres = genres
+	wishlist := make([]ContentListResponse, 0, len(items))
+	for _, item := range items {
+		wishlist = append(wishlist, item.content)
 	}
 
-	return wishlist, nil
+	// 장르, 평가 정보 추가 (찜 여부는 이미 true로 설정됨)
+	if err := attachContentListDetails(db, viewer, wishlist); err != nil {
+		return nil, err
+	}
+
+	page.Content = wishlist
+	return page, nil
 }
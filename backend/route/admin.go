@@ -0,0 +1,390 @@
+package route
+
+import (
+	"database/sql"
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+
+	"backend/config"
+	"backend/helper"
+	"backend/middleware"
+	"backend/model"
+	"backend/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// adminLockoutHistoryLimit 잠금 기록 조회 최대 건수
+const adminLockoutHistoryLimit = 50
+
+// SetupAdminRoutes 관리자 전용 라우트 설정
+func SetupAdminRoutes(router *gin.RouterGroup, cfg *config.Config) {
+	adminRoutes := router.Group("/admin")
+	adminRoutes.Use(middleware.AuthMiddleware(cfg), middleware.AdminMiddleware(cfg))
+	{
+		adminRoutes.GET("/users/:id/lockouts", handleGetAccountLockouts(cfg))
+		adminRoutes.POST("/users/:id/unlock", handleUnlockAccount(cfg))
+		adminRoutes.POST("/users/:id/subscription", handleGrantSubscription(cfg))
+		adminRoutes.GET("/contents/:id/availability", handleGetContentAvailability(cfg))
+		adminRoutes.PUT("/contents/:id/availability", handleSetContentAvailability(cfg))
+		adminRoutes.PUT("/contents/:id/schedule", handleSetContentSchedule(cfg))
+		adminRoutes.GET("/contents/:id/translations", handleGetContentTranslations(cfg))
+		adminRoutes.PUT("/contents/:id/translations/:locale", handleSetContentTranslation(cfg))
+		adminRoutes.DELETE("/contents/:id/translations/:locale", handleDeleteContentTranslation(cfg))
+		adminRoutes.GET("/genres/:id/translations", handleGetGenreTranslations(cfg))
+		adminRoutes.PUT("/genres/:id/translations/:locale", handleSetGenreTranslation(cfg))
+		adminRoutes.DELETE("/genres/:id/translations/:locale", handleDeleteGenreTranslation(cfg))
+		adminRoutes.GET("/collections", handleGetAllCollections(cfg))
+		adminRoutes.POST("/collections", handleCreateCollection(cfg))
+		adminRoutes.PUT("/collections/order", handleReorderCollections(cfg))
+		adminRoutes.GET("/collections/:id", handleGetCollectionAdmin(cfg))
+		adminRoutes.PUT("/collections/:id", handleUpdateCollection(cfg))
+		adminRoutes.DELETE("/collections/:id", handleDeleteCollection(cfg))
+		adminRoutes.PUT("/collections/:id/items", handleSetCollectionItems(cfg))
+		adminRoutes.GET("/reviews/moderation", handleGetReviewModerationQueue(cfg))
+		adminRoutes.POST("/reviews/:id/approve", handleModerateReview(cfg, model.ReviewActionApprove))
+		adminRoutes.POST("/reviews/:id/hide", handleModerateReview(cfg, model.ReviewActionHide))
+		adminRoutes.POST("/reviews/:id/ban", handleModerateReview(cfg, model.ReviewActionBan))
+	}
+}
+
+// @Summary 계정 잠금 기록 조회
+// @Description 로그인 실패로 인한 계정 잠금 기록을 최신순으로 조회 (관리자 전용)
+// @Tags 관리자
+// @Produce json
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Param id path int true "사용자 ID"
+// @Success 200 {object} model.ApiResponse{data=[]model.AccountLockout} "잠금 기록"
+// @Failure 400 {object} model.ErrorResponse "유효하지 않은 사용자 ID"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 403 {object} model.ErrorResponse "관리자 권한 필요"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /admin/users/{id}/lockouts [get]
+func handleGetAccountLockouts(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 사용자 ID 파싱
+		userID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 사용자 ID"})
+			return
+		}
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		lockouts, err := model.GetAccountLockouts(db.DB, userID, adminLockoutHistoryLimit)
+		if err != nil {
+			log.Printf("계정 잠금 기록 조회 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "계정 잠금 기록 조회 실패"})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    lockouts,
+		})
+	}
+}
+
+// @Summary 계정 잠금 해제
+// @Description 로그인 실패로 잠긴 계정의 잠금과 실패 기록 해제 (관리자 전용)
+// @Tags 관리자
+// @Produce json
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Param id path int true "사용자 ID"
+// @Success 200 {object} model.ApiResponse "잠금 해제 성공"
+// @Failure 400 {object} model.ErrorResponse "유효하지 않은 사용자 ID"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 403 {object} model.ErrorResponse "관리자 권한 필요"
+// @Failure 404 {object} model.ErrorResponse "사용자 없음"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /admin/users/{id}/unlock [post]
+func handleUnlockAccount(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 사용자 ID 파싱
+		userID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 사용자 ID"})
+			return
+		}
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		loginGuard := service.NewLoginGuardService(db.DB, helper.GetAttemptStore(cfg), cfg.LoginProtection)
+		if err := loginGuard.Unlock(userID, c.GetInt64("userID")); err != nil {
+			if errors.Is(err, service.ErrUserNotFound) {
+				c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+				return
+			}
+			log.Printf("계정 잠금 해제 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "계정 잠금 해제 실패"})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    gin.H{"message": "계정 잠금이 해제되었습니다"},
+		})
+	}
+}
+
+// @Summary 구독 부여
+// @Description 계정에 요금제 구독 부여 (이용 중인 구독이 있으면 종료일부터 이어서 시작, 관리자 전용)
+// @Tags 관리자
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Param id path int true "사용자 ID"
+// @Param request body model.GrantSubscriptionRequest true "요금제와 기간"
+// @Success 201 {object} model.ApiResponse{data=model.Subscription} "생성된 구독"
+// @Failure 400 {object} model.ErrorResponse "유효하지 않은 요청"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 403 {object} model.ErrorResponse "관리자 권한 필요"
+// @Failure 404 {object} model.ErrorResponse "사용자 또는 요금제 없음"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /admin/users/{id}/subscription [post]
+func handleGrantSubscription(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 사용자 ID 파싱
+		userID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 사용자 ID"})
+			return
+		}
+
+		var req model.GrantSubscriptionRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 구독 부여 요청"})
+			return
+		}
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		if _, err := model.GetUserByID(db.DB, userID); err != nil {
+			if err == sql.ErrNoRows {
+				c.JSON(http.StatusNotFound, gin.H{"error": service.ErrUserNotFound.Error()})
+				return
+			}
+			log.Printf("사용자 조회 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "사용자 조회 실패"})
+			return
+		}
+
+		subscriptionService := service.NewSubscriptionService(db.DB)
+		subscription, err := subscriptionService.Grant(userID, req.Plan, req.Days)
+		if err != nil {
+			if errors.Is(err, service.ErrPlanNotFound) {
+				c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+				return
+			}
+			log.Printf("구독 부여 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "구독 부여 실패"})
+			return
+		}
+
+		c.JSON(http.StatusCreated, gin.H{
+			"success": true,
+			"data":    subscription,
+		})
+	}
+}
+
+// @Summary 콘텐츠 이용 가능 기간 조회
+// @Description 콘텐츠의 국가별 이용 가능 기간 목록 조회 (목록이 비어 있으면 지역 제한 없음, 관리자 전용)
+// @Tags 관리자
+// @Produce json
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Param id path int true "콘텐츠 ID"
+// @Success 200 {object} model.ApiResponse{data=[]model.ContentAvailability} "이용 가능 기간 목록"
+// @Failure 400 {object} model.ErrorResponse "유효하지 않은 콘텐츠 ID"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 403 {object} model.ErrorResponse "관리자 권한 필요"
+// @Failure 404 {object} model.ErrorResponse "콘텐츠 없음"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /admin/contents/{id}/availability [get]
+func handleGetContentAvailability(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 콘텐츠 ID 파싱
+		contentID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 콘텐츠 ID"})
+			return
+		}
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		if !requireContentExists(c, db.DB, contentID) {
+			return
+		}
+
+		windows, err := model.GetContentAvailability(db.DB, contentID)
+		if err != nil {
+			log.Printf("이용 가능 기간 조회 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "이용 가능 기간 조회 실패"})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    windows,
+		})
+	}
+}
+
+// @Summary 콘텐츠 이용 가능 기간 설정
+// @Description 콘텐츠의 국가별 이용 가능 기간 전체 교체 (빈 목록이면 지역 제한 해제, 관리자 전용)
+// @Tags 관리자
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Param id path int true "콘텐츠 ID"
+// @Param request body model.SetContentAvailabilityRequest true "이용 가능 기간 목록"
+// @Success 200 {object} model.ApiResponse{data=[]model.ContentAvailability} "저장된 이용 가능 기간 목록"
+// @Failure 400 {object} model.ErrorResponse "유효하지 않은 요청"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 403 {object} model.ErrorResponse "관리자 권한 필요"
+// @Failure 404 {object} model.ErrorResponse "콘텐츠 없음"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /admin/contents/{id}/availability [put]
+func handleSetContentAvailability(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 콘텐츠 ID 파싱
+		contentID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 콘텐츠 ID"})
+			return
+		}
+
+		var req model.SetContentAvailabilityRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 이용 가능 기간 요청"})
+			return
+		}
+		if err := req.Validate(); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		if !requireContentExists(c, db.DB, contentID) {
+			return
+		}
+
+		if err := model.ReplaceContentAvailability(db.DB, contentID, req.Windows); err != nil {
+			log.Printf("이용 가능 기간 저장 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "이용 가능 기간 저장 실패"})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    req.Windows,
+		})
+	}
+}
+
+// @Summary 콘텐츠 공개 일정 설정
+// @Description 콘텐츠의 공개/공개 종료 일시 설정 (null이면 제한 없음, 공개 전에는 공개 예정 목록에만 노출, 관리자 전용)
+// @Tags 관리자
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Param id path int true "콘텐츠 ID"
+// @Param request body model.ContentSchedule true "공개 일정"
+// @Success 200 {object} model.ApiResponse{data=model.ContentSchedule} "저장된 공개 일정"
+// @Failure 400 {object} model.ErrorResponse "유효하지 않은 요청"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 403 {object} model.ErrorResponse "관리자 권한 필요"
+// @Failure 404 {object} model.ErrorResponse "콘텐츠 없음"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /admin/contents/{id}/schedule [put]
+func handleSetContentSchedule(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 콘텐츠 ID 파싱
+		contentID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 콘텐츠 ID"})
+			return
+		}
+
+		var req model.ContentSchedule
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 공개 일정 요청"})
+			return
+		}
+		if err := req.Validate(); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		if err := model.SetContentSchedule(db.DB, contentID, &req); err != nil {
+			if err == sql.ErrNoRows {
+				c.JSON(http.StatusNotFound, gin.H{"error": "콘텐츠를 찾을 수 없습니다"})
+				return
+			}
+			log.Printf("공개 일정 저장 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "공개 일정 저장 실패"})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    req,
+		})
+	}
+}
+
+// requireContentExists 콘텐츠가 없으면 404 응답 후 false 반환
+func requireContentExists(c *gin.Context, db *sql.DB, contentID int64) bool {
+	exists, err := model.ContentExists(db, contentID)
+	if err != nil {
+		log.Printf("콘텐츠 조회 실패: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "콘텐츠 조회 실패"})
+		return false
+	}
+	if !exists {
+		c.JSON(http.StatusNotFound, gin.H{"error": "콘텐츠를 찾을 수 없습니다"})
+		return false
+	}
+	return true
+}
@@ -0,0 +1,380 @@
+package route
+
+import (
+	"database/sql"
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+
+	"backend/config"
+	"backend/helper"
+	"backend/model"
+
+	"github.com/gin-gonic/gin"
+)
+
+// respondCollectionError 컬렉션 저장 오류를 상태 코드로 변환하여 응답
+func respondCollectionError(c *gin.Context, err error, action string) {
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		c.JSON(http.StatusNotFound, gin.H{"error": "컬렉션을 찾을 수 없습니다"})
+	case errors.Is(err, model.ErrCollectionSlugTaken):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case errors.Is(err, model.ErrInvalidCollection), errors.Is(err, model.ErrCollectionContentMissing):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		log.Printf("컬렉션 %s 실패: %v", action, err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "컬렉션 " + action + " 실패"})
+	}
+}
+
+// @Summary 전체 컬렉션 목록 조회
+// @Description 노출 기간과 관계없이 모든 컬렉션을 노출 순서대로 조회 (관리자 전용)
+// @Tags 관리자
+// @Produce json
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Success 200 {object} model.ApiResponse{data=[]model.Collection} "컬렉션 목록"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 403 {object} model.ErrorResponse "관리자 권한 필요"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /admin/collections [get]
+func handleGetAllCollections(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		collections, err := model.GetAllCollections(db.DB)
+		if err != nil {
+			log.Printf("컬렉션 목록 조회 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "컬렉션 목록 조회 실패"})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    collections,
+		})
+	}
+}
+
+// @Summary 컬렉션 생성
+// @Description 새 큐레이션 컬렉션 생성 (콘텐츠는 별도로 설정, 관리자 전용)
+// @Tags 관리자
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Param request body model.CollectionRequest true "컬렉션 정보"
+// @Success 201 {object} model.ApiResponse{data=model.Collection} "생성된 컬렉션"
+// @Failure 400 {object} model.ErrorResponse "유효하지 않은 요청"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 403 {object} model.ErrorResponse "관리자 권한 필요"
+// @Failure 409 {object} model.ErrorResponse "슬러그 중복"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /admin/collections [post]
+func handleCreateCollection(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req model.CollectionRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 컬렉션 요청"})
+			return
+		}
+		if err := req.Validate(); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		collectionID, err := model.CreateCollection(db.DB, &req)
+		if err != nil {
+			respondCollectionError(c, err, "생성")
+			return
+		}
+
+		collection, err := model.GetCollection(db.DB, collectionID)
+		if err != nil {
+			respondCollectionError(c, err, "조회")
+			return
+		}
+
+		c.JSON(http.StatusCreated, gin.H{
+			"success": true,
+			"data":    collection,
+		})
+	}
+}
+
+// @Summary 컬렉션 조회
+// @Description 컬렉션 정보와 순서대로 정렬된 전체 콘텐츠 ID 목록 조회 (관리자 전용)
+// @Tags 관리자
+// @Produce json
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Param id path int true "컬렉션 ID"
+// @Success 200 {object} model.ApiResponse{data=model.CollectionAdminResponse} "컬렉션"
+// @Failure 400 {object} model.ErrorResponse "유효하지 않은 컬렉션 ID"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 403 {object} model.ErrorResponse "관리자 권한 필요"
+// @Failure 404 {object} model.ErrorResponse "컬렉션 없음"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /admin/collections/{id} [get]
+func handleGetCollectionAdmin(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 컬렉션 ID 파싱
+		collectionID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 컬렉션 ID"})
+			return
+		}
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		collection, err := model.GetCollection(db.DB, collectionID)
+		if err != nil {
+			respondCollectionError(c, err, "조회")
+			return
+		}
+
+		contentIDs, err := model.GetCollectionContentIDs(db.DB, collectionID)
+		if err != nil {
+			respondCollectionError(c, err, "조회")
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data": model.CollectionAdminResponse{
+				Collection: *collection,
+				ContentIDs: contentIDs,
+			},
+		})
+	}
+}
+
+// @Summary 컬렉션 수정
+// @Description 컬렉션 정보 수정 (관리자 전용)
+// @Tags 관리자
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Param id path int true "컬렉션 ID"
+// @Param request body model.CollectionRequest true "컬렉션 정보"
+// @Success 200 {object} model.ApiResponse{data=model.Collection} "수정된 컬렉션"
+// @Failure 400 {object} model.ErrorResponse "유효하지 않은 요청"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 403 {object} model.ErrorResponse "관리자 권한 필요"
+// @Failure 404 {object} model.ErrorResponse "컬렉션 없음"
+// @Failure 409 {object} model.ErrorResponse "슬러그 중복"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /admin/collections/{id} [put]
+func handleUpdateCollection(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 컬렉션 ID 파싱
+		collectionID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 컬렉션 ID"})
+			return
+		}
+
+		var req model.CollectionRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 컬렉션 요청"})
+			return
+		}
+		if err := req.Validate(); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		if err := model.UpdateCollection(db.DB, collectionID, &req); err != nil {
+			respondCollectionError(c, err, "수정")
+			return
+		}
+
+		collection, err := model.GetCollection(db.DB, collectionID)
+		if err != nil {
+			respondCollectionError(c, err, "조회")
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    collection,
+		})
+	}
+}
+
+// @Summary 컬렉션 삭제
+// @Description 컬렉션과 담긴 콘텐츠 목록 삭제 (콘텐츠 자체는 유지, 관리자 전용)
+// @Tags 관리자
+// @Produce json
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Param id path int true "컬렉션 ID"
+// @Success 200 {object} model.ApiResponse "삭제 성공"
+// @Failure 400 {object} model.ErrorResponse "유효하지 않은 컬렉션 ID"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 403 {object} model.ErrorResponse "관리자 권한 필요"
+// @Failure 404 {object} model.ErrorResponse "컬렉션 없음"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /admin/collections/{id} [delete]
+func handleDeleteCollection(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 컬렉션 ID 파싱
+		collectionID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 컬렉션 ID"})
+			return
+		}
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		if err := model.DeleteCollection(db.DB, collectionID); err != nil {
+			respondCollectionError(c, err, "삭제")
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    gin.H{"message": "컬렉션이 삭제되었습니다"},
+		})
+	}
+}
+
+// @Summary 컬렉션 콘텐츠 설정
+// @Description 컬렉션에 담을 콘텐츠 목록 전체 교체 (목록 순서가 노출 순서, 중복 ID는 처음 위치만 사용, 관리자 전용)
+// @Tags 관리자
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Param id path int true "컬렉션 ID"
+// @Param request body model.CollectionItemsRequest true "콘텐츠 ID 목록"
+// @Success 200 {object} model.ApiResponse{data=model.CollectionAdminResponse} "수정된 컬렉션"
+// @Failure 400 {object} model.ErrorResponse "유효하지 않은 요청 또는 존재하지 않는 콘텐츠"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 403 {object} model.ErrorResponse "관리자 권한 필요"
+// @Failure 404 {object} model.ErrorResponse "컬렉션 없음"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /admin/collections/{id}/items [put]
+func handleSetCollectionItems(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 컬렉션 ID 파싱
+		collectionID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 컬렉션 ID"})
+			return
+		}
+
+		var req model.CollectionItemsRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 콘텐츠 목록"})
+			return
+		}
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		collection, err := model.GetCollection(db.DB, collectionID)
+		if err != nil {
+			respondCollectionError(c, err, "조회")
+			return
+		}
+
+		contentIDs, err := model.ReplaceCollectionItems(db.DB, collectionID, req.ContentIDs)
+		if err != nil {
+			respondCollectionError(c, err, "콘텐츠 설정")
+			return
+		}
+		collection.ItemCount = len(contentIDs)
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data": model.CollectionAdminResponse{
+				Collection: *collection,
+				ContentIDs: contentIDs,
+			},
+		})
+	}
+}
+
+// @Summary 컬렉션 노출 순서 변경
+// @Description 컬렉션 ID 목록 순서대로 노출 순서 설정 (목록에 없는 컬렉션은 기존 순서대로 뒤에 배치, 관리자 전용)
+// @Tags 관리자
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Param request body model.CollectionOrderRequest true "컬렉션 ID 목록"
+// @Success 200 {object} model.ApiResponse{data=[]model.Collection} "변경된 컬렉션 목록"
+// @Failure 400 {object} model.ErrorResponse "유효하지 않은 요청"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 403 {object} model.ErrorResponse "관리자 권한 필요"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /admin/collections/order [put]
+func handleReorderCollections(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req model.CollectionOrderRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 컬렉션 목록"})
+			return
+		}
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		if err := model.ReorderCollections(db.DB, req.CollectionIDs); err != nil {
+			respondCollectionError(c, err, "순서 변경")
+			return
+		}
+
+		collections, err := model.GetAllCollections(db.DB)
+		if err != nil {
+			respondCollectionError(c, err, "목록 조회")
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    collections,
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package route
+
+import (
+	"log"
+	"net/http"
+	"strconv"
+
+	"backend/config"
+	"backend/helper"
+	"backend/model"
+	"backend/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// reviewModerationMessages 리뷰 검토 처리별 안내 메시지
+var reviewModerationMessages = map[string]string{
+	model.ReviewActionApprove: "리뷰가 승인되었습니다",
+	model.ReviewActionHide:    "리뷰가 숨김 처리되었습니다",
+	model.ReviewActionBan:     "작성자의 리뷰 작성이 금지되고 작성한 리뷰가 모두 숨김 처리되었습니다",
+}
+
+// @Summary 리뷰 검토 대기열 조회
+// @Description 스팸 의심 또는 신고 누적으로 검토 대기 중이거나 처리되지 않은 신고가 있는 리뷰를 신고가 많은 순으로 조회 (관리자 전용)
+// @Tags 관리자
+// @Produce json
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Param page query int false "페이지 번호 (기본값: 0)"
+// @Param size query int false "페이지당 항목 수 (기본값: 20, 최대 50)"
+// @Success 200 {object} model.PagingResponse{data=model.PageInfo{content=[]model.ReviewModerationItem}} "검토 대기열"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 403 {object} model.ErrorResponse "관리자 권한 필요"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /admin/reviews/moderation [get]
+func handleGetReviewModerationQueue(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 페이징 파라미터 처리
+		page, err := strconv.Atoi(c.DefaultQuery("page", "0"))
+		if err != nil || page < 0 {
+			page = 0
+		}
+		size, err := strconv.Atoi(c.DefaultQuery("size", "20"))
+		if err != nil || size <= 0 {
+			size = 20
+		}
+		if size > reviewPageSizeLimit {
+			size = reviewPageSizeLimit
+		}
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		reviewService := service.NewReviewService(db.DB, cfg)
+		pageInfo, err := reviewService.GetModerationQueue(page, size)
+		if err != nil {
+			log.Printf("리뷰 검토 대기열 조회 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "리뷰 검토 대기열 조회 실패"})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    pageInfo,
+		})
+	}
+}
+
+// @Summary 리뷰 검토 처리
+// @Description 리뷰 승인(approve: 공개), 숨김(hide), 작성 금지(ban: 작성자의 리뷰 작성을 막고 모든 리뷰 숨김) 처리 후 관련 신고를 처리 완료로 기록 (관리자 전용)
+// @Tags 관리자
+// @Produce json
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Param id path int true "리뷰 ID"
+// @Param action path string true "처리 (approve, hide, ban)"
+// @Success 200 {object} model.ApiResponse "처리 성공"
+// @Failure 400 {object} model.ErrorResponse "유효하지 않은 요청"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 403 {object} model.ErrorResponse "관리자 권한 필요"
+// @Failure 404 {object} model.ErrorResponse "리뷰 없음"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /admin/reviews/{id}/{action} [post]
+func handleModerateReview(cfg *config.Config, action string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 리뷰 ID 파싱
+		reviewID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 리뷰 ID"})
+			return
+		}
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		reviewService := service.NewReviewService(db.DB, cfg)
+		if err := reviewService.Moderate(reviewID, action); err != nil {
+			respondReviewError(c, err, "검토 처리")
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    gin.H{"message": reviewModerationMessages[action]},
+		})
+	}
+}
@@ -0,0 +1,355 @@
+package route
+
+import (
+	"database/sql"
+	"log"
+	"net/http"
+	"strconv"
+
+	"backend/config"
+	"backend/helper"
+	"backend/model"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary 콘텐츠 번역 목록 조회
+// @Description 콘텐츠의 언어별 제목/설명 번역 목록 조회 (관리자 전용)
+// @Tags 관리자
+// @Produce json
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Param id path int true "콘텐츠 ID"
+// @Success 200 {object} model.ApiResponse{data=[]model.ContentTranslation} "번역 목록"
+// @Failure 400 {object} model.ErrorResponse "유효하지 않은 콘텐츠 ID"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 403 {object} model.ErrorResponse "관리자 권한 필요"
+// @Failure 404 {object} model.ErrorResponse "콘텐츠 없음"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /admin/contents/{id}/translations [get]
+func handleGetContentTranslations(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 콘텐츠 ID 파싱
+		contentID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 콘텐츠 ID"})
+			return
+		}
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		if !requireContentExists(c, db.DB, contentID) {
+			return
+		}
+
+		translations, err := model.GetContentTranslations(db.DB, contentID)
+		if err != nil {
+			log.Printf("콘텐츠 번역 조회 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "콘텐츠 번역 조회 실패"})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    translations,
+		})
+	}
+}
+
+// @Summary 콘텐츠 번역 저장
+// @Description 콘텐츠의 특정 언어 제목/설명 번역 저장 (이미 있으면 교체, 관리자 전용)
+// @Tags 관리자
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Param id path int true "콘텐츠 ID"
+// @Param locale path string true "언어 태그 (예: en, en-US, ja)"
+// @Param request body model.ContentTranslation true "번역 (locale은 경로 값 사용)"
+// @Success 200 {object} model.ApiResponse{data=model.ContentTranslation} "저장된 번역"
+// @Failure 400 {object} model.ErrorResponse "유효하지 않은 요청"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 403 {object} model.ErrorResponse "관리자 권한 필요"
+// @Failure 404 {object} model.ErrorResponse "콘텐츠 없음"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /admin/contents/{id}/translations/{locale} [put]
+func handleSetContentTranslation(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 콘텐츠 ID 파싱
+		contentID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 콘텐츠 ID"})
+			return
+		}
+
+		locale, ok := translationLocaleParam(c)
+		if !ok {
+			return
+		}
+
+		var req model.ContentTranslation
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 번역 요청"})
+			return
+		}
+		req.Locale = locale
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		if !requireContentExists(c, db.DB, contentID) {
+			return
+		}
+
+		if err := model.SetContentTranslation(db.DB, contentID, &req); err != nil {
+			log.Printf("콘텐츠 번역 저장 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "콘텐츠 번역 저장 실패"})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    req,
+		})
+	}
+}
+
+// @Summary 콘텐츠 번역 삭제
+// @Description 콘텐츠의 특정 언어 번역 삭제 (이후 다음 순서의 언어 또는 원문 사용, 관리자 전용)
+// @Tags 관리자
+// @Produce json
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Param id path int true "콘텐츠 ID"
+// @Param locale path string true "언어 태그"
+// @Success 200 {object} model.ApiResponse "삭제 성공"
+// @Failure 400 {object} model.ErrorResponse "유효하지 않은 요청"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 403 {object} model.ErrorResponse "관리자 권한 필요"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /admin/contents/{id}/translations/{locale} [delete]
+func handleDeleteContentTranslation(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 콘텐츠 ID 파싱
+		contentID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 콘텐츠 ID"})
+			return
+		}
+
+		locale, ok := translationLocaleParam(c)
+		if !ok {
+			return
+		}
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		if err := model.DeleteContentTranslation(db.DB, contentID, locale); err != nil {
+			log.Printf("콘텐츠 번역 삭제 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "콘텐츠 번역 삭제 실패"})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    gin.H{"message": "번역이 삭제되었습니다"},
+		})
+	}
+}
+
+// @Summary 장르 번역 목록 조회
+// @Description 장르의 언어별 이름/설명 번역 목록 조회 (관리자 전용)
+// @Tags 관리자
+// @Produce json
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Param id path int true "장르 ID"
+// @Success 200 {object} model.ApiResponse{data=[]model.GenreTranslation} "번역 목록"
+// @Failure 400 {object} model.ErrorResponse "유효하지 않은 장르 ID"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 403 {object} model.ErrorResponse "관리자 권한 필요"
+// @Failure 404 {object} model.ErrorResponse "장르 없음"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /admin/genres/{id}/translations [get]
+func handleGetGenreTranslations(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 장르 ID 파싱
+		genreID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 장르 ID"})
+			return
+		}
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		if !requireGenreExists(c, db.DB, genreID) {
+			return
+		}
+
+		translations, err := model.GetGenreTranslations(db.DB, genreID)
+		if err != nil {
+			log.Printf("장르 번역 조회 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "장르 번역 조회 실패"})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    translations,
+		})
+	}
+}
+
+// @Summary 장르 번역 저장
+// @Description 장르의 특정 언어 이름/설명 번역 저장 (이미 있으면 교체, 관리자 전용)
+// @Tags 관리자
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Param id path int true "장르 ID"
+// @Param locale path string true "언어 태그 (예: en, en-US, ja)"
+// @Param request body model.GenreTranslation true "번역 (locale은 경로 값 사용)"
+// @Success 200 {object} model.ApiResponse{data=model.GenreTranslation} "저장된 번역"
+// @Failure 400 {object} model.ErrorResponse "유효하지 않은 요청"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 403 {object} model.ErrorResponse "관리자 권한 필요"
+// @Failure 404 {object} model.ErrorResponse "장르 없음"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /admin/genres/{id}/translations/{locale} [put]
+func handleSetGenreTranslation(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 장르 ID 파싱
+		genreID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 장르 ID"})
+			return
+		}
+
+		locale, ok := translationLocaleParam(c)
+		if !ok {
+			return
+		}
+
+		var req model.GenreTranslation
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 번역 요청"})
+			return
+		}
+		req.Locale = locale
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		if !requireGenreExists(c, db.DB, genreID) {
+			return
+		}
+
+		if err := model.SetGenreTranslation(db.DB, genreID, &req); err != nil {
+			log.Printf("장르 번역 저장 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "장르 번역 저장 실패"})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    req,
+		})
+	}
+}
+
+// @Summary 장르 번역 삭제
+// @Description 장르의 특정 언어 번역 삭제 (이후 다음 순서의 언어 또는 원문 사용, 관리자 전용)
+// @Tags 관리자
+// @Produce json
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Param id path int true "장르 ID"
+// @Param locale path string true "언어 태그"
+// @Success 200 {object} model.ApiResponse "삭제 성공"
+// @Failure 400 {object} model.ErrorResponse "유효하지 않은 요청"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 403 {object} model.ErrorResponse "관리자 권한 필요"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /admin/genres/{id}/translations/{locale} [delete]
+func handleDeleteGenreTranslation(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 장르 ID 파싱
+		genreID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 장르 ID"})
+			return
+		}
+
+		locale, ok := translationLocaleParam(c)
+		if !ok {
+			return
+		}
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		if err := model.DeleteGenreTranslation(db.DB, genreID, locale); err != nil {
+			log.Printf("장르 번역 삭제 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "장르 번역 삭제 실패"})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    gin.H{"message": "번역이 삭제되었습니다"},
+		})
+	}
+}
+
+// translationLocaleParam 경로의 언어 태그를 정규화하여 반환 (형식이 잘못되면 400 응답 후 false 반환)
+func translationLocaleParam(c *gin.Context) (string, bool) {
+	locale := helper.NormalizeLocale(c.Param("locale"))
+	if locale == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 언어 태그"})
+		return "", false
+	}
+	return locale, true
+}
+
+// requireGenreExists 장르가 없으면 404 응답 후 false 반환
+func requireGenreExists(c *gin.Context, db *sql.DB, genreID int64) bool {
+	exists, err := model.GenreExists(db, genreID)
+	if err != nil {
+		log.Printf("장르 조회 실패: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "장르 조회 실패"})
+		return false
+	}
+	if !exists {
+		c.JSON(http.StatusNotFound, gin.H{"error": "장르를 찾을 수 없습니다"})
+		return false
+	}
+	return true
+}
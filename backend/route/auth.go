@@ -1,14 +1,19 @@
 package route
 
 import (
+	"database/sql"
+	"errors"
 	"log"
+	"math"
 	"net/http"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
 	"backend/config"
 	"backend/helper"
+	"backend/middleware"
 	"backend/model"
 	"backend/service"
 
@@ -21,17 +26,27 @@ func SetupAuthRoutes(router *gin.RouterGroup, cfg *config.Config) {
 	{
 		authRoutes.POST("/register", handleRegister(cfg))
 		authRoutes.POST("/login", handleLogin(cfg))
+		authRoutes.POST("/login/two-factor", handleTwoFactorLogin(cfg))
+		authRoutes.GET("/oidc/providers", handleOIDCProviders(cfg))
+		authRoutes.GET("/oidc/:provider/authorize", handleOIDCAuthorize(cfg))
+		authRoutes.POST("/oidc/:provider/callback", handleOIDCCallback(cfg))
+		authRoutes.POST("/refresh", handleRefreshToken(cfg))
+		authRoutes.POST("/logout", middleware.AuthMiddleware(cfg), handleLogout(cfg))
+		authRoutes.POST("/forgot-password", handleForgotPassword(cfg))
+		authRoutes.POST("/reset-password", handleResetPassword(cfg))
+		authRoutes.POST("/verify-email", handleVerifyEmail(cfg))
+		authRoutes.POST("/resend-verification", middleware.AuthMiddleware(cfg), handleResendVerification(cfg))
 	}
 }
 
 // @Summary 회원가입
-// @Description 새 사용자 등록
+// @Description 새 사용자 등록 후 이메일 인증 메일 발송 (Accept-Language에 따라 한국어/영어)
 // @Tags 인증
 // @Accept json
 // @Produce json
 // @Param user body model.RegisterRequest true "사용자 등록 정보"
 // @Success 201 {object} model.ApiResponse{data=model.UserResponse} "등록된 사용자 정보"
-// @Failure 400 {object} model.ErrorResponse "유효하지 않은 요청"
+// @Failure 400 {object} model.ValidationErrorResponse "유효하지 않은 요청 또는 비밀번호 정책 위반"
 // @Failure 409 {object} model.ErrorResponse "이메일 중복"
 // @Failure 500 {object} model.ErrorResponse "서버 오류"
 // @Router /auth/register [post]
@@ -91,6 +106,16 @@ func handleRegister(cfg *config.Config) gin.HandlerFunc {
 			return
 		}
 
+		// 비밀번호 정책 검사
+		policy := service.NewPasswordPolicy(cfg)
+		if err := policy.Validate("password", registerRequest.Password, registerRequest.Email, registerRequest.Name); err != nil {
+			if !writePasswordPolicyError(c, err) {
+				log.Printf("비밀번호 정책 검사 실패: %v", err)
+				c.JSON(http.StatusInternalServerError, gin.H{"error": "사용자 등록 실패"})
+			}
+			return
+		}
+
 		// UserService 인스턴스 생성
 		userService := service.NewUserService(db)
 
@@ -108,6 +133,12 @@ func handleRegister(cfg *config.Config) gin.HandlerFunc {
 			return
 		}
 
+		// 이메일 인증 메일 발송 (실패해도 가입은 완료, 재발송 가능)
+		accountService := service.NewAccountService(db.DB, helper.NewMailer(cfg.Mail), cfg)
+		if err := accountService.SendVerificationEmail(user, c.GetHeader("Accept-Language")); err != nil {
+			log.Printf("이메일 인증 메일 발송 실패: %v", err)
+		}
+
 		// 응답 반환
 		c.JSON(http.StatusCreated, gin.H{
 			"success": true,
@@ -117,14 +148,16 @@ func handleRegister(cfg *config.Config) gin.HandlerFunc {
 }
 
 // @Summary 로그인
-// @Description 사용자 로그인 및 JWT 토큰 발급
+// @Description 사용자 로그인 및 단기 액세스 토큰/리프레시 토큰 발급 (2단계 인증 계정은 대기 토큰 발급 후 /auth/login/two-factor에서 완료)
 // @Tags 인증
 // @Accept json
 // @Produce json
 // @Param credentials body model.LoginRequest true "로그인 정보"
-// @Success 200 {object} model.ApiResponse{data=model.LoginResponse} "토큰 및 사용자 정보"
+// @Success 200 {object} model.ApiResponse{data=model.LoginResponse} "토큰 및 사용자 정보 (2단계 인증 계정은 model.TwoFactorChallengeResponse)"
 // @Failure 400 {object} model.ErrorResponse "유효하지 않은 요청"
-// @Failure 401 {object} model.ErrorResponse "인증 실패"
+// @Failure 401 {object} model.ErrorResponse "인증 실패 (연속 실패 시 Retry-After 헤더로 다음 시도까지 대기 시간 안내)"
+// @Failure 423 {object} model.ErrorResponse "연속 실패로 계정 잠금 (Retry-After 헤더 포함)"
+// @Failure 429 {object} model.ErrorResponse "대기 시간 전 재시도 (Retry-After 헤더 포함)"
 // @Failure 500 {object} model.ErrorResponse "서버 오류"
 // @Router /auth/login [post]
 func handleLogin(cfg *config.Config) gin.HandlerFunc {
@@ -173,8 +206,9 @@ func handleLogin(cfg *config.Config) gin.HandlerFunc {
 				c.JSON(http.StatusOK, gin.H{
 					"success": true,
 					"data": gin.H{
-						"token": token,
-						"user":  user.ToUserResponse(),
+						"token":         token,
+						"refresh_token": "test-refresh-token",
+						"user":          user.ToUserResponse(),
 					},
 				})
 				return
@@ -192,31 +226,514 @@ func handleLogin(cfg *config.Config) gin.HandlerFunc {
 			return
 		}
 
+		// 계정/IP별 잠금 및 대기 상태 확인
+		loginGuard := service.NewLoginGuardService(db.DB, helper.GetAttemptStore(cfg), cfg.LoginProtection)
+		var blocked *service.LoginBlockedError
+		if err := loginGuard.Check(loginRequest.Email, clientIP(c)); errors.As(err, &blocked) {
+			writeLoginBlocked(c, blocked)
+			return
+		}
+
 		// UserService 인스턴스 생성
 		userService := service.NewUserService(db)
 
-		// 로그인 검증
+		// 로그인 검증 (실패 시 대기 시간 또는 계정 잠금 적용)
 		user, err := userService.ValidateLogin(&loginRequest)
 		if err != nil {
+			if blocked := loginGuard.RecordFailure(loginRequest.Email, clientIP(c)); blocked != nil {
+				if blocked.Locked {
+					writeLoginBlocked(c, blocked)
+					return
+				}
+				c.Header("Retry-After", retryAfterSeconds(blocked.RetryAfter))
+			}
+			c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+
+		// 실패 기록은 2단계 인증까지 마쳐 로그인이 완료될 때 초기화
+		beginLogin(c, cfg, db.DB, user, loginRequest.DeviceName)
+	}
+}
+
+// @Summary 2단계 인증 로그인
+// @Description 로그인 1단계에서 받은 대기 토큰과 인증 앱 코드(또는 복구 코드)로 로그인 완료
+// @Tags 인증
+// @Accept json
+// @Produce json
+// @Param request body model.TwoFactorLoginRequest true "대기 토큰과 인증 코드"
+// @Success 200 {object} model.ApiResponse{data=model.LoginResponse} "토큰 및 사용자 정보"
+// @Failure 400 {object} model.ErrorResponse "유효하지 않은 요청"
+// @Failure 401 {object} model.ErrorResponse "만료되었거나 이미 사용한 대기 토큰 또는 잘못된 인증 코드"
+// @Failure 429 {object} model.ErrorResponse "인증 코드 반복 실패로 일시 제한"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /auth/login/two-factor [post]
+func handleTwoFactorLogin(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 요청 파싱
+		var req model.TwoFactorLoginRequest
+		if err := c.ShouldBindJSON(&req); err != nil || (req.Code == "" && req.RecoveryCode == "") {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "대기 토큰과 인증 코드가 필요합니다"})
+			return
+		}
+
+		// 대기 토큰 검증
+		userID, challengeID, err := helper.ValidateTwoFactorChallenge(req.ChallengeToken, cfg)
+		if err != nil {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "로그인 시간이 만료되었습니다. 다시 로그인해 주세요"})
+			return
+		}
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		// 계정 잠금과 대기 토큰 사용 여부 확인 (대기 토큰 하나로 시도할 수 있는 횟수 제한)
+		loginGuard := service.NewLoginGuardService(db.DB, helper.GetAttemptStore(cfg), cfg.LoginProtection)
+		var exceeded *service.AttemptsExceededError
+		if err := loginGuard.BeginTwoFactorAttempt(userID, challengeID); err != nil {
+			if errors.As(err, &exceeded) {
+				writeAttemptsExceeded(c, exceeded)
+				return
+			}
 			c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
 			return
 		}
 
-		// JWT 토큰 생성
-		token, err := helper.GenerateToken(user.ID, user.Name, user.Email, cfg)
+		// 인증 코드 확인 (실패가 반복되면 계정의 코드 확인 잠금)
+		twoFactorService := service.NewTwoFactorService(db.DB)
+		if err := twoFactorService.VerifyCode(userID, req.Code, req.RecoveryCode); err != nil {
+			if errors.Is(err, service.ErrInvalidTwoFactorCode) || errors.Is(err, service.ErrTwoFactorNotEnabled) {
+				if exceeded := loginGuard.RecordTwoFactorFailure(userID, challengeID); exceeded != nil {
+					writeAttemptsExceeded(c, exceeded)
+					return
+				}
+				c.JSON(http.StatusUnauthorized, gin.H{"error": service.ErrInvalidTwoFactorCode.Error()})
+				return
+			}
+			log.Printf("2단계 인증 코드 확인 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "인증 코드 확인 실패"})
+			return
+		}
+		loginGuard.RecordTwoFactorSuccess(userID, challengeID)
+
+		// 대기 중 계정이 비활성화되었는지 확인
+		user, err := model.GetUserByID(db.DB, userID)
+		if err != nil {
+			log.Printf("사용자 정보 조회 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "사용자 정보 조회 실패"})
+			return
+		}
+		if !user.IsActive {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "비활성화된 계정입니다"})
+			return
+		}
+
+		completeLogin(c, cfg, db.DB, user, req.DeviceName)
+	}
+}
+
+// writeLoginBlocked 로그인 잠금/대기 응답 (Retry-After 헤더 포함)
+func writeLoginBlocked(c *gin.Context, blocked *service.LoginBlockedError) {
+	status := http.StatusTooManyRequests
+	if blocked.Locked {
+		status = http.StatusLocked
+	}
+	c.Header("Retry-After", retryAfterSeconds(blocked.RetryAfter))
+	c.JSON(status, gin.H{"error": blocked.Error()})
+}
+
+// writeAttemptsExceeded 추가 확인 절차 반복 실패 제한 응답 (Retry-After 헤더 포함)
+func writeAttemptsExceeded(c *gin.Context, exceeded *service.AttemptsExceededError) {
+	c.Header("Retry-After", retryAfterSeconds(exceeded.RetryAfter))
+	c.JSON(http.StatusTooManyRequests, gin.H{"error": exceeded.Error()})
+}
+
+// writePasswordPolicyError 비밀번호 정책 위반이면 필드별 오류와 함께 400 응답 후 true 반환
+func writePasswordPolicyError(c *gin.Context, err error) bool {
+	var policyErr *service.PasswordPolicyError
+	if !errors.As(err, &policyErr) {
+		return false
+	}
+	c.JSON(http.StatusBadRequest, gin.H{
+		"error":  policyErr.Error(),
+		"fields": policyErr.Fields,
+	})
+	return true
+}
+
+// retryAfterSeconds Retry-After 헤더 값 (초 단위 올림)
+func retryAfterSeconds(d time.Duration) string {
+	return strconv.FormatInt(int64(math.Ceil(d.Seconds())), 10)
+}
+
+// beginLogin 2단계 인증이 활성화된 계정은 대기 토큰을, 아니면 새 세션의 토큰을 발급
+func beginLogin(c *gin.Context, cfg *config.Config, db *sql.DB, user *model.User, deviceName string) {
+	// 2단계 인증이 활성화된 계정은 코드 확인 전까지 대기 토큰만 발급
+	twoFactorService := service.NewTwoFactorService(db)
+	twoFactorEnabled, err := twoFactorService.IsEnabled(user.ID)
+	if err != nil {
+		log.Printf("2단계 인증 상태 조회 실패: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "로그인 처리 실패"})
+		return
+	}
+	if twoFactorEnabled {
+		challengeToken, err := helper.GenerateTwoFactorChallenge(user.ID, cfg)
 		if err != nil {
-			log.Printf("토큰 생성 실패: %v", err)
+			log.Printf("2단계 인증 대기 토큰 생성 실패: %v", err)
 			c.JSON(http.StatusInternalServerError, gin.H{"error": "토큰 생성 실패"})
 			return
 		}
 
-		// 응답 반환
 		c.JSON(http.StatusOK, gin.H{
 			"success": true,
-			"data": gin.H{
-				"token": token,
-				"user":  user.ToUserResponse(),
+			"data": model.TwoFactorChallengeResponse{
+				TwoFactorRequired: true,
+				ChallengeToken:    challengeToken,
+				ExpiresIn:         int64(helper.TwoFactorChallengeTTL.Seconds()),
 			},
 		})
+		return
+	}
+
+	completeLogin(c, cfg, db, user, deviceName)
+}
+
+// completeLogin 기본 프로필 기준으로 새 세션의 토큰을 발급하고 로그인 응답 반환
+// 2단계 인증까지 마친 시점이므로 계정의 로그인 실패 기록도 여기서 초기화
+func completeLogin(c *gin.Context, cfg *config.Config, db *sql.DB, user *model.User, deviceName string) {
+	service.NewLoginGuardService(db, helper.GetAttemptStore(cfg), cfg.LoginProtection).RecordSuccess(user.Email)
+
+	// 기본 프로필 조회 (없으면 생성)
+	profileService := newProfileService(db, cfg)
+	profile, err := profileService.EnsureDefaultProfile(user)
+	if err != nil {
+		log.Printf("기본 프로필 조회 실패: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "프로필 조회 실패"})
+		return
+	}
+
+	// 유예 기간 중인 탈퇴 요청은 로그인하면 취소
+	deletionService := service.NewAccountDeletionService(db, cfg)
+	deletionCancelled, err := deletionService.CancelDeletion(user.ID)
+	if err != nil {
+		log.Printf("회원 탈퇴 취소 실패: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "로그인 처리 실패"})
+		return
+	}
+
+	// 새 세션의 액세스/리프레시 토큰 발급 (기본 프로필 기준)
+	authService := service.NewAuthService(db, cfg)
+	tokens, err := authService.IssueTokens(user, profile.ID, model.SessionClient{
+		DeviceName: deviceName,
+		UserAgent:  c.Request.UserAgent(),
+		IPAddress:  clientIP(c),
+	})
+	if err != nil {
+		log.Printf("토큰 생성 실패: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "토큰 생성 실패"})
+		return
+	}
+
+	// 응답 반환
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data": model.LoginResponse{
+			Token:        tokens.Token,
+			RefreshToken: tokens.RefreshToken,
+			ExpiresIn:    tokens.ExpiresIn,
+			User:         user.ToUserResponse(),
+			Profile:      *profile,
+
+			DeletionCancelled: deletionCancelled,
+		},
+	})
+}
+
+// @Summary 토큰 갱신
+// @Description 리프레시 토큰으로 새 액세스 토큰 발급 (리프레시 토큰은 매번 교체되며, 이미 사용된 토큰을 다시 보내면 해당 세션이 종료됨)
+// @Tags 인증
+// @Accept json
+// @Produce json
+// @Param request body model.RefreshTokenRequest true "리프레시 토큰"
+// @Success 200 {object} model.ApiResponse{data=model.TokenResponse} "새 액세스/리프레시 토큰"
+// @Failure 400 {object} model.ErrorResponse "유효하지 않은 요청"
+// @Failure 401 {object} model.ErrorResponse "유효하지 않거나 재사용된 리프레시 토큰"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /auth/refresh [post]
+func handleRefreshToken(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 토큰 갱신 요청 파싱
+		var req model.RefreshTokenRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 토큰 갱신 요청"})
+			return
+		}
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		// 리프레시 토큰 교체 및 액세스 토큰 발급
+		authService := service.NewAuthService(db.DB, cfg)
+		tokens, err := authService.RefreshTokens(req.RefreshToken, model.SessionClient{
+			UserAgent: c.Request.UserAgent(),
+			IPAddress: clientIP(c),
+		})
+		if err != nil {
+			if errors.Is(err, service.ErrInvalidRefreshToken) || errors.Is(err, service.ErrRefreshTokenReused) {
+				c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+				return
+			}
+			log.Printf("토큰 갱신 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "토큰 갱신 실패"})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    tokens,
+		})
+	}
+}
+
+// @Summary 로그아웃
+// @Description 현재 액세스 토큰과 해당 세션의 리프레시 토큰 폐기 (인증 필요)
+// @Tags 인증
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Success 200 {object} model.ApiResponse "로그아웃 성공"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /auth/logout [post]
+func handleLogout(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		// 현재 세션 폐기
+		authService := service.NewAuthService(db.DB, cfg)
+		if err := authService.Logout(c.GetString("tokenID"), c.GetTime("tokenExpiresAt")); err != nil {
+			log.Printf("로그아웃 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "로그아웃 실패"})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    gin.H{"message": "로그아웃되었습니다"},
+		})
+	}
+}
+
+// @Summary 비밀번호 재설정 메일 요청
+// @Description 가입한 이메일로 비밀번호 재설정 링크 발송 (가입 여부와 관계없이 같은 응답 반환)
+// @Tags 인증
+// @Accept json
+// @Produce json
+// @Param Accept-Language header string false "메일 언어 (ko, en)"
+// @Param request body model.ForgotPasswordRequest true "이메일"
+// @Success 200 {object} model.ApiResponse "요청 접수"
+// @Failure 400 {object} model.ErrorResponse "유효하지 않은 요청"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /auth/forgot-password [post]
+func handleForgotPassword(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 요청 파싱
+		var req model.ForgotPasswordRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 이메일 형식"})
+			return
+		}
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		// 비밀번호 재설정 메일은 응답 후 백그라운드에서 발송
+		// 가입 여부와 관계없이 같은 시점에 응답하여 응답 시간으로 가입 여부가 드러나지 않도록 함
+		accountService := service.NewAccountService(db.DB, helper.NewMailer(cfg.Mail), cfg)
+		email, language := req.Email, c.GetHeader("Accept-Language")
+		go func() {
+			if err := accountService.RequestPasswordReset(email, language); err != nil {
+				log.Printf("비밀번호 재설정 메일 발송 실패: %v", err)
+			}
+		}()
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    gin.H{"message": "가입된 이메일이라면 비밀번호 재설정 링크가 발송됩니다"},
+		})
+	}
+}
+
+// @Summary 비밀번호 재설정
+// @Description 메일로 받은 토큰으로 새 비밀번호 설정 (토큰은 한 번만 사용 가능, 기존 로그인 세션은 모두 종료됨)
+// @Tags 인증
+// @Accept json
+// @Produce json
+// @Param request body model.ResetPasswordRequest true "재설정 토큰과 새 비밀번호"
+// @Success 200 {object} model.ApiResponse "재설정 성공"
+// @Failure 400 {object} model.ValidationErrorResponse "유효하지 않거나 만료된 토큰 또는 비밀번호 정책 위반"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /auth/reset-password [post]
+func handleResetPassword(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 요청 파싱
+		var req model.ResetPasswordRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 비밀번호 재설정 요청"})
+			return
+		}
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		// 비밀번호 재설정
+		accountService := service.NewAccountService(db.DB, helper.NewMailer(cfg.Mail), cfg)
+		if err := accountService.ResetPassword(req.Token, req.NewPassword); err != nil {
+			if writePasswordPolicyError(c, err) {
+				return
+			}
+			if errors.Is(err, service.ErrInvalidAccountToken) {
+				c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+				return
+			}
+			log.Printf("비밀번호 재설정 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "비밀번호 재설정 실패"})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    gin.H{"message": "비밀번호가 변경되었습니다. 다시 로그인해 주세요"},
+		})
+	}
+}
+
+// @Summary 이메일 인증
+// @Description 메일로 받은 토큰으로 이메일 인증 완료
+// @Tags 인증
+// @Accept json
+// @Produce json
+// @Param request body model.VerifyEmailRequest true "이메일 인증 토큰"
+// @Success 200 {object} model.ApiResponse "인증 성공"
+// @Failure 400 {object} model.ErrorResponse "유효하지 않거나 만료된 토큰"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /auth/verify-email [post]
+func handleVerifyEmail(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 요청 파싱
+		var req model.VerifyEmailRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 이메일 인증 요청"})
+			return
+		}
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		// 이메일 인증
+		accountService := service.NewAccountService(db.DB, helper.NewMailer(cfg.Mail), cfg)
+		if err := accountService.VerifyEmail(req.Token); err != nil {
+			if errors.Is(err, service.ErrInvalidAccountToken) {
+				c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+				return
+			}
+			log.Printf("이메일 인증 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "이메일 인증 실패"})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    gin.H{"message": "이메일 인증이 완료되었습니다"},
+		})
+	}
+}
+
+// @Summary 이메일 인증 메일 재발송
+// @Description 로그인한 계정의 이메일 인증 메일 재발송 (이전 인증 링크는 무효화, 인증 필요)
+// @Tags 인증
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Param Accept-Language header string false "메일 언어 (ko, en)"
+// @Success 200 {object} model.ApiResponse "발송 성공"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 409 {object} model.ErrorResponse "이미 인증된 이메일"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /auth/resend-verification [post]
+func handleResendVerification(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		// 사용자 조회
+		user, err := model.GetUserByID(db.DB, c.GetInt64("userID"))
+		if err != nil {
+			log.Printf("사용자 정보 조회 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "사용자 정보 조회 실패"})
+			return
+		}
+		if user.EmailVerified {
+			c.JSON(http.StatusConflict, gin.H{"error": "이미 인증된 이메일입니다"})
+			return
+		}
+
+		// 이메일 인증 메일 발송
+		accountService := service.NewAccountService(db.DB, helper.NewMailer(cfg.Mail), cfg)
+		if err := accountService.SendVerificationEmail(user, c.GetHeader("Accept-Language")); err != nil {
+			log.Printf("이메일 인증 메일 발송 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "이메일 인증 메일 발송 실패"})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    gin.H{"message": "이메일 인증 메일이 발송되었습니다"},
+		})
+	}
+}
+
+// clientIP 요청의 클라이언트 IP (GeoMiddleware가 신뢰하는 프록시 설정으로 확인한 값)
+// gin의 ClientIP는 모든 요청의 X-Forwarded-For를 믿으므로 위조된 IP로 IP별 로그인 제한을 피할 수 없도록 사용하지 않음
+func clientIP(c *gin.Context) string {
+	if ip := c.GetString(middleware.ClientIPKey); ip != "" {
+		return ip
 	}
+	return c.RemoteIP()
 }
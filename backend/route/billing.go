@@ -0,0 +1,273 @@
+package route
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+
+	"backend/config"
+	"backend/helper"
+	"backend/middleware"
+	"backend/model"
+	"backend/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SetupBillingRoutes 결제 관련 라우트 설정
+func SetupBillingRoutes(router *gin.RouterGroup, cfg *config.Config) {
+	billingRoutes := router.Group("/billing")
+	{
+		// 결제 제공자가 호출하는 웹훅 (서명으로 검증하므로 인증 없음, 서명 키가 없으면 등록하지 않음)
+		if cfg.Billing.WebhookSecret != "" {
+			billingRoutes.POST("/webhook", handleBillingWebhook(cfg))
+		}
+
+		billingRoutes.POST("/checkout", middleware.AuthMiddleware(cfg), handleCreateCheckout(cfg))
+		billingRoutes.GET("/checkout/:id", middleware.AuthMiddleware(cfg), handleGetCheckout(cfg))
+
+		// 모의 결제 제공자의 결제 결과 처리 (billing.allow_simulation을 켠 경우에만 등록, 운영 환경에서는 항상 제외)
+		if cfg.Billing.Provider == config.BillingProviderFake && cfg.Billing.AllowSimulation && cfg.Environment != "production" {
+			billingRoutes.POST("/checkout/:id/simulate", middleware.AuthMiddleware(cfg), handleSimulatePayment(cfg))
+		}
+	}
+
+	router.GET("/users/invoices", middleware.AuthMiddleware(cfg), handleGetInvoices(cfg))
+}
+
+// newBillingService 설정의 결제 제공자로 BillingService 생성
+func newBillingService(c *gin.Context, cfg *config.Config) (*service.BillingService, bool) {
+	// 데이터베이스 연결
+	db, err := helper.GetDB(cfg)
+	if err != nil {
+		log.Printf("데이터베이스 연결 실패: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+		return nil, false
+	}
+
+	provider, err := helper.NewBillingProvider(cfg.Billing)
+	if err != nil {
+		log.Printf("결제 제공자 생성 실패: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "결제 제공자 설정 오류"})
+		return nil, false
+	}
+
+	return service.NewBillingService(db.DB, provider, cfg), true
+}
+
+// @Summary 결제 요청
+// @Description 요금제 한 달 이용권 결제 페이지 생성 (결제가 완료되면 웹훅으로 구독이 생성되며, 이용 중인 구독이 있으면 종료일부터 이어서 시작, 인증 필요)
+// @Tags 결제
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Param request body model.CreateCheckoutRequest true "요금제"
+// @Success 201 {object} model.ApiResponse{data=model.CheckoutSession} "결제 세션 (checkout_url로 이동)"
+// @Failure 400 {object} model.ErrorResponse "유효하지 않은 요청"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 404 {object} model.ErrorResponse "요금제 없음"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /billing/checkout [post]
+func handleCreateCheckout(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req model.CreateCheckoutRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 결제 요청"})
+			return
+		}
+
+		billingService, ok := newBillingService(c, cfg)
+		if !ok {
+			return
+		}
+
+		checkout, err := billingService.CreateCheckout(c.GetInt64("userID"), c.GetString("userEmail"), req.Plan)
+		if err != nil {
+			if errors.Is(err, service.ErrPlanNotFound) {
+				c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+				return
+			}
+			log.Printf("결제 세션 생성 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "결제 세션 생성 실패"})
+			return
+		}
+
+		c.JSON(http.StatusCreated, gin.H{
+			"success": true,
+			"data":    checkout,
+		})
+	}
+}
+
+// @Summary 결제 상태 조회
+// @Description 결제 페이지에서 돌아온 뒤 결제 반영 여부 확인 (인증 필요)
+// @Tags 결제
+// @Produce json
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Param id path int true "결제 세션 ID"
+// @Success 200 {object} model.ApiResponse{data=model.CheckoutSession} "결제 세션"
+// @Failure 400 {object} model.ErrorResponse "유효하지 않은 결제 세션 ID"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 404 {object} model.ErrorResponse "결제 세션 없음"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /billing/checkout/{id} [get]
+func handleGetCheckout(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		checkoutID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 결제 세션 ID"})
+			return
+		}
+
+		billingService, ok := newBillingService(c, cfg)
+		if !ok {
+			return
+		}
+
+		checkout, err := billingService.GetCheckout(c.GetInt64("userID"), checkoutID)
+		if err != nil {
+			if errors.Is(err, service.ErrCheckoutSessionNotFound) {
+				c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+				return
+			}
+			log.Printf("결제 세션 조회 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "결제 세션 조회 실패"})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    checkout,
+		})
+	}
+}
+
+// @Summary 모의 결제 완료 (개발용)
+// @Description 모의 결제 제공자를 사용하고 billing.allow_simulation을 켠 개발 환경에서 결제 결과 웹훅을 만들어 반영 (result=failed면 결제 실패, 인증 필요)
+// @Tags 결제
+// @Produce json
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Param id path int true "결제 세션 ID"
+// @Param result query string false "결제 결과 (succeeded, failed)" default(succeeded)
+// @Success 200 {object} model.ApiResponse{data=model.CheckoutSession} "반영된 결제 세션"
+// @Failure 400 {object} model.ErrorResponse "유효하지 않은 요청"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 404 {object} model.ErrorResponse "결제 세션 없음"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /billing/checkout/{id}/simulate [post]
+func handleSimulatePayment(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		checkoutID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 결제 세션 ID"})
+			return
+		}
+		result := c.DefaultQuery("result", "succeeded")
+		if result != "succeeded" && result != "failed" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "결제 결과는 succeeded 또는 failed여야 합니다"})
+			return
+		}
+
+		billingService, ok := newBillingService(c, cfg)
+		if !ok {
+			return
+		}
+
+		checkout, err := billingService.SimulatePayment(c.GetInt64("userID"), checkoutID, result == "succeeded")
+		if err != nil {
+			if errors.Is(err, service.ErrCheckoutSessionNotFound) {
+				c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+				return
+			}
+			log.Printf("모의 결제 처리 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "모의 결제 처리 실패"})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    checkout,
+		})
+	}
+}
+
+// @Summary 결제 웹훅
+// @Description 결제 제공자의 결제 성공/실패/환불 이벤트 수신 (Miniflix-Signature 헤더로 서명 검증, 같은 이벤트는 한 번만 반영)
+// @Tags 결제
+// @Accept json
+// @Produce json
+// @Param Miniflix-Signature header string true "t=유닉스시각,v1=HMAC-SHA256 서명"
+// @Success 200 {object} model.ApiResponse "수신 확인 (data.processed가 false면 이미 처리했거나 처리하지 않는 이벤트)"
+// @Failure 400 {object} model.ErrorResponse "서명 또는 본문 오류"
+// @Failure 404 {object} model.ErrorResponse "결제 세션 또는 결제 없음"
+// @Failure 422 {object} model.ErrorResponse "결제 금액 불일치"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /billing/webhook [post]
+func handleBillingWebhook(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 서명은 원문 그대로 검증해야 하므로 바인딩하지 않고 읽음
+		payload, err := c.GetRawData()
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "웹훅 본문을 읽을 수 없습니다"})
+			return
+		}
+
+		billingService, ok := newBillingService(c, cfg)
+		if !ok {
+			return
+		}
+
+		processed, err := billingService.HandleWebhook(payload, c.GetHeader(helper.BillingSignatureHeader))
+		if err != nil {
+			switch {
+			case errors.Is(err, helper.ErrInvalidWebhookSignature), errors.Is(err, helper.ErrInvalidWebhookPayload):
+				c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			case errors.Is(err, service.ErrCheckoutSessionNotFound), errors.Is(err, service.ErrPaymentNotFound):
+				c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			case errors.Is(err, model.ErrPaymentMismatch):
+				log.Printf("결제 금액 불일치: %s", payload)
+				c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+			default:
+				log.Printf("결제 웹훅 처리 실패: %v", err)
+				c.JSON(http.StatusInternalServerError, gin.H{"error": "결제 웹훅 처리 실패"})
+			}
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    gin.H{"processed": processed},
+		})
+	}
+}
+
+// @Summary 청구서 목록 조회
+// @Description 계정의 결제 성공/실패/환불 기록 조회 (최근 순, 인증 필요)
+// @Tags 결제
+// @Produce json
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Success 200 {object} model.ApiResponse{data=[]model.Invoice} "청구서 목록"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /users/invoices [get]
+func handleGetInvoices(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		billingService, ok := newBillingService(c, cfg)
+		if !ok {
+			return
+		}
+
+		invoices, err := billingService.GetInvoices(c.GetInt64("userID"))
+		if err != nil {
+			log.Printf("청구서 목록 조회 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "청구서 목록 조회 실패"})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    invoices,
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package route
+
+import (
+	"database/sql"
+	"log"
+	"net/http"
+	"strconv"
+
+	"backend/config"
+	"backend/helper"
+	"backend/middleware"
+	"backend/model"
+
+	"github.com/gin-gonic/gin"
+)
+
+// collectionItemsLimit 컬렉션 상세에서 조회하는 최대 콘텐츠 수
+const collectionItemsLimit = 100
+
+// SetupCollectionRoutes 큐레이션 컬렉션 관련 라우트 설정
+func SetupCollectionRoutes(router *gin.RouterGroup, cfg *config.Config) {
+	collectionRoutes := router.Group("/collections")
+	{
+		collectionRoutes.GET("", handleGetCollections(cfg))
+		collectionRoutes.GET("/:slug", middleware.OptionalAuthMiddleware(cfg), handleGetCollection(cfg))
+	}
+}
+
+// @Summary 컬렉션 목록 조회
+// @Description 현재 노출 기간인 큐레이션 컬렉션 목록을 노출 순서대로 조회
+// @Tags 컬렉션
+// @Accept json
+// @Produce json
+// @Success 200 {object} model.ArrayResponse{data=[]model.Collection} "컬렉션 목록"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /collections [get]
+func handleGetCollections(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		collections, err := model.GetActiveCollections(db.DB)
+		if err != nil {
+			log.Printf("컬렉션 목록 조회 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "컬렉션 목록 조회 실패"})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    collections,
+		})
+	}
+}
+
+// @Summary 컬렉션 상세 조회
+// @Description 컬렉션 정보와 콘텐츠 목록을 순서대로 조회 (인증 선택, 시청 등급/지역 제한으로 볼 수 없는 콘텐츠는 제외)
+// @Tags 컬렉션
+// @Accept json
+// @Produce json
+// @Param slug path string true "컬렉션 슬러그"
+// @Param size query int false "최대 콘텐츠 수 (기본값: 100)"
+// @Param Authorization header string false "Bearer JWT 토큰"
+// @Param lang query string false "표시 언어 (없으면 프로필 선호 언어, Accept-Language 순)"
+// @Success 200 {object} model.ApiResponse{data=model.CollectionDetailResponse} "컬렉션 상세"
+// @Failure 404 {object} model.ErrorResponse "컬렉션 없음"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /collections/{slug} [get]
+func handleGetCollection(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		size, err := strconv.Atoi(c.DefaultQuery("size", strconv.Itoa(collectionItemsLimit)))
+		if err != nil || size <= 0 || size > collectionItemsLimit {
+			size = collectionItemsLimit
+		}
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		collection, err := model.GetActiveCollectionBySlug(db.DB, c.Param("slug"))
+		if err != nil {
+			if err == sql.ErrNoRows {
+				c.JSON(http.StatusNotFound, gin.H{"error": "컬렉션을 찾을 수 없습니다"})
+				return
+			}
+			log.Printf("컬렉션 조회 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "컬렉션 조회 실패"})
+			return
+		}
+
+		// 시청자 정보 (로그인한 경우 프로필, 접속 국가, 선호 언어)
+		viewer := catalogViewer(c, db.DB)
+
+		items, err := model.GetCollectionContents(db.DB, collection.ID, viewer, size)
+		if err != nil {
+			log.Printf("컬렉션 콘텐츠 조회 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "컬렉션 콘텐츠 조회 실패"})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data": model.CollectionDetailResponse{
+				Collection: *collection,
+				Items:      items,
+			},
+		})
+	}
+}
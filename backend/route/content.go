@@ -1,6 +1,8 @@
 package route
 
 import (
+	"database/sql"
+	"errors"
 	"log"
 	"net/http"
 	"strconv"
@@ -21,11 +23,23 @@ func SetupContentRoutes(router *gin.RouterGroup, cfg *config.Config) {
 		// 비로그인 상태에서도 접근 가능한 라우트
 		contentRoutes.GET("", middleware.OptionalAuthMiddleware(cfg), handleGetContentList(cfg))
 		contentRoutes.GET("/:id", middleware.OptionalAuthMiddleware(cfg), handleGetContentDetail(cfg))
+		contentRoutes.GET("/trending", middleware.OptionalAuthMiddleware(cfg), handleGetTrendingContents(cfg))
+		contentRoutes.GET("/coming-soon", middleware.OptionalAuthMiddleware(cfg), handleGetComingSoonContents(cfg))
 		contentRoutes.GET("/search", middleware.OptionalAuthMiddleware(cfg), handleSearchContents(cfg))
 		contentRoutes.GET("/genre/:genreId", middleware.OptionalAuthMiddleware(cfg), handleGetContentsByGenre(cfg))
+		contentRoutes.GET("/:id/similar", middleware.OptionalAuthMiddleware(cfg), handleGetSimilarContents(cfg))
+		contentRoutes.GET("/:id/reviews", middleware.OptionalAuthMiddleware(cfg), handleGetContentReviews(cfg))
 
 		// 인증이 필요한 라우트
 		contentRoutes.POST("/:id/history", middleware.AuthMiddleware(cfg), handleUpdateViewingHistory(cfg))
+		contentRoutes.POST("/:id/reminder", middleware.AuthMiddleware(cfg), handleSetContentReminder(cfg))
+		contentRoutes.DELETE("/:id/reminder", middleware.AuthMiddleware(cfg), handleCancelContentReminder(cfg))
+		contentRoutes.PUT("/:id/rating", middleware.AuthMiddleware(cfg), handleSetContentRating(cfg))
+		contentRoutes.DELETE("/:id/rating", middleware.AuthMiddleware(cfg), handleDeleteContentRating(cfg))
+		contentRoutes.POST("/:id/reviews", middleware.AuthMiddleware(cfg), handleCreateReview(cfg))
+		contentRoutes.GET("/:id/reviews/mine", middleware.AuthMiddleware(cfg), handleGetMyReview(cfg))
+		contentRoutes.PUT("/:id/reviews/mine", middleware.AuthMiddleware(cfg), handleUpdateMyReview(cfg))
+		contentRoutes.DELETE("/:id/reviews/mine", middleware.AuthMiddleware(cfg), handleDeleteMyReview(cfg))
 
 		// 스트리밍 관련 라우트
 		contentRoutes.GET("/:id/stream", middleware.AuthMiddleware(cfg), handleStreamContent(cfg))
@@ -51,9 +65,13 @@ func SetupContentRoutesWithMockService(router *gin.RouterGroup, cfg *config.Conf
 // @Accept json
 // @Produce json
 // @Param Authorization header string false "Bearer JWT 토큰"
+// @Param lang query string false "표시 언어 (없으면 프로필 선호 언어, Accept-Language 순)"
 // @Param page query int false "페이지 번호 (기본값: 0)"
 // @Param size query int false "페이지당 항목 수 (기본값: 10)"
+// @Param cursor query string false "커서 (지정 시 커서 페이징, 첫 페이지는 빈 값, 다른 목록/검색어에서 만든 커서는 400)"
+// @Param sort query string false "정렬 (latest: 최신순(기본값), popularity: 인기도 순, 커서 페이징과 함께 사용 불가)"
 // @Success 200 {object} model.PagingResponse "콘텐츠 목록"
+// @Failure 400 {object} model.ErrorResponse "잘못된 요청"
 // @Failure 500 {object} model.ErrorResponse "서버 오류"
 // @Router /contents [get]
 func handleGetContentList(cfg *config.Config) gin.HandlerFunc {
@@ -69,6 +87,17 @@ func handleGetContentList(cfg *config.Config) gin.HandlerFunc {
 			size = 10
 		}
 
+		// 정렬 파라미터 처리
+		sort := c.Query("sort")
+		if !model.IsValidContentSort(sort) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 정렬 옵션"})
+			return
+		}
+		if _, ok := c.GetQuery("cursor"); ok && sort == model.ContentSortPopularity {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "인기도 정렬은 커서 페이징을 지원하지 않습니다"})
+			return
+		}
+
 		// 데이터베이스 연결
 		db, err := helper.GetDB(cfg)
 		if err != nil {
@@ -77,14 +106,31 @@ func handleGetContentList(cfg *config.Config) gin.HandlerFunc {
 			return
 		}
 
-		// 인증된 사용자인지 확인
-		var userID int64
-		if isAuthenticated, exists := c.Get("isAuthenticated"); exists && isAuthenticated.(bool) {
-			userID = c.GetInt64("userID")
+		// 시청자 정보 (로그인한 경우 프로필, 접속 국가, 선호 언어)
+		viewer := catalogViewer(c, db.DB)
+
+		// 커서 파라미터가 있으면 커서 페이징으로 조회
+		if cursor, ok := c.GetQuery("cursor"); ok {
+			cursorPage, err := model.GetContentListByCursor(db.DB, viewer, cursor, size)
+			if err != nil {
+				if errors.Is(err, model.ErrInvalidCursor) {
+					c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+					return
+				}
+				log.Printf("콘텐츠 목록 조회 실패: %v", err)
+				c.JSON(http.StatusInternalServerError, gin.H{"error": "콘텐츠 목록 조회 실패"})
+				return
+			}
+
+			c.JSON(http.StatusOK, gin.H{
+				"success": true,
+				"data":    cursorPage,
+			})
+			return
 		}
 
 		// 콘텐츠 목록 조회 (페이징 적용)
-		pageInfo, err := model.GetContentList(db.DB, userID, page, size)
+		pageInfo, err := model.GetContentList(db.DB, viewer, page, size, sort)
 		if err != nil {
 			log.Printf("콘텐츠 목록 조회 실패: %v", err)
 			c.JSON(http.StatusInternalServerError, gin.H{"error": "콘텐츠 목록 조회 실패"})
@@ -105,8 +151,13 @@ func handleGetContentList(cfg *config.Config) gin.HandlerFunc {
 // @Produce json
 // @Param id path int true "콘텐츠 ID"
 // @Param Authorization header string false "Bearer JWT 토큰"
+// @Param lang query string false "표시 언어 (없으면 프로필 선호 언어, Accept-Language 순)"
+// @Param X-Parental-PIN header string false "보호자 PIN (프로필 시청 등급을 초과하는 콘텐츠 조회 시)"
 // @Success 200 {object} model.ApiResponse{data=model.ContentDetailResponse} "콘텐츠 상세 정보"
+// @Failure 403 {object} model.ErrorResponse "시청 등급 제한"
 // @Failure 404 {object} model.ErrorResponse "콘텐츠 없음"
+// @Failure 451 {object} model.ErrorResponse "현재 국가에서 이용할 수 없는 콘텐츠"
+// @Failure 429 {object} model.ErrorResponse "보호자 PIN 반복 실패로 일시 제한"
 // @Failure 500 {object} model.ErrorResponse "서버 오류"
 // @Router /contents/{id} [get]
 func handleGetContentDetail(cfg *config.Config) gin.HandlerFunc {
@@ -126,20 +177,36 @@ func handleGetContentDetail(cfg *config.Config) gin.HandlerFunc {
 			return
 		}
 
-		// 인증된 사용자인지 확인
-		var userID int64
-		if isAuthenticated, exists := c.Get("isAuthenticated"); exists && isAuthenticated.(bool) {
-			userID = c.GetInt64("userID")
-		}
+		// 시청자 정보 (로그인한 경우 프로필, 접속 국가, 선호 언어)
+		viewer := catalogViewer(c, db.DB)
+		profileID := viewer.ProfileID
 
-		// 콘텐츠 상세 정보 조회
-		content, err := model.GetContentDetail(db.DB, contentID, userID)
+		// 콘텐츠 상세 정보 조회 (선호 언어로 번역)
+		content, err := model.GetContentDetail(db.DB, contentID, viewer)
 		if err != nil {
 			log.Printf("콘텐츠 상세 정보 조회 실패: %v", err)
 			c.JSON(http.StatusNotFound, gin.H{"error": "콘텐츠를 찾을 수 없습니다"})
 			return
 		}
 
+		// 접속 국가의 이용 가능 기간 확인
+		if !checkContentAvailable(c, db.DB, contentID, viewer.Country) {
+			return
+		}
+
+		// 프로필 시청 등급 확인
+		if profileID > 0 {
+			profileService := newProfileService(db.DB, cfg)
+			err := profileService.AuthorizeMaturityLevel(c.GetInt64("userID"), profileID, content.MaturityLevel, c.GetHeader(parentalPINHeader))
+			if err != nil {
+				if !writeParentalControlError(c, err) {
+					log.Printf("시청 등급 확인 실패: %v", err)
+					c.JSON(http.StatusInternalServerError, gin.H{"error": "시청 등급 확인 실패"})
+				}
+				return
+			}
+		}
+
 		c.JSON(http.StatusOK, gin.H{
 			"success": true,
 			"data":    content,
@@ -154,8 +221,11 @@ func handleGetContentDetail(cfg *config.Config) gin.HandlerFunc {
 // @Produce json
 // @Param q query string true "검색어"
 // @Param Authorization header string false "Bearer JWT 토큰"
+// @Param lang query string false "표시 언어 (없으면 프로필 선호 언어, Accept-Language 순)"
 // @Param page query int false "페이지 번호 (기본값: 0)"
 // @Param size query int false "페이지당 항목 수 (기본값: 10)"
+// @Param cursor query string false "커서 (지정 시 커서 페이징, 첫 페이지는 빈 값, 다른 목록/검색어에서 만든 커서는 400)"
+// @Param sort query string false "정렬 (latest: 최신순(기본값), popularity: 인기도 순, 커서 페이징과 함께 사용 불가)"
 // @Success 200 {object} model.PagingResponse "검색 결과"
 // @Failure 400 {object} model.ErrorResponse "잘못된 요청"
 // @Failure 500 {object} model.ErrorResponse "서버 오류"
@@ -180,6 +250,17 @@ func handleSearchContents(cfg *config.Config) gin.HandlerFunc {
 			size = 10
 		}
 
+		// 정렬 파라미터 처리
+		sort := c.Query("sort")
+		if !model.IsValidContentSort(sort) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 정렬 옵션"})
+			return
+		}
+		if _, ok := c.GetQuery("cursor"); ok && sort == model.ContentSortPopularity {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "인기도 정렬은 커서 페이징을 지원하지 않습니다"})
+			return
+		}
+
 		// 데이터베이스 연결
 		db, err := helper.GetDB(cfg)
 		if err != nil {
@@ -188,14 +269,31 @@ func handleSearchContents(cfg *config.Config) gin.HandlerFunc {
 			return
 		}
 
-		// 인증된 사용자인지 확인
-		var userID int64
-		if isAuthenticated, exists := c.Get("isAuthenticated"); exists && isAuthenticated.(bool) {
-			userID = c.GetInt64("userID")
+		// 시청자 정보 (로그인한 경우 프로필, 접속 국가, 선호 언어)
+		viewer := catalogViewer(c, db.DB)
+
+		// 커서 파라미터가 있으면 커서 페이징으로 조회
+		if cursor, ok := c.GetQuery("cursor"); ok {
+			cursorPage, err := model.SearchContentsByCursor(db.DB, query, viewer, cursor, size)
+			if err != nil {
+				if errors.Is(err, model.ErrInvalidCursor) {
+					c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+					return
+				}
+				log.Printf("콘텐츠 검색 실패: %v", err)
+				c.JSON(http.StatusInternalServerError, gin.H{"error": "콘텐츠 검색 실패"})
+				return
+			}
+
+			c.JSON(http.StatusOK, gin.H{
+				"success": true,
+				"data":    cursorPage,
+			})
+			return
 		}
 
 		// 검색 쿼리 실행 (페이징 적용)
-		pageInfo, err := model.SearchContents(db.DB, query, userID, page, size)
+		pageInfo, err := model.SearchContents(db.DB, query, viewer, page, size, sort)
 		if err != nil {
 			log.Printf("콘텐츠 검색 실패: %v", err)
 			c.JSON(http.StatusInternalServerError, gin.H{"error": "콘텐츠 검색 실패"})
@@ -216,8 +314,11 @@ func handleSearchContents(cfg *config.Config) gin.HandlerFunc {
 // @Produce json
 // @Param genreId path int true "장르 ID"
 // @Param Authorization header string false "Bearer JWT 토큰"
+// @Param lang query string false "표시 언어 (없으면 프로필 선호 언어, Accept-Language 순)"
 // @Param page query int false "페이지 번호 (기본값: 0)"
 // @Param size query int false "페이지당 항목 수 (기본값: 10)"
+// @Param cursor query string false "커서 (지정 시 커서 페이징, 첫 페이지는 빈 값, 다른 목록/검색어에서 만든 커서는 400)"
+// @Param sort query string false "정렬 (latest: 최신순(기본값), popularity: 인기도 순, 커서 페이징과 함께 사용 불가)"
 // @Success 200 {object} model.PagingResponse "장르별 콘텐츠 목록"
 // @Failure 400 {object} model.ErrorResponse "잘못된 요청"
 // @Failure 500 {object} model.ErrorResponse "서버 오류"
@@ -242,6 +343,17 @@ func handleGetContentsByGenre(cfg *config.Config) gin.HandlerFunc {
 			size = 10
 		}
 
+		// 정렬 파라미터 처리
+		sort := c.Query("sort")
+		if !model.IsValidContentSort(sort) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 정렬 옵션"})
+			return
+		}
+		if _, ok := c.GetQuery("cursor"); ok && sort == model.ContentSortPopularity {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "인기도 정렬은 커서 페이징을 지원하지 않습니다"})
+			return
+		}
+
 		// 데이터베이스 연결
 		db, err := helper.GetDB(cfg)
 		if err != nil {
@@ -250,14 +362,31 @@ func handleGetContentsByGenre(cfg *config.Config) gin.HandlerFunc {
 			return
 		}
 
-		// 인증된 사용자인지 확인
-		var userID int64
-		if isAuthenticated, exists := c.Get("isAuthenticated"); exists && isAuthenticated.(bool) {
-			userID = c.GetInt64("userID")
+		// 시청자 정보 (로그인한 경우 프로필, 접속 국가, 선호 언어)
+		viewer := catalogViewer(c, db.DB)
+
+		// 커서 파라미터가 있으면 커서 페이징으로 조회
+		if cursor, ok := c.GetQuery("cursor"); ok {
+			cursorPage, err := model.GetContentsByGenreByCursor(db.DB, genreID, viewer, cursor, size)
+			if err != nil {
+				if errors.Is(err, model.ErrInvalidCursor) {
+					c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+					return
+				}
+				log.Printf("장르별 콘텐츠 조회 실패: %v", err)
+				c.JSON(http.StatusInternalServerError, gin.H{"error": "장르별 콘텐츠 조회 실패"})
+				return
+			}
+
+			c.JSON(http.StatusOK, gin.H{
+				"success": true,
+				"data":    cursorPage,
+			})
+			return
 		}
 
 		// 장르별 콘텐츠 조회 (페이징 적용)
-		pageInfo, err := model.GetContentsByGenre(db.DB, genreID, userID, page, size)
+		pageInfo, err := model.GetContentsByGenre(db.DB, genreID, viewer, page, size, sort)
 		if err != nil {
 			log.Printf("장르별 콘텐츠 조회 실패: %v", err)
 			c.JSON(http.StatusInternalServerError, gin.H{"error": "장르별 콘텐츠 조회 실패"})
@@ -271,17 +400,271 @@ func handleGetContentsByGenre(cfg *config.Config) gin.HandlerFunc {
 	}
 }
 
+// @Summary 유사 콘텐츠 조회
+// @Description 시청/찜 패턴과 장르를 기반으로 계산된 유사 콘텐츠 목록 조회 (인증 선택)
+// @Tags 콘텐츠
+// @Accept json
+// @Produce json
+// @Param id path int true "콘텐츠 ID"
+// @Param Authorization header string false "Bearer JWT 토큰"
+// @Param lang query string false "표시 언어 (없으면 프로필 선호 언어, Accept-Language 순)"
+// @Param size query int false "최대 항목 수 (기본값: 20, 최대: 50)"
+// @Success 200 {object} model.ArrayResponse{data=[]model.ContentListResponse} "유사 콘텐츠 목록"
+// @Failure 400 {object} model.ErrorResponse "유효하지 않은 콘텐츠 ID"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /contents/{id}/similar [get]
+func handleGetSimilarContents(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 콘텐츠 ID 파싱
+		contentID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 콘텐츠 ID"})
+			return
+		}
+
+		size, err := strconv.Atoi(c.DefaultQuery("size", "20"))
+		if err != nil || size <= 0 {
+			size = 20
+		}
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		// 시청자 정보 (로그인한 경우 프로필, 접속 국가, 선호 언어)
+		viewer := catalogViewer(c, db.DB)
+
+		// 유사 콘텐츠 조회
+		recommendationService := service.NewRecommendationService(db.DB)
+		contents, err := recommendationService.GetSimilarContents(contentID, viewer, size)
+		if err != nil {
+			log.Printf("유사 콘텐츠 조회 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "유사 콘텐츠 조회 실패"})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    contents,
+		})
+	}
+}
+
+// @Summary 인기 콘텐츠 조회
+// @Description 스트리밍 시작/시청 완료/찜 추가 이벤트에 시간 감쇠를 적용한 인기도 순 콘텐츠 목록 조회 (인증 선택)
+// @Tags 콘텐츠
+// @Accept json
+// @Produce json
+// @Param Authorization header string false "Bearer JWT 토큰"
+// @Param lang query string false "표시 언어 (없으면 프로필 선호 언어, Accept-Language 순)"
+// @Param size query int false "최대 항목 수 (기본값: 20, 최대: 50)"
+// @Success 200 {object} model.ArrayResponse{data=[]model.ContentListResponse} "인기 콘텐츠 목록"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /contents/trending [get]
+func handleGetTrendingContents(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		size, err := strconv.Atoi(c.DefaultQuery("size", "20"))
+		if err != nil || size <= 0 {
+			size = 20
+		}
+		if size > 50 {
+			size = 50
+		}
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		// 시청자 정보 (로그인한 경우 프로필, 접속 국가, 선호 언어)
+		viewer := catalogViewer(c, db.DB)
+
+		// 인기 콘텐츠 조회
+		contents, err := model.GetTrendingContents(db.DB, viewer, size)
+		if err != nil {
+			log.Printf("인기 콘텐츠 조회 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "인기 콘텐츠 조회 실패"})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    contents,
+		})
+	}
+}
+
+// @Summary 공개 예정 콘텐츠 조회
+// @Description 공개 일시가 가까운 순으로 공개 예정 콘텐츠 목록 조회 (인증 선택, 로그인 시 공개 알림 신청 여부 포함)
+// @Tags 콘텐츠
+// @Accept json
+// @Produce json
+// @Param Authorization header string false "Bearer JWT 토큰"
+// @Param lang query string false "표시 언어 (없으면 프로필 선호 언어, Accept-Language 순)"
+// @Param size query int false "최대 항목 수 (기본값: 20, 최대: 50)"
+// @Success 200 {object} model.ArrayResponse{data=[]model.ComingSoonResponse} "공개 예정 콘텐츠 목록"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /contents/coming-soon [get]
+func handleGetComingSoonContents(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		size, err := strconv.Atoi(c.DefaultQuery("size", "20"))
+		if err != nil || size <= 0 {
+			size = 20
+		}
+		if size > 50 {
+			size = 50
+		}
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		// 공개 예정 콘텐츠 조회
+		contents, err := model.GetComingSoonContents(db.DB, catalogViewer(c, db.DB), size)
+		if err != nil {
+			log.Printf("공개 예정 콘텐츠 조회 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "공개 예정 콘텐츠 조회 실패"})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    contents,
+		})
+	}
+}
+
+// @Summary 공개 알림 신청
+// @Description 공개 예정 콘텐츠가 공개되면 메일로 알림 받기 (인증 필요, 프로필 언어로 발송)
+// @Tags 콘텐츠
+// @Accept json
+// @Produce json
+// @Param id path int true "콘텐츠 ID"
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Success 201 {object} model.ApiResponse{data=model.ContentSchedule} "신청 완료 (공개 일정 포함)"
+// @Failure 400 {object} model.ErrorResponse "유효하지 않은 콘텐츠 ID"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 403 {object} model.ErrorResponse "시청 등급 제한"
+// @Failure 404 {object} model.ErrorResponse "콘텐츠 없음"
+// @Failure 409 {object} model.ErrorResponse "이미 공개된 콘텐츠"
+// @Failure 451 {object} model.ErrorResponse "공개 시점에 현재 국가에서 이용할 수 없는 콘텐츠"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /contents/{id}/reminder [post]
+func handleSetContentReminder(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 콘텐츠 ID 파싱
+		contentID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 콘텐츠 ID"})
+			return
+		}
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		reminderService := service.NewContentReminderService(db.DB, helper.NewMailer(cfg.Mail), cfg)
+		schedule, err := reminderService.SetReminder(authenticatedViewer(c, db.DB), contentID)
+		if err != nil {
+			switch {
+			case errors.Is(err, service.ErrContentNotFound):
+				c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			case errors.Is(err, service.ErrMaturityRestricted):
+				c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+			case errors.Is(err, service.ErrContentAlreadyPublic):
+				c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			case errors.Is(err, service.ErrContentRegionRestricted):
+				c.JSON(http.StatusUnavailableForLegalReasons, gin.H{"error": err.Error(), "code": "not_available_in_region"})
+			default:
+				log.Printf("공개 알림 신청 실패: %v", err)
+				c.JSON(http.StatusInternalServerError, gin.H{"error": "공개 알림 신청 실패"})
+			}
+			return
+		}
+
+		c.JSON(http.StatusCreated, gin.H{
+			"success": true,
+			"data":    schedule,
+		})
+	}
+}
+
+// @Summary 공개 알림 신청 취소
+// @Description 공개 예정 콘텐츠의 공개 알림 신청 취소 (인증 필요)
+// @Tags 콘텐츠
+// @Accept json
+// @Produce json
+// @Param id path int true "콘텐츠 ID"
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Success 200 {object} model.ApiResponse "취소 완료"
+// @Failure 400 {object} model.ErrorResponse "유효하지 않은 콘텐츠 ID"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /contents/{id}/reminder [delete]
+func handleCancelContentReminder(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 콘텐츠 ID 파싱
+		contentID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 콘텐츠 ID"})
+			return
+		}
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		reminderService := service.NewContentReminderService(db.DB, helper.NewMailer(cfg.Mail), cfg)
+		if err := reminderService.CancelReminder(c.GetInt64("profileID"), contentID); err != nil {
+			log.Printf("공개 알림 신청 취소 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "공개 알림 신청 취소 실패"})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data": gin.H{
+				"message": "공개 알림 신청이 취소되었습니다",
+			},
+		})
+	}
+}
+
 // @Summary 콘텐츠 스트리밍
-// @Description 특정 콘텐츠의 스트리밍 URL 조회 (인증 필요)
+// @Description 특정 콘텐츠의 스트리밍 URL 조회 (인증 필요, 구독 요금제의 최대 화질과 동시 시청 수 적용)
 // @Tags 콘텐츠
 // @Accept json
 // @Produce json
 // @Param id path int true "콘텐츠 ID"
 // @Param Authorization header string true "Bearer JWT 토큰"
+// @Param X-Parental-PIN header string false "보호자 PIN (프로필 시청 등급을 초과하는 콘텐츠 재생 시)"
 // @Success 200 {object} model.ApiResponse{data=model.StreamingResponse} "스트리밍 정보"
 // @Failure 400 {object} model.ErrorResponse "유효하지 않은 콘텐츠 ID"
 // @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 402 {object} model.ErrorResponse "구독 없음 또는 구독 만료"
+// @Failure 403 {object} model.ErrorResponse "시청 등급 제한, 이메일 미인증 또는 동시 시청 수 초과"
 // @Failure 404 {object} model.ErrorResponse "콘텐츠 없음"
+// @Failure 451 {object} model.ErrorResponse "현재 국가에서 이용할 수 없는 콘텐츠"
+// @Failure 429 {object} model.ErrorResponse "보호자 PIN 반복 실패로 일시 제한"
 // @Failure 500 {object} model.ErrorResponse "서버 오류"
 // @Router /contents/{id}/stream [get]
 func handleStreamContent(cfg *config.Config) gin.HandlerFunc {
@@ -301,15 +684,49 @@ func handleStreamContent(cfg *config.Config) gin.HandlerFunc {
 			return
 		}
 
-		// 사용자 ID 가져오기
-		userID := c.GetInt64("userID")
+		// 프로필 ID 가져오기
+		profileID := c.GetInt64("profileID")
+
+		// 접속 국가의 이용 가능 기간 확인
+		if !checkContentAvailable(c, db.DB, contentID, c.GetString(middleware.CountryKey)) {
+			return
+		}
+
+		// 이메일 미인증 계정 제한 (설정된 경우)
+		accountService := service.NewAccountService(db.DB, helper.NewMailer(cfg.Mail), cfg)
+		if err := accountService.RequireVerifiedEmail(c.GetInt64("userID")); err != nil {
+			if errors.Is(err, service.ErrEmailNotVerified) {
+				c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+				return
+			}
+			log.Printf("이메일 인증 여부 확인 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "이메일 인증 여부 확인 실패"})
+			return
+		}
+
+		// 프로필 시청 등급 확인 (등급 초과 콘텐츠는 보호자 PIN 필요)
+		profileService := newProfileService(db.DB, cfg)
+		if err := profileService.AuthorizeContent(c.GetInt64("userID"), profileID, contentID, c.GetHeader(parentalPINHeader)); err != nil {
+			if err == sql.ErrNoRows {
+				c.JSON(http.StatusNotFound, gin.H{"error": "콘텐츠를 찾을 수 없습니다"})
+				return
+			}
+			if !writeParentalControlError(c, err) {
+				log.Printf("시청 등급 확인 실패: %v", err)
+				c.JSON(http.StatusInternalServerError, gin.H{"error": "시청 등급 확인 실패"})
+			}
+			return
+		}
 
 		// 콘텐츠 서비스 생성
 		contentService := service.NewContentService(db.DB)
 
-		// 스트리밍 URL 조회
-		response, err := contentService.GetStreamingURL(contentID, userID)
+		// 스트리밍 URL 조회 (구독 요금제 확인 포함)
+		response, err := contentService.GetStreamingURL(contentID, profileID)
 		if err != nil {
+			if writeEntitlementError(c, err) {
+				return
+			}
 			log.Printf("스트리밍 URL 조회 실패: %v", err)
 			c.JSON(http.StatusNotFound, gin.H{"error": "콘텐츠를 찾을 수 없습니다"})
 			return
@@ -322,6 +739,117 @@ func handleStreamContent(cfg *config.Config) gin.HandlerFunc {
 	}
 }
 
+// catalogViewer 인증 선택 라우트의 시청자 정보 (로그인한 경우 프로필, 접속 국가, 선호 언어)
+func catalogViewer(c *gin.Context, db *sql.DB) model.Viewer {
+	var profileID int64
+	if isAuthenticated, exists := c.Get("isAuthenticated"); exists && isAuthenticated.(bool) {
+		profileID = c.GetInt64("profileID")
+	}
+	return newViewer(c, db, profileID)
+}
+
+// authenticatedViewer 인증 필수 라우트의 시청자 정보 (프로필, 접속 국가, 선호 언어)
+func authenticatedViewer(c *gin.Context, db *sql.DB) model.Viewer {
+	return newViewer(c, db, c.GetInt64("profileID"))
+}
+
+// newViewer 시청자 정보 생성 (접속 국가는 GeoMiddleware가 확인한 값)
+func newViewer(c *gin.Context, db *sql.DB, profileID int64) model.Viewer {
+	return model.Viewer{
+		ProfileID: profileID,
+		Country:   c.GetString(middleware.CountryKey),
+		Locales:   requestLocales(c, db, profileID),
+	}
+}
+
+// requestLocales 번역 조회 언어 순서 (lang 쿼리 파라미터 → 프로필 선호 언어 → Accept-Language 헤더)
+// 각 언어 뒤에는 상위 언어가 이어지며, 어느 번역도 없으면 원문을 사용
+func requestLocales(c *gin.Context, db *sql.DB, profileID int64) []string {
+	preferred := []string{c.Query("lang")}
+	if profileID > 0 {
+		language, err := model.GetProfileLanguage(db, profileID)
+		if err != nil {
+			log.Printf("프로필 선호 언어 조회 실패: %v", err)
+		} else {
+			preferred = append(preferred, language)
+		}
+	}
+	preferred = append(preferred, helper.ParseAcceptLanguage(c.GetHeader("Accept-Language"))...)
+	return helper.LocaleFallbackChain(preferred...)
+}
+
+// checkContentAvailable 콘텐츠가 공개 중이며 접속 국가에서 이용 가능한지 확인
+// 콘텐츠가 없거나 공개 기간이 아니면 404, 이용 기간이 아니거나 라이선스가 없는 국가이면 451 응답 후 false 반환
+func checkContentAvailable(c *gin.Context, db *sql.DB, contentID int64, country string) bool {
+	published, err := model.IsContentPublished(db, contentID)
+	if err != nil && err != sql.ErrNoRows {
+		log.Printf("콘텐츠 공개 여부 확인 실패: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "콘텐츠 공개 여부 확인 실패"})
+		return false
+	}
+	if !published {
+		c.JSON(http.StatusNotFound, gin.H{"error": "콘텐츠를 찾을 수 없습니다"})
+		return false
+	}
+
+	available, err := model.IsContentAvailable(db, contentID, country)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			c.JSON(http.StatusNotFound, gin.H{"error": "콘텐츠를 찾을 수 없습니다"})
+			return false
+		}
+		log.Printf("콘텐츠 이용 가능 여부 확인 실패: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "콘텐츠 이용 가능 여부 확인 실패"})
+		return false
+	}
+	if !available {
+		c.JSON(http.StatusUnavailableForLegalReasons, gin.H{"error": "현재 국가에서 이용할 수 없는 콘텐츠입니다", "code": "not_available_in_region"})
+		return false
+	}
+	return true
+}
+
+// authorizeContentAccess 콘텐츠 이용 가능 여부와 프로필 시청 등급 확인 (profileID가 0이면 비로그인으로 보고 등급 확인 생략)
+// 등급 초과 콘텐츠는 보호자 PIN 헤더가 필요하며, 허용되지 않으면 응답 후 false 반환
+func authorizeContentAccess(c *gin.Context, cfg *config.Config, db *sql.DB, contentID, profileID int64) bool {
+	if !checkContentAvailable(c, db, contentID, c.GetString(middleware.CountryKey)) {
+		return false
+	}
+	if profileID == 0 {
+		return true
+	}
+
+	profileService := newProfileService(db, cfg)
+	if err := profileService.AuthorizeContent(c.GetInt64("userID"), profileID, contentID, c.GetHeader(parentalPINHeader)); err != nil {
+		if err == sql.ErrNoRows {
+			c.JSON(http.StatusNotFound, gin.H{"error": "콘텐츠를 찾을 수 없습니다"})
+			return false
+		}
+		if !writeParentalControlError(c, err) {
+			log.Printf("시청 등급 확인 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "시청 등급 확인 실패"})
+		}
+		return false
+	}
+	return true
+}
+
+// writeEntitlementError 구독 요금제 관련 오류이면 응답 후 true 반환
+// 구독이 없거나 만료된 경우 402, 동시 시청 수 초과는 403
+func writeEntitlementError(c *gin.Context, err error) bool {
+	switch {
+	case errors.Is(err, service.ErrSubscriptionRequired):
+		c.JSON(http.StatusPaymentRequired, gin.H{"error": err.Error(), "code": "subscription_required"})
+	case errors.Is(err, service.ErrSubscriptionExpired):
+		c.JSON(http.StatusPaymentRequired, gin.H{"error": err.Error(), "code": "subscription_expired"})
+	case errors.Is(err, service.ErrStreamLimitExceeded):
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error(), "code": "stream_limit_exceeded"})
+	default:
+		return false
+	}
+	return true
+}
+
 // @Summary 재생 위치 업데이트
 // @Description 콘텐츠 시청 중 재생 위치 업데이트 (인증 필요)
 // @Tags 콘텐츠
@@ -365,14 +893,14 @@ func handleUpdatePlaybackPosition(cfg *config.Config) gin.HandlerFunc {
 			return
 		}
 
-		// 사용자 ID 가져오기
-		userID := c.GetInt64("userID")
+		// 프로필 ID 가져오기
+		profileID := c.GetInt64("profileID")
 
 		// 콘텐츠 서비스 생성
 		contentService := service.NewContentService(db.DB)
 
 		// 재생 위치 업데이트
-		if err := contentService.UpdatePlaybackPosition(userID, &req); err != nil {
+		if err := contentService.UpdatePlaybackPosition(profileID, &req); err != nil {
 			log.Printf("재생 위치 업데이트 실패: %v", err)
 			c.JSON(http.StatusInternalServerError, gin.H{"error": "재생 위치 업데이트 실패"})
 			return
@@ -430,14 +958,14 @@ func handleSaveFinalPosition(cfg *config.Config) gin.HandlerFunc {
 			return
 		}
 
-		// 사용자 ID 가져오기
-		userID := c.GetInt64("userID")
+		// 프로필 ID 가져오기
+		profileID := c.GetInt64("profileID")
 
 		// 콘텐츠 서비스 생성
 		contentService := service.NewContentService(db.DB)
 
 		// 최종 재생 위치 저장
-		if err := contentService.SaveFinalPosition(userID, &req); err != nil {
+		if err := contentService.SaveFinalPosition(profileID, &req); err != nil {
 			log.Printf("최종 재생 위치 저장 실패: %v", err)
 			c.JSON(http.StatusInternalServerError, gin.H{"error": "최종 재생 위치 저장 실패"})
 			return
@@ -495,11 +1023,11 @@ func handleUpdateViewingHistory(cfg *config.Config) gin.HandlerFunc {
 			return
 		}
 
-		// 사용자 ID 가져오기
-		userID := c.GetInt64("userID")
+		// 프로필 ID 가져오기
+		profileID := c.GetInt64("profileID")
 
 		// 시청 기록 업데이트
-		if err := model.UpdateViewingHistory(db.DB, userID, &req); err != nil {
+		if err := model.UpdateViewingHistory(db.DB, profileID, &req); err != nil {
 			log.Printf("시청 기록 업데이트 실패: %v", err)
 			c.JSON(http.StatusInternalServerError, gin.H{"error": "시청 기록 업데이트 실패"})
 			return
@@ -524,12 +1052,12 @@ func handleStreamContentWithMock(cfg *config.Config, mockService interface{}) gi
 			return
 		}
 
-		// 사용자 ID 가져오기
-		userID := c.GetInt64("userID")
+		// 프로필 ID 가져오기
+		profileID := c.GetInt64("profileID")
 
 		// 모의 서비스 메서드 호출
 		contentService, ok := mockService.(interface {
-			GetStreamingURL(contentID int64, userID int64) (*model.StreamingResponse, error)
+			GetStreamingURL(contentID int64, profileID int64) (*model.StreamingResponse, error)
 		})
 		if !ok {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": "모의 서비스 유형 오류"})
@@ -537,7 +1065,7 @@ func handleStreamContentWithMock(cfg *config.Config, mockService interface{}) gi
 		}
 
 		// 스트리밍 URL 조회
-		response, err := contentService.GetStreamingURL(contentID, userID)
+		response, err := contentService.GetStreamingURL(contentID, profileID)
 		if err != nil {
 			log.Printf("스트리밍 URL 조회 실패: %v", err)
 			c.JSON(http.StatusNotFound, gin.H{"error": "콘텐츠를 찾을 수 없습니다"})
@@ -574,12 +1102,12 @@ func handleUpdatePlaybackPositionWithMock(cfg *config.Config, mockService interf
 			return
 		}
 
-		// 사용자 ID 가져오기
-		userID := c.GetInt64("userID")
+		// 프로필 ID 가져오기
+		profileID := c.GetInt64("profileID")
 
 		// 모의 서비스 메서드 호출
 		contentService, ok := mockService.(interface {
-			UpdatePlaybackPosition(req *model.PlaybackPositionRequest, userID int64) error
+			UpdatePlaybackPosition(req *model.PlaybackPositionRequest, profileID int64) error
 		})
 		if !ok {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": "모의 서비스 유형 오류"})
@@ -587,7 +1115,7 @@ func handleUpdatePlaybackPositionWithMock(cfg *config.Config, mockService interf
 		}
 
 		// 재생 위치 업데이트
-		if err := contentService.UpdatePlaybackPosition(&req, userID); err != nil {
+		if err := contentService.UpdatePlaybackPosition(&req, profileID); err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": "재생 위치 업데이트 실패"})
 			return
 		}
@@ -624,12 +1152,12 @@ func handleSaveFinalPositionWithMock(cfg *config.Config, mockService interface{}
 			return
 		}
 
-		// 사용자 ID 가져오기
-		userID := c.GetInt64("userID")
+		// 프로필 ID 가져오기
+		profileID := c.GetInt64("profileID")
 
 		// 모의 서비스 메서드 호출
 		contentService, ok := mockService.(interface {
-			SaveFinalPosition(req *model.FinalPositionRequest, userID int64) error
+			SaveFinalPosition(req *model.FinalPositionRequest, profileID int64) error
 		})
 		if !ok {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": "모의 서비스 유형 오류"})
@@ -637,7 +1165,7 @@ func handleSaveFinalPositionWithMock(cfg *config.Config, mockService interface{}
 		}
 
 		// 최종 재생 위치 저장
-		if err := contentService.SaveFinalPosition(&req, userID); err != nil {
+		if err := contentService.SaveFinalPosition(&req, profileID); err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": "최종 재생 위치 저장 실패"})
 			return
 		}
@@ -0,0 +1,135 @@
+package route
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+
+	"backend/config"
+	"backend/helper"
+	"backend/model"
+	"backend/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary 콘텐츠 평가
+// @Description 콘텐츠에 엄지 평가(up/down)와 별점(1~5) 중 하나 이상으로 평가 (기존 평가는 교체, 인증 필요)
+// @Tags 콘텐츠
+// @Accept json
+// @Produce json
+// @Param id path int true "콘텐츠 ID"
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Param X-Parental-PIN header string false "보호자 PIN (프로필 시청 등급을 초과하는 콘텐츠인 경우)"
+// @Param request body model.UserRatingRequest true "평가"
+// @Success 200 {object} model.ApiResponse{data=model.UserRatingResponse} "저장된 평가와 평가 집계"
+// @Failure 400 {object} model.ErrorResponse "유효하지 않은 요청"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 403 {object} model.ErrorResponse "시청 등급 제한"
+// @Failure 404 {object} model.ErrorResponse "콘텐츠 없음"
+// @Failure 409 {object} model.ErrorResponse "공개 전 콘텐츠"
+// @Failure 451 {object} model.ErrorResponse "현재 국가에서 이용할 수 없는 콘텐츠"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /contents/{id}/rating [put]
+func handleSetContentRating(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 콘텐츠 ID 파싱
+		contentID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 콘텐츠 ID"})
+			return
+		}
+
+		// 요청 데이터 파싱
+		var req model.UserRatingRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 평가 요청"})
+			return
+		}
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		// 접속 국가의 이용 가능 여부와 프로필 시청 등급 확인
+		if !authorizeContentAccess(c, cfg, db.DB, contentID, c.GetInt64("profileID")) {
+			return
+		}
+
+		ratingService := service.NewRatingService(db.DB)
+		response, err := ratingService.SetRating(c.GetInt64("profileID"), contentID, &req)
+		if err != nil {
+			switch {
+			case errors.Is(err, model.ErrEmptyUserRating):
+				c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			case errors.Is(err, service.ErrContentNotFound):
+				c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			case errors.Is(err, service.ErrContentNotRateable):
+				c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			default:
+				log.Printf("콘텐츠 평가 저장 실패: %v", err)
+				c.JSON(http.StatusInternalServerError, gin.H{"error": "콘텐츠 평가 저장 실패"})
+			}
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    response,
+		})
+	}
+}
+
+// @Summary 콘텐츠 평가 삭제
+// @Description 콘텐츠에 남긴 내 평가 삭제 (인증 필요)
+// @Tags 콘텐츠
+// @Accept json
+// @Produce json
+// @Param id path int true "콘텐츠 ID"
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Success 200 {object} model.ApiResponse{data=model.UserRatingResponse} "갱신된 평가 집계"
+// @Failure 400 {object} model.ErrorResponse "유효하지 않은 콘텐츠 ID"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 404 {object} model.ErrorResponse "평가 기록 없음"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /contents/{id}/rating [delete]
+func handleDeleteContentRating(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 콘텐츠 ID 파싱
+		contentID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 콘텐츠 ID"})
+			return
+		}
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		ratingService := service.NewRatingService(db.DB)
+		response, err := ratingService.DeleteRating(c.GetInt64("profileID"), contentID)
+		if err != nil {
+			if errors.Is(err, service.ErrRatingNotFound) {
+				c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+				return
+			}
+			log.Printf("콘텐츠 평가 삭제 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "콘텐츠 평가 삭제 실패"})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    response,
+		})
+	}
+}
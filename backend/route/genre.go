@@ -6,6 +6,7 @@ import (
 
 	"backend/config"
 	"backend/helper"
+	"backend/model"
 
 	"github.com/gin-gonic/gin"
 )
@@ -19,10 +20,12 @@ func SetupGenreRoutes(router *gin.RouterGroup, cfg *config.Config) {
 }
 
 // @Summary 장르 목록 조회
-// @Description 모든 장르 목록 조회
+// @Description 모든 장르 목록 조회 (lang 쿼리, 프로필 선호 언어, Accept-Language 순으로 번역)
 // @Tags 장르
 // @Accept json
 // @Produce json
+// @Param lang query string false "표시 언어 (예: en, ja)"
+// @Param Accept-Language header string false "선호 언어 목록"
 // @Success 200 {object} model.ArrayResponse{data=[]model.Genre} "장르 목록"
 // @Failure 500 {object} model.ErrorResponse "서버 오류"
 // @Router /genres [get]
@@ -36,30 +39,13 @@ func handleGetAllGenres(cfg *config.Config) gin.HandlerFunc {
 			return
 		}
 
-		// 장르 목록 조회
-		rows, err := db.Query("SELECT id, name, description FROM Genres ORDER BY name")
+		// 장르 목록 조회 (선호 언어로 번역)
+		genres, err := model.GetGenres(db.DB, catalogViewer(c, db.DB).Locales)
 		if err != nil {
 			log.Printf("장르 목록 조회 실패: %v", err)
 			c.JSON(http.StatusInternalServerError, gin.H{"error": "장르 목록 조회 실패"})
 			return
 		}
-		defer rows.Close()
-
-		genres := []gin.H{}
-		for rows.Next() {
-			var id int64
-			var name, description string
-			if err := rows.Scan(&id, &name, &description); err != nil {
-				log.Printf("장르 정보 처리 실패: %v", err)
-				c.JSON(http.StatusInternalServerError, gin.H{"error": "장르 정보 처리 실패"})
-				return
-			}
-			genres = append(genres, gin.H{
-				"id":          id,
-				"name":        name,
-				"description": description,
-			})
-		}
 
 		c.JSON(http.StatusOK, gin.H{
 			"success": true,
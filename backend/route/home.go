@@ -0,0 +1,57 @@
+package route
+
+import (
+	"log"
+	"net/http"
+
+	"backend/config"
+	"backend/helper"
+	"backend/middleware"
+	"backend/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SetupHomeRoutes 홈 화면 관련 라우트 설정
+func SetupHomeRoutes(router *gin.RouterGroup, cfg *config.Config) {
+	router.GET("/home", middleware.OptionalAuthMiddleware(cfg), handleGetHome(cfg))
+}
+
+// @Summary 홈 화면 구성 조회
+// @Description 홈 화면에 표시할 행 목록 조회 (인증 선택, 로그인 여부에 따라 구성이 달라짐)
+// @Tags 홈
+// @Accept json
+// @Produce json
+// @Param Authorization header string false "Bearer JWT 토큰"
+// @Param lang query string false "표시 언어 (없으면 프로필 선호 언어, Accept-Language 순)"
+// @Success 200 {object} model.ApiResponse{data=model.HomeResponse} "홈 화면 행 목록"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /home [get]
+func handleGetHome(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		// 시청자 정보 (로그인한 경우 프로필, 접속 국가, 선호 언어)
+		viewer := catalogViewer(c, db.DB)
+
+		// 홈 화면 구성
+		homeService := service.NewHomeService(db.DB, cfg.Home)
+		home, err := homeService.BuildHome(viewer)
+		if err != nil {
+			log.Printf("홈 화면 구성 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "홈 화면 구성 실패"})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    home,
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package route
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"backend/config"
+	"backend/helper"
+	"backend/model"
+	"backend/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary 소셜 로그인 제공자 목록
+// @Description 설정된 OpenID Connect 소셜 로그인 제공자 목록 조회
+// @Tags 인증
+// @Produce json
+// @Success 200 {object} model.ApiResponse{data=[]model.OIDCProviderResponse} "제공자 목록"
+// @Router /auth/oidc/providers [get]
+func handleOIDCProviders(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		oidcService := service.NewOIDCService(nil, cfg)
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    oidcService.Providers(),
+		})
+	}
+}
+
+// @Summary 소셜 로그인 시작
+// @Description 제공자 로그인 페이지 URL과 상태 토큰 발급 (클라이언트는 상태 토큰을 보관했다가 콜백 요청에 함께 전달)
+// @Tags 인증
+// @Produce json
+// @Param provider path string true "제공자 이름 (예: google, kakao)"
+// @Success 200 {object} model.ApiResponse{data=model.OIDCAuthorizeResponse} "로그인 페이지 URL과 상태 토큰"
+// @Failure 404 {object} model.ErrorResponse "지원하지 않는 제공자"
+// @Failure 502 {object} model.ErrorResponse "제공자 디스커버리 실패"
+// @Router /auth/oidc/{provider}/authorize [get]
+func handleOIDCAuthorize(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		oidcService := service.NewOIDCService(nil, cfg)
+		response, err := oidcService.Authorize(c.Request.Context(), c.Param("provider"))
+		if err != nil {
+			if errors.Is(err, service.ErrOIDCProviderNotFound) {
+				c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+				return
+			}
+			log.Printf("소셜 로그인 시작 실패: %v", err)
+			c.JSON(http.StatusBadGateway, gin.H{"error": "소셜 로그인 제공자에 연결할 수 없습니다"})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    response,
+		})
+	}
+}
+
+// @Summary 소셜 로그인 완료
+// @Description 제공자가 redirect_url로 전달한 code/state와 상태 토큰으로 로그인 (인증된 이메일이 같은 기존 계정에 연결되며, 없으면 새 계정 생성. 2단계 인증 계정은 대기 토큰 발급)
+// @Tags 인증
+// @Accept json
+// @Produce json
+// @Param provider path string true "제공자 이름 (예: google, kakao)"
+// @Param request body model.OIDCCallbackRequest true "인가 코드, state, 상태 토큰"
+// @Success 200 {object} model.ApiResponse{data=model.LoginResponse} "토큰 및 사용자 정보 (2단계 인증 계정은 model.TwoFactorChallengeResponse)"
+// @Failure 400 {object} model.ErrorResponse "유효하지 않은 요청 또는 state 불일치"
+// @Failure 401 {object} model.ErrorResponse "제공자 인증 실패 또는 비활성화된 계정"
+// @Failure 403 {object} model.ErrorResponse "제공자 계정에 인증된 이메일 없음"
+// @Failure 404 {object} model.ErrorResponse "지원하지 않는 제공자"
+// @Failure 409 {object} model.ErrorResponse "같은 이메일의 미인증 계정 존재"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /auth/oidc/{provider}/callback [post]
+func handleOIDCCallback(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 콜백 요청 파싱
+		var req model.OIDCCallbackRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 소셜 로그인 요청"})
+			return
+		}
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		// 제공자 인증 및 계정 연결
+		oidcService := service.NewOIDCService(db.DB, cfg)
+		user, err := oidcService.Authenticate(c.Request.Context(), c.Param("provider"), &req)
+		if err != nil {
+			switch {
+			case errors.Is(err, service.ErrOIDCProviderNotFound):
+				c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			case errors.Is(err, service.ErrOIDCInvalidState):
+				c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			case errors.Is(err, service.ErrOIDCAuthenticationFailed):
+				log.Printf("소셜 로그인 인증 실패: %v", err)
+				c.JSON(http.StatusUnauthorized, gin.H{"error": service.ErrOIDCAuthenticationFailed.Error()})
+			case errors.Is(err, service.ErrOIDCEmailNotVerified):
+				c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+			case errors.Is(err, service.ErrOIDCAccountConflict):
+				c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			default:
+				log.Printf("소셜 로그인 처리 실패: %v", err)
+				c.JSON(http.StatusInternalServerError, gin.H{"error": "소셜 로그인 처리 실패"})
+			}
+			return
+		}
+
+		if !user.IsActive {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "비활성화된 계정입니다"})
+			return
+		}
+
+		beginLogin(c, cfg, db.DB, user, req.DeviceName)
+	}
+}
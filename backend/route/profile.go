@@ -0,0 +1,387 @@
+package route
+
+import (
+	"database/sql"
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+
+	"backend/config"
+	"backend/helper"
+	"backend/middleware"
+	"backend/model"
+	"backend/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// parentalPINHeader 보호자 PIN 전달 헤더
+const parentalPINHeader = "X-Parental-PIN"
+
+// SetupProfileRoutes 시청자 프로필 관련 라우트 설정
+func SetupProfileRoutes(router *gin.RouterGroup, cfg *config.Config) {
+	profileRoutes := router.Group("/profiles")
+	profileRoutes.Use(middleware.AuthMiddleware(cfg))
+	{
+		profileRoutes.GET("", handleGetProfiles(cfg))
+		profileRoutes.POST("", handleCreateProfile(cfg))
+		profileRoutes.PUT("/:id", handleUpdateProfile(cfg))
+		profileRoutes.DELETE("/:id", handleDeleteProfile(cfg))
+		profileRoutes.POST("/:id/select", handleSelectProfile(cfg))
+	}
+}
+
+// @Summary 프로필 목록 조회
+// @Description 계정에 속한 시청자 프로필 목록 조회 (인증 필요)
+// @Tags 프로필
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Success 200 {object} model.ArrayResponse{data=[]model.Profile} "프로필 목록"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /profiles [get]
+func handleGetProfiles(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		// 사용자 ID 가져오기
+		userID := c.GetInt64("userID")
+
+		// 프로필 목록 조회
+		profileService := newProfileService(db.DB, cfg)
+		profiles, err := profileService.ListProfiles(userID)
+		if err != nil {
+			log.Printf("프로필 목록 조회 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "프로필 목록 조회 실패"})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    profiles,
+		})
+	}
+}
+
+// @Summary 프로필 생성
+// @Description 계정에 새 시청자 프로필 추가 (인증 필요, 계정당 최대 프로필 수 제한)
+// @Tags 프로필
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Param X-Parental-PIN header string false "보호자 PIN (키즈 프로필에서 요청 시)"
+// @Param profile body model.ProfileRequest true "프로필 정보"
+// @Success 201 {object} model.ApiResponse{data=model.Profile} "생성된 프로필"
+// @Failure 400 {object} model.ErrorResponse "유효하지 않은 요청"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 403 {object} model.ErrorResponse "보호자 PIN 필요"
+// @Failure 409 {object} model.ErrorResponse "최대 프로필 수 초과"
+// @Failure 429 {object} model.ErrorResponse "보호자 PIN 반복 실패로 일시 제한"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /profiles [post]
+func handleCreateProfile(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 프로필 생성 요청 파싱
+		var req model.ProfileRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			log.Printf("프로필 생성 요청 파싱 실패: %v", err)
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 요청"})
+			return
+		}
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		// 사용자 ID 가져오기
+		userID := c.GetInt64("userID")
+
+		// 키즈 프로필에서는 보호자 PIN 확인
+		profileService := newProfileService(db.DB, cfg)
+		if err := profileService.AuthorizeFromKidsProfile(userID, c.GetInt64("profileID"), c.GetHeader(parentalPINHeader)); err != nil {
+			if !writeParentalControlError(c, err) {
+				log.Printf("보호자 권한 확인 실패: %v", err)
+				c.JSON(http.StatusInternalServerError, gin.H{"error": "보호자 권한 확인 실패"})
+			}
+			return
+		}
+
+		// 프로필 생성
+		profile, err := profileService.CreateProfile(userID, &req)
+		if err != nil {
+			if errors.Is(err, service.ErrProfileLimitExceeded) {
+				c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+				return
+			}
+			log.Printf("프로필 생성 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "프로필 생성 실패"})
+			return
+		}
+
+		c.JSON(http.StatusCreated, gin.H{
+			"success": true,
+			"data":    profile,
+		})
+	}
+}
+
+// @Summary 프로필 수정
+// @Description 시청자 프로필 이름/아바타/언어/시청 등급 수정 (인증 필요)
+// @Tags 프로필
+// @Accept json
+// @Produce json
+// @Param id path int true "프로필 ID"
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Param X-Parental-PIN header string false "보호자 PIN (키즈 프로필에서 요청 시)"
+// @Param profile body model.ProfileRequest true "프로필 정보"
+// @Success 200 {object} model.ApiResponse{data=model.Profile} "수정된 프로필"
+// @Failure 400 {object} model.ErrorResponse "유효하지 않은 요청"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 403 {object} model.ErrorResponse "보호자 PIN 필요"
+// @Failure 404 {object} model.ErrorResponse "프로필 없음"
+// @Failure 429 {object} model.ErrorResponse "보호자 PIN 반복 실패로 일시 제한"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /profiles/{id} [put]
+func handleUpdateProfile(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 프로필 ID 파싱
+		profileID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 프로필 ID"})
+			return
+		}
+
+		// 프로필 수정 요청 파싱
+		var req model.ProfileRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			log.Printf("프로필 수정 요청 파싱 실패: %v", err)
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 요청"})
+			return
+		}
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		// 사용자 ID 가져오기
+		userID := c.GetInt64("userID")
+
+		// 키즈 프로필에서는 보호자 PIN 확인
+		profileService := newProfileService(db.DB, cfg)
+		if err := profileService.AuthorizeFromKidsProfile(userID, c.GetInt64("profileID"), c.GetHeader(parentalPINHeader)); err != nil {
+			if !writeParentalControlError(c, err) {
+				log.Printf("보호자 권한 확인 실패: %v", err)
+				c.JSON(http.StatusInternalServerError, gin.H{"error": "보호자 권한 확인 실패"})
+			}
+			return
+		}
+
+		// 프로필 수정
+		profile, err := profileService.UpdateProfile(userID, profileID, &req)
+		if err != nil {
+			if errors.Is(err, service.ErrProfileNotFound) {
+				c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+				return
+			}
+			log.Printf("프로필 수정 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "프로필 수정 실패"})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    profile,
+		})
+	}
+}
+
+// @Summary 프로필 삭제
+// @Description 시청자 프로필과 해당 프로필의 시청 기록/찜 목록 삭제 (인증 필요, 기본 프로필 및 사용 중인 프로필은 삭제 불가)
+// @Tags 프로필
+// @Accept json
+// @Produce json
+// @Param id path int true "프로필 ID"
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Param X-Parental-PIN header string false "보호자 PIN (키즈 프로필에서 요청 시)"
+// @Success 200 {object} model.ApiResponse "삭제 성공"
+// @Failure 400 {object} model.ErrorResponse "삭제할 수 없는 프로필"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 403 {object} model.ErrorResponse "보호자 PIN 필요"
+// @Failure 404 {object} model.ErrorResponse "프로필 없음"
+// @Failure 429 {object} model.ErrorResponse "보호자 PIN 반복 실패로 일시 제한"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /profiles/{id} [delete]
+func handleDeleteProfile(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 프로필 ID 파싱
+		profileID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 프로필 ID"})
+			return
+		}
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		// 사용자 ID 및 현재 프로필 ID 가져오기
+		userID := c.GetInt64("userID")
+		currentProfileID := c.GetInt64("profileID")
+
+		// 키즈 프로필에서는 보호자 PIN 확인
+		profileService := newProfileService(db.DB, cfg)
+		if err := profileService.AuthorizeFromKidsProfile(userID, currentProfileID, c.GetHeader(parentalPINHeader)); err != nil {
+			if !writeParentalControlError(c, err) {
+				log.Printf("보호자 권한 확인 실패: %v", err)
+				c.JSON(http.StatusInternalServerError, gin.H{"error": "보호자 권한 확인 실패"})
+			}
+			return
+		}
+
+		// 프로필 삭제
+		if err := profileService.DeleteProfile(userID, profileID, currentProfileID); err != nil {
+			switch {
+			case errors.Is(err, service.ErrProfileNotFound):
+				c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			case errors.Is(err, service.ErrDefaultProfileDelete), errors.Is(err, service.ErrCurrentProfileDelete):
+				c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			default:
+				log.Printf("프로필 삭제 실패: %v", err)
+				c.JSON(http.StatusInternalServerError, gin.H{"error": "프로필 삭제 실패"})
+			}
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    gin.H{"message": "프로필이 삭제되었습니다"},
+		})
+	}
+}
+
+// @Summary 프로필 선택
+// @Description 시청할 프로필을 선택하고 해당 프로필 전용 토큰 발급 (인증 필요)
+// @Tags 프로필
+// @Accept json
+// @Produce json
+// @Param id path int true "프로필 ID"
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Param X-Parental-PIN header string false "보호자 PIN (키즈 프로필에서 요청 시)"
+// @Success 200 {object} model.ApiResponse{data=model.ProfileSelectResponse} "프로필 전용 토큰 및 프로필 정보"
+// @Failure 400 {object} model.ErrorResponse "유효하지 않은 프로필 ID"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 403 {object} model.ErrorResponse "보호자 PIN 필요"
+// @Failure 404 {object} model.ErrorResponse "프로필 없음"
+// @Failure 429 {object} model.ErrorResponse "보호자 PIN 반복 실패로 일시 제한"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /profiles/{id}/select [post]
+func handleSelectProfile(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 프로필 ID 파싱
+		profileID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 프로필 ID"})
+			return
+		}
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		// 사용자 정보 가져오기
+		userID := c.GetInt64("userID")
+
+		// 계정에 속한 프로필인지 확인
+		profileService := newProfileService(db.DB, cfg)
+		profile, err := profileService.GetProfile(userID, profileID)
+		if err != nil {
+			if errors.Is(err, service.ErrProfileNotFound) {
+				c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+				return
+			}
+			log.Printf("프로필 조회 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "프로필 조회 실패"})
+			return
+		}
+
+		// 키즈 프로필에서 일반 프로필로 전환 시 보호자 PIN 확인
+		if err := profileService.AuthorizeProfileSwitch(userID, c.GetInt64("profileID"), profile, c.GetHeader(parentalPINHeader)); err != nil {
+			if !writeParentalControlError(c, err) {
+				log.Printf("보호자 권한 확인 실패: %v", err)
+				c.JSON(http.StatusInternalServerError, gin.H{"error": "보호자 권한 확인 실패"})
+			}
+			return
+		}
+
+		// 현재 세션의 프로필 전환 및 프로필 전용 토큰 생성
+		authService := service.NewAuthService(db.DB, cfg)
+		accessToken, err := authService.SwitchProfile(c.GetString("tokenID"), c.GetTime("tokenExpiresAt"),
+			userID, profile.ID, c.GetString("userName"), c.GetString("userEmail"))
+		if err != nil {
+			if errors.Is(err, service.ErrSessionNotFound) {
+				c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+				return
+			}
+			log.Printf("토큰 생성 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "토큰 생성 실패"})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data": model.ProfileSelectResponse{
+				Token:   accessToken.Token,
+				Profile: *profile,
+			},
+		})
+	}
+}
+
+// newProfileService 보호자 PIN 연속 실패 제한을 적용한 ProfileService 생성
+func newProfileService(db *sql.DB, cfg *config.Config) *service.ProfileService {
+	profileService := service.NewProfileService(db, cfg.MaxProfilesPerUser)
+	profileService.Guard = service.NewLoginGuardService(db, helper.GetAttemptStore(cfg), cfg.LoginProtection)
+	return profileService
+}
+
+// writeParentalControlError 보호자 통제 관련 오류 응답 (처리한 경우 true 반환)
+func writeParentalControlError(c *gin.Context, err error) bool {
+	var exceeded *service.AttemptsExceededError
+	switch {
+	case errors.As(err, &exceeded):
+		writeAttemptsExceeded(c, exceeded)
+	case errors.Is(err, service.ErrMaturityRestricted),
+		errors.Is(err, service.ErrParentalPINRequired),
+		errors.Is(err, service.ErrInvalidParentalPIN):
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	case errors.Is(err, service.ErrProfileNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	default:
+		return false
+	}
+	return true
+}
@@ -0,0 +1,487 @@
+package route
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+
+	"backend/config"
+	"backend/helper"
+	"backend/middleware"
+	"backend/model"
+	"backend/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// reviewPageSizeLimit 리뷰 목록 페이지당 최대 항목 수
+const reviewPageSizeLimit = 50
+
+// SetupReviewRoutes 리뷰 추천/신고 라우트 설정 (콘텐츠별 리뷰 작성/조회는 콘텐츠 라우트에 등록)
+func SetupReviewRoutes(router *gin.RouterGroup, cfg *config.Config) {
+	reviewRoutes := router.Group("/reviews")
+	reviewRoutes.Use(middleware.AuthMiddleware(cfg))
+	{
+		reviewRoutes.PUT("/:id/vote", handleVoteReview(cfg))
+		reviewRoutes.DELETE("/:id/vote", handleDeleteReviewVote(cfg))
+		reviewRoutes.POST("/:id/report", handleReportReview(cfg))
+	}
+}
+
+// respondReviewError 리뷰 처리 오류를 상태 코드로 변환하여 응답
+func respondReviewError(c *gin.Context, err error, action string) {
+	switch {
+	case errors.Is(err, service.ErrReviewEmpty), errors.Is(err, service.ErrReviewProfanity),
+		errors.Is(err, service.ErrInvalidReviewAction):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case errors.Is(err, service.ErrReviewBanned), errors.Is(err, model.ErrOwnReview):
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	case errors.Is(err, service.ErrContentNotFound), errors.Is(err, service.ErrReviewNotFound),
+		errors.Is(err, model.ErrReviewVoteNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, service.ErrContentNotReviewable), errors.Is(err, model.ErrReviewExists),
+		errors.Is(err, model.ErrReviewAlreadyReported):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		log.Printf("리뷰 %s 실패: %v", action, err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "리뷰 " + action + " 실패"})
+	}
+}
+
+// @Summary 콘텐츠 리뷰 목록 조회
+// @Description 콘텐츠의 공개 리뷰 목록 조회 (인증 선택, 로그인 시 내 추천과 내 리뷰 여부 포함)
+// @Tags 리뷰
+// @Produce json
+// @Param id path int true "콘텐츠 ID"
+// @Param Authorization header string false "Bearer JWT 토큰"
+// @Param page query int false "페이지 번호 (기본값: 0)"
+// @Param size query int false "페이지당 항목 수 (기본값: 10, 최대 50)"
+// @Param sort query string false "정렬 (latest: 최신순(기본값), helpful: 도움이 됐어요 순, votes: 추천 참여 수 순)"
+// @Param X-Parental-PIN header string false "보호자 PIN (프로필 시청 등급을 초과하는 콘텐츠인 경우)"
+// @Success 200 {object} model.PagingResponse{data=model.PageInfo{content=[]model.Review}} "리뷰 목록"
+// @Failure 400 {object} model.ErrorResponse "잘못된 요청"
+// @Failure 403 {object} model.ErrorResponse "시청 등급 제한"
+// @Failure 404 {object} model.ErrorResponse "콘텐츠 없음"
+// @Failure 451 {object} model.ErrorResponse "현재 국가에서 이용할 수 없는 콘텐츠"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /contents/{id}/reviews [get]
+func handleGetContentReviews(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 콘텐츠 ID 파싱
+		contentID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 콘텐츠 ID"})
+			return
+		}
+
+		// 페이징 파라미터 처리
+		page, err := strconv.Atoi(c.DefaultQuery("page", "0"))
+		if err != nil || page < 0 {
+			page = 0
+		}
+		size, err := strconv.Atoi(c.DefaultQuery("size", "10"))
+		if err != nil || size <= 0 {
+			size = 10
+		}
+		if size > reviewPageSizeLimit {
+			size = reviewPageSizeLimit
+		}
+
+		// 정렬 파라미터 처리
+		sort := c.Query("sort")
+		if !model.IsValidReviewSort(sort) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 정렬 옵션"})
+			return
+		}
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		// 로그인한 경우에만 사용자 ID와 프로필 ID 사용
+		var userID, profileID int64
+		if isAuthenticated, exists := c.Get("isAuthenticated"); exists && isAuthenticated.(bool) {
+			userID = c.GetInt64("userID")
+			profileID = c.GetInt64("profileID")
+		}
+
+		// 접속 국가의 이용 가능 여부와 프로필 시청 등급 확인
+		if !authorizeContentAccess(c, cfg, db.DB, contentID, profileID) {
+			return
+		}
+
+		reviewService := service.NewReviewService(db.DB, cfg)
+		pageInfo, err := reviewService.GetReviews(contentID, userID, page, size, sort)
+		if err != nil {
+			log.Printf("리뷰 목록 조회 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "리뷰 목록 조회 실패"})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    pageInfo,
+		})
+	}
+}
+
+// @Summary 리뷰 작성
+// @Description 콘텐츠에 리뷰 작성 (계정당 콘텐츠별 1건, 금칙어가 포함되면 거부되고 스팸 의심 리뷰는 관리자 검토 후 공개, 인증 필요)
+// @Tags 리뷰
+// @Accept json
+// @Produce json
+// @Param id path int true "콘텐츠 ID"
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Param X-Parental-PIN header string false "보호자 PIN (프로필 시청 등급을 초과하는 콘텐츠인 경우)"
+// @Param request body model.ReviewRequest true "리뷰 내용"
+// @Success 201 {object} model.ApiResponse{data=model.Review} "작성된 리뷰 (status가 pending이면 검토 대기)"
+// @Failure 400 {object} model.ErrorResponse "유효하지 않은 요청 또는 금칙어 포함"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 403 {object} model.ErrorResponse "리뷰 작성이 제한된 계정 또는 시청 등급 제한"
+// @Failure 404 {object} model.ErrorResponse "콘텐츠 없음"
+// @Failure 409 {object} model.ErrorResponse "이미 작성한 리뷰가 있거나 공개 전 콘텐츠"
+// @Failure 451 {object} model.ErrorResponse "현재 국가에서 이용할 수 없는 콘텐츠"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /contents/{id}/reviews [post]
+func handleCreateReview(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 콘텐츠 ID 파싱
+		contentID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 콘텐츠 ID"})
+			return
+		}
+
+		// 요청 데이터 파싱
+		var req model.ReviewRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 리뷰 요청"})
+			return
+		}
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		// 접속 국가의 이용 가능 여부와 프로필 시청 등급 확인
+		if !authorizeContentAccess(c, cfg, db.DB, contentID, c.GetInt64("profileID")) {
+			return
+		}
+
+		reviewService := service.NewReviewService(db.DB, cfg)
+		review, err := reviewService.CreateReview(c.GetInt64("userID"), c.GetInt64("profileID"), contentID, &req)
+		if err != nil {
+			respondReviewError(c, err, "작성")
+			return
+		}
+
+		c.JSON(http.StatusCreated, gin.H{
+			"success": true,
+			"data":    review,
+		})
+	}
+}
+
+// @Summary 내 리뷰 조회
+// @Description 콘텐츠에 작성한 내 리뷰 조회 (검토 대기/숨김 상태 포함, 인증 필요)
+// @Tags 리뷰
+// @Produce json
+// @Param id path int true "콘텐츠 ID"
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Success 200 {object} model.ApiResponse{data=model.Review} "내 리뷰"
+// @Failure 400 {object} model.ErrorResponse "유효하지 않은 콘텐츠 ID"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 404 {object} model.ErrorResponse "리뷰 없음"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /contents/{id}/reviews/mine [get]
+func handleGetMyReview(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 콘텐츠 ID 파싱
+		contentID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 콘텐츠 ID"})
+			return
+		}
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		reviewService := service.NewReviewService(db.DB, cfg)
+		review, err := reviewService.GetMyReview(c.GetInt64("userID"), contentID)
+		if err != nil {
+			respondReviewError(c, err, "조회")
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    review,
+		})
+	}
+}
+
+// @Summary 내 리뷰 수정
+// @Description 콘텐츠에 작성한 내 리뷰 수정 (공개 리뷰가 스팸 의심 내용으로 수정되면 검토 대기로 전환, 인증 필요)
+// @Tags 리뷰
+// @Accept json
+// @Produce json
+// @Param id path int true "콘텐츠 ID"
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Param X-Parental-PIN header string false "보호자 PIN (프로필 시청 등급을 초과하는 콘텐츠인 경우)"
+// @Param request body model.ReviewRequest true "리뷰 내용"
+// @Success 200 {object} model.ApiResponse{data=model.Review} "수정된 리뷰"
+// @Failure 400 {object} model.ErrorResponse "유효하지 않은 요청 또는 금칙어 포함"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 403 {object} model.ErrorResponse "리뷰 작성이 제한된 계정 또는 시청 등급 제한"
+// @Failure 404 {object} model.ErrorResponse "콘텐츠 또는 리뷰 없음"
+// @Failure 451 {object} model.ErrorResponse "현재 국가에서 이용할 수 없는 콘텐츠"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /contents/{id}/reviews/mine [put]
+func handleUpdateMyReview(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 콘텐츠 ID 파싱
+		contentID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 콘텐츠 ID"})
+			return
+		}
+
+		// 요청 데이터 파싱
+		var req model.ReviewRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 리뷰 요청"})
+			return
+		}
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		// 접속 국가의 이용 가능 여부와 프로필 시청 등급 확인
+		if !authorizeContentAccess(c, cfg, db.DB, contentID, c.GetInt64("profileID")) {
+			return
+		}
+
+		reviewService := service.NewReviewService(db.DB, cfg)
+		review, err := reviewService.UpdateReview(c.GetInt64("userID"), contentID, &req)
+		if err != nil {
+			respondReviewError(c, err, "수정")
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    review,
+		})
+	}
+}
+
+// @Summary 내 리뷰 삭제
+// @Description 콘텐츠에 작성한 내 리뷰 삭제 (인증 필요)
+// @Tags 리뷰
+// @Produce json
+// @Param id path int true "콘텐츠 ID"
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Success 200 {object} model.ApiResponse "삭제 성공"
+// @Failure 400 {object} model.ErrorResponse "유효하지 않은 콘텐츠 ID"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 404 {object} model.ErrorResponse "리뷰 없음"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /contents/{id}/reviews/mine [delete]
+func handleDeleteMyReview(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 콘텐츠 ID 파싱
+		contentID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 콘텐츠 ID"})
+			return
+		}
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		reviewService := service.NewReviewService(db.DB, cfg)
+		if err := reviewService.DeleteReview(c.GetInt64("userID"), contentID); err != nil {
+			respondReviewError(c, err, "삭제")
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    gin.H{"message": "리뷰가 삭제되었습니다"},
+		})
+	}
+}
+
+// @Summary 리뷰 추천
+// @Description 리뷰가 도움이 됐는지 추천 (기존 추천은 교체, 본인 리뷰는 불가, 인증 필요)
+// @Tags 리뷰
+// @Accept json
+// @Produce json
+// @Param id path int true "리뷰 ID"
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Param request body model.ReviewVoteRequest true "추천"
+// @Success 200 {object} model.ApiResponse{data=model.ReviewVoteResponse} "갱신된 추천 수"
+// @Failure 400 {object} model.ErrorResponse "유효하지 않은 요청"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 403 {object} model.ErrorResponse "본인 리뷰"
+// @Failure 404 {object} model.ErrorResponse "리뷰 없음"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /reviews/{id}/vote [put]
+func handleVoteReview(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 리뷰 ID 파싱
+		reviewID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 리뷰 ID"})
+			return
+		}
+
+		// 요청 데이터 파싱
+		var req model.ReviewVoteRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 추천 요청"})
+			return
+		}
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		reviewService := service.NewReviewService(db.DB, cfg)
+		response, err := reviewService.Vote(c.GetInt64("userID"), reviewID, *req.Helpful)
+		if err != nil {
+			respondReviewError(c, err, "추천")
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    response,
+		})
+	}
+}
+
+// @Summary 리뷰 추천 취소
+// @Description 리뷰에 남긴 내 추천 취소 (인증 필요)
+// @Tags 리뷰
+// @Produce json
+// @Param id path int true "리뷰 ID"
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Success 200 {object} model.ApiResponse{data=model.ReviewVoteResponse} "갱신된 추천 수"
+// @Failure 400 {object} model.ErrorResponse "유효하지 않은 리뷰 ID"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 404 {object} model.ErrorResponse "리뷰 또는 추천 기록 없음"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /reviews/{id}/vote [delete]
+func handleDeleteReviewVote(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 리뷰 ID 파싱
+		reviewID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 리뷰 ID"})
+			return
+		}
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		reviewService := service.NewReviewService(db.DB, cfg)
+		response, err := reviewService.DeleteVote(c.GetInt64("userID"), reviewID)
+		if err != nil {
+			respondReviewError(c, err, "추천 취소")
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    response,
+		})
+	}
+}
+
+// @Summary 리뷰 신고
+// @Description 리뷰 신고 (계정당 리뷰별 1회, 처리되지 않은 신고가 일정 수 이상 쌓이면 관리자 검토 전까지 숨김, 인증 필요)
+// @Tags 리뷰
+// @Accept json
+// @Produce json
+// @Param id path int true "리뷰 ID"
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Param request body model.ReviewReportRequest true "신고 사유"
+// @Success 201 {object} model.ApiResponse{data=model.ReviewReportResponse} "신고 접수"
+// @Failure 400 {object} model.ErrorResponse "유효하지 않은 요청"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 403 {object} model.ErrorResponse "본인 리뷰"
+// @Failure 404 {object} model.ErrorResponse "리뷰 없음"
+// @Failure 409 {object} model.ErrorResponse "이미 신고한 리뷰"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /reviews/{id}/report [post]
+func handleReportReview(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 리뷰 ID 파싱
+		reviewID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 리뷰 ID"})
+			return
+		}
+
+		// 요청 데이터 파싱
+		var req model.ReviewReportRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 신고 요청"})
+			return
+		}
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		reviewService := service.NewReviewService(db.DB, cfg)
+		response, err := reviewService.Report(c.GetInt64("userID"), reviewID, &req)
+		if err != nil {
+			respondReviewError(c, err, "신고")
+			return
+		}
+
+		c.JSON(http.StatusCreated, gin.H{
+			"success": true,
+			"data":    response,
+		})
+	}
+}
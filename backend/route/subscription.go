@@ -0,0 +1,85 @@
+package route
+
+import (
+	"log"
+	"net/http"
+
+	"backend/config"
+	"backend/helper"
+	"backend/middleware"
+	"backend/model"
+	"backend/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SetupSubscriptionRoutes 요금제 및 구독 관련 라우트 설정
+func SetupSubscriptionRoutes(router *gin.RouterGroup, cfg *config.Config) {
+	router.GET("/plans", handleGetPlans(cfg))
+	router.GET("/users/subscription", middleware.AuthMiddleware(cfg), handleGetSubscription(cfg))
+}
+
+// @Summary 요금제 목록 조회
+// @Description 요금제별 최대 화질, 동시 시청 수, 저장 가능 수, 월 요금 조회
+// @Tags 구독
+// @Produce json
+// @Success 200 {object} model.ApiResponse{data=[]model.Plan} "요금제 목록"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /plans [get]
+func handleGetPlans(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		plans, err := model.GetPlans(db.DB)
+		if err != nil {
+			log.Printf("요금제 목록 조회 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "요금제 목록 조회 실패"})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    plans,
+		})
+	}
+}
+
+// @Summary 내 구독 조회
+// @Description 계정의 최근 구독 조회 (만료된 구독 포함, 구독한 적이 없으면 data는 null, 인증 필요)
+// @Tags 구독
+// @Produce json
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Success 200 {object} model.ApiResponse{data=model.Subscription} "구독 정보"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /users/subscription [get]
+func handleGetSubscription(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		subscriptionService := service.NewSubscriptionService(db.DB)
+		subscription, err := subscriptionService.GetCurrent(c.GetInt64("userID"))
+		if err != nil {
+			log.Printf("구독 조회 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "구독 조회 실패"})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    subscription,
+		})
+	}
+}
@@ -0,0 +1,180 @@
+package route
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"backend/config"
+	"backend/helper"
+	"backend/middleware"
+	"backend/model"
+	"backend/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SetupTwoFactorRoutes 2단계 인증 관련 라우트 설정
+func SetupTwoFactorRoutes(router *gin.RouterGroup, cfg *config.Config) {
+	twoFactorRoutes := router.Group("/users/two-factor")
+	twoFactorRoutes.Use(middleware.AuthMiddleware(cfg))
+	{
+		twoFactorRoutes.POST("/enroll", handleEnrollTwoFactor(cfg))
+		twoFactorRoutes.POST("/confirm", handleConfirmTwoFactor(cfg))
+		twoFactorRoutes.DELETE("", handleDisableTwoFactor(cfg))
+	}
+}
+
+// @Summary 2단계 인증 등록 시작
+// @Description 인증 앱에 등록할 TOTP 비밀 키와 otpauth URI 발급 (코드 확인 전까지 비활성, 인증 필요)
+// @Tags 사용자
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Success 200 {object} model.ApiResponse{data=model.TwoFactorEnrollResponse} "비밀 키와 otpauth URI"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 409 {object} model.ErrorResponse "이미 활성화됨"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /users/two-factor/enroll [post]
+func handleEnrollTwoFactor(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		// 사용자 조회
+		user, err := model.GetUserByID(db.DB, c.GetInt64("userID"))
+		if err != nil {
+			log.Printf("사용자 정보 조회 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "사용자 정보 조회 실패"})
+			return
+		}
+
+		// 등록 시작
+		twoFactorService := service.NewTwoFactorService(db.DB)
+		enrollment, err := twoFactorService.Enroll(user)
+		if err != nil {
+			if errors.Is(err, service.ErrTwoFactorAlreadyEnabled) {
+				c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+				return
+			}
+			log.Printf("2단계 인증 등록 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "2단계 인증 등록 실패"})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    enrollment,
+		})
+	}
+}
+
+// @Summary 2단계 인증 활성화
+// @Description 인증 앱에 표시된 코드를 확인하여 2단계 인증을 활성화하고 복구 코드 발급 (복구 코드는 이 응답에서만 확인 가능, 인증 필요)
+// @Tags 사용자
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Param request body model.TwoFactorCodeRequest true "인증 코드"
+// @Success 200 {object} model.ApiResponse{data=model.RecoveryCodesResponse} "복구 코드"
+// @Failure 400 {object} model.ErrorResponse "잘못된 코드 또는 등록 전"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 409 {object} model.ErrorResponse "이미 활성화됨"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /users/two-factor/confirm [post]
+func handleConfirmTwoFactor(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 요청 파싱
+		var req model.TwoFactorCodeRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "인증 코드가 필요합니다"})
+			return
+		}
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		// 코드 확인 및 활성화
+		twoFactorService := service.NewTwoFactorService(db.DB)
+		codes, err := twoFactorService.Confirm(c.GetInt64("userID"), req.Code)
+		if err != nil {
+			switch {
+			case errors.Is(err, service.ErrTwoFactorAlreadyEnabled):
+				c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			case errors.Is(err, service.ErrInvalidTwoFactorCode), errors.Is(err, service.ErrTwoFactorNotEnrolled):
+				c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			default:
+				log.Printf("2단계 인증 활성화 실패: %v", err)
+				c.JSON(http.StatusInternalServerError, gin.H{"error": "2단계 인증 활성화 실패"})
+			}
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    model.RecoveryCodesResponse{RecoveryCodes: codes},
+		})
+	}
+}
+
+// @Summary 2단계 인증 해제
+// @Description 계정 비밀번호와 인증 코드(또는 복구 코드)를 확인하여 2단계 인증 해제 (인증 필요)
+// @Tags 사용자
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Param request body model.TwoFactorDisableRequest true "비밀번호와 인증 코드"
+// @Success 200 {object} model.ApiResponse "해제 성공"
+// @Failure 400 {object} model.ErrorResponse "유효하지 않은 요청 또는 잘못된 코드"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 403 {object} model.ErrorResponse "비밀번호 불일치"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /users/two-factor [delete]
+func handleDisableTwoFactor(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 요청 파싱
+		var req model.TwoFactorDisableRequest
+		if err := c.ShouldBindJSON(&req); err != nil || (req.Code == "" && req.RecoveryCode == "") {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "비밀번호와 인증 코드가 필요합니다"})
+			return
+		}
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		// 2단계 인증 해제
+		twoFactorService := service.NewTwoFactorService(db.DB)
+		if err := twoFactorService.Disable(c.GetInt64("userID"), &req); err != nil {
+			switch {
+			case errors.Is(err, service.ErrInvalidPassword):
+				c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+			case errors.Is(err, service.ErrInvalidTwoFactorCode), errors.Is(err, service.ErrTwoFactorNotEnabled):
+				c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			default:
+				log.Printf("2단계 인증 해제 실패: %v", err)
+				c.JSON(http.StatusInternalServerError, gin.H{"error": "2단계 인증 해제 실패"})
+			}
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    gin.H{"message": "2단계 인증이 해제되었습니다"},
+		})
+	}
+}
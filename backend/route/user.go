@@ -2,8 +2,11 @@ package route
 
 import (
 	"database/sql"
+	"errors"
 	"log"
 	"net/http"
+	"strconv"
+	"time"
 
 	"backend/config"
 	"backend/helper"
@@ -21,7 +24,16 @@ func SetupUserRoutes(router *gin.RouterGroup, cfg *config.Config) {
 	{
 		userRoutes.GET("/profile", handleGetUserProfile(cfg))
 		userRoutes.PUT("/profile", handleUpdateUserProfile(cfg))
+		userRoutes.PUT("/parental-pin", handleSetParentalPIN(cfg))
 		userRoutes.GET("/viewing-history", handleGetViewingHistory(cfg))
+		userRoutes.GET("/recommendations", handleGetRecommendations(cfg))
+		userRoutes.GET("/sessions", handleGetSessions(cfg))
+		userRoutes.DELETE("/sessions", handleRevokeAllSessions(cfg))
+		userRoutes.DELETE("/sessions/:id", handleRevokeSession(cfg))
+		userRoutes.POST("/export", handleRequestDataExport(cfg))
+		userRoutes.GET("/export/:id", handleGetDataExport(cfg))
+		userRoutes.GET("/export/:id/download", handleDownloadDataExport(cfg))
+		userRoutes.DELETE("/me", handleDeleteAccount(cfg))
 	}
 }
 
@@ -68,14 +80,14 @@ func handleGetUserProfile(cfg *config.Config) gin.HandlerFunc {
 }
 
 // @Summary 사용자 프로필 업데이트
-// @Description 로그인한 사용자의 프로필 정보 업데이트 (인증 필요)
+// @Description 로그인한 사용자의 프로필 정보 업데이트 (인증 필요, 비밀번호 변경 시 모든 기기에서 로그아웃됨)
 // @Tags 사용자
 // @Accept json
 // @Produce json
 // @Param Authorization header string true "Bearer JWT 토큰"
 // @Param request body model.UpdateProfileRequest true "업데이트할 프로필 정보"
 // @Success 200 {object} model.ApiResponse{data=model.UserResponse} "업데이트된 사용자 정보"
-// @Failure 400 {object} model.ErrorResponse "유효하지 않은 요청"
+// @Failure 400 {object} model.ValidationErrorResponse "유효하지 않은 요청 또는 비밀번호 정책 위반"
 // @Failure 401 {object} model.ErrorResponse "인증 필요"
 // @Failure 500 {object} model.ErrorResponse "서버 오류"
 // @Router /users/profile [put]
@@ -92,6 +104,22 @@ func handleUpdateUserProfile(cfg *config.Config) gin.HandlerFunc {
 			return
 		}
 
+		// 새 비밀번호 정책 검사 (이름을 함께 바꾸면 바뀐 이름 기준)
+		if updateRequest.NewPassword != "" {
+			name := updateRequest.Name
+			if name == "" {
+				name = c.GetString("userName")
+			}
+			policy := service.NewPasswordPolicy(cfg)
+			if err := policy.Validate("new_password", updateRequest.NewPassword, c.GetString("userEmail"), name); err != nil {
+				if !writePasswordPolicyError(c, err) {
+					log.Printf("비밀번호 정책 검사 실패: %v", err)
+					c.JSON(http.StatusInternalServerError, gin.H{"error": "사용자 정보 업데이트 실패"})
+				}
+				return
+			}
+		}
+
 		// 데이터베이스 연결
 		db, err := helper.GetDB(cfg)
 		if err != nil {
@@ -130,13 +158,68 @@ func handleUpdateUserProfile(cfg *config.Config) gin.HandlerFunc {
 	}
 }
 
+// @Summary 보호자 PIN 설정
+// @Description 키즈 프로필 전환 및 시청 등급 초과 콘텐츠 재생에 사용할 보호자 PIN 설정 (인증 필요, 계정 비밀번호 확인)
+// @Tags 사용자
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Param request body model.ParentalPINRequest true "보호자 PIN 정보"
+// @Success 200 {object} model.ApiResponse "설정 성공"
+// @Failure 400 {object} model.ErrorResponse "유효하지 않은 요청"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 403 {object} model.ErrorResponse "비밀번호 불일치"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /users/parental-pin [put]
+func handleSetParentalPIN(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 사용자 ID 가져오기
+		userID := c.GetInt64("userID")
+
+		// 보호자 PIN 설정 요청 파싱
+		var req model.ParentalPINRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "보호자 PIN은 숫자 4~6자리여야 합니다"})
+			return
+		}
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		// 보호자 PIN 설정
+		profileService := newProfileService(db.DB, cfg)
+		if err := profileService.SetParentalPIN(userID, &req); err != nil {
+			if errors.Is(err, service.ErrInvalidPassword) {
+				c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+				return
+			}
+			log.Printf("보호자 PIN 설정 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "보호자 PIN 설정 실패"})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    gin.H{"message": "보호자 PIN이 설정되었습니다"},
+		})
+	}
+}
+
 // @Summary 시청 기록 조회
 // @Description 로그인한 사용자의 시청 기록 조회 (인증 필요)
 // @Tags 사용자
 // @Accept json
 // @Produce json
 // @Param Authorization header string true "Bearer JWT 토큰"
-// @Success 200 {object} model.ArrayResponse{data=[]model.ViewingHistoryResponse} "시청 기록 목록"
+// @Param cursor query string false "커서 (지정 시 커서 페이징 응답, 첫 페이지는 빈 값)"
+// @Param size query int false "커서 페이징 시 페이지당 항목 수 (기본값: 10)"
+// @Success 200 {object} model.ArrayResponse{data=[]model.ViewingHistoryResponse} "시청 기록 목록 (커서 지정 시 model.CursorPage)"
+// @Failure 400 {object} model.ErrorResponse "유효하지 않거나 다른 목록에서 만든 커서"
 // @Failure 401 {object} model.ErrorResponse "인증 필요"
 // @Failure 500 {object} model.ErrorResponse "서버 오류"
 // @Router /users/viewing-history [get]
@@ -150,11 +233,36 @@ func handleGetViewingHistory(cfg *config.Config) gin.HandlerFunc {
 			return
 		}
 
-		// 사용자 ID 가져오기
-		userID := c.GetInt64("userID")
+		// 프로필 ID 가져오기
+		profileID := c.GetInt64("profileID")
+
+		// 커서 파라미터가 있으면 커서 페이징으로 조회
+		if cursor, ok := c.GetQuery("cursor"); ok {
+			size, err := strconv.Atoi(c.DefaultQuery("size", "10"))
+			if err != nil || size <= 0 {
+				size = 10
+			}
+
+			cursorPage, err := model.GetViewingHistoryByCursor(db.DB, profileID, cursor, size)
+			if err != nil {
+				if errors.Is(err, model.ErrInvalidCursor) {
+					c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+					return
+				}
+				log.Printf("시청 기록 조회 실패: %v", err)
+				c.JSON(http.StatusInternalServerError, gin.H{"error": "시청 기록 조회 실패"})
+				return
+			}
+
+			c.JSON(http.StatusOK, gin.H{
+				"success": true,
+				"data":    cursorPage,
+			})
+			return
+		}
 
 		// 시청 기록 조회
-		historyList, err := model.GetViewingHistory(db.DB, userID)
+		historyList, err := model.GetViewingHistory(db.DB, profileID)
 		if err != nil {
 			log.Printf("시청 기록 조회 실패: %v", err)
 			c.JSON(http.StatusInternalServerError, gin.H{"error": "시청 기록 조회 실패"})
@@ -167,3 +275,357 @@ func handleGetViewingHistory(cfg *config.Config) gin.HandlerFunc {
 		})
 	}
 }
+
+// @Summary 맞춤 추천 조회
+// @Description 로그인한 사용자의 시청 기록과 찜 목록을 기반으로 한 추천 콘텐츠 조회 (인증 필요)
+// @Tags 사용자
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Param size query int false "최대 항목 수 (기본값: 20, 최대: 50)"
+// @Success 200 {object} model.ArrayResponse{data=[]model.ContentListResponse} "추천 콘텐츠 목록"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /users/recommendations [get]
+func handleGetRecommendations(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		size, err := strconv.Atoi(c.DefaultQuery("size", "20"))
+		if err != nil || size <= 0 {
+			size = 20
+		}
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		// 시청자 정보 (프로필, 접속 국가, 선호 언어)
+		viewer := authenticatedViewer(c, db.DB)
+
+		// 추천 콘텐츠 조회
+		recommendationService := service.NewRecommendationService(db.DB)
+		contents, err := recommendationService.GetRecommendations(viewer, size)
+		if err != nil {
+			log.Printf("추천 콘텐츠 조회 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "추천 콘텐츠 조회 실패"})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    contents,
+		})
+	}
+}
+
+// @Summary 로그인 세션 목록 조회
+// @Description 로그인한 기기별 세션 목록 조회 (최근 접속 순, 인증 필요)
+// @Tags 사용자
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Success 200 {object} model.ArrayResponse{data=[]model.UserSession} "세션 목록"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /users/sessions [get]
+func handleGetSessions(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		// 세션 목록 조회
+		authService := service.NewAuthService(db.DB, cfg)
+		sessions, err := authService.ListSessions(c.GetInt64("userID"), c.GetString("tokenID"))
+		if err != nil {
+			log.Printf("세션 목록 조회 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "세션 목록 조회 실패"})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    sessions,
+		})
+	}
+}
+
+// @Summary 로그인 세션 종료
+// @Description 특정 기기의 세션을 종료하여 해당 기기에서 로그아웃 (인증 필요)
+// @Tags 사용자
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Param id path int true "세션 ID"
+// @Success 200 {object} model.ApiResponse "세션 종료 성공"
+// @Failure 400 {object} model.ErrorResponse "유효하지 않은 세션 ID"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 404 {object} model.ErrorResponse "세션 없음"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /users/sessions/{id} [delete]
+func handleRevokeSession(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		sessionID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 세션 ID"})
+			return
+		}
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		// 세션 종료
+		authService := service.NewAuthService(db.DB, cfg)
+		if err := authService.RevokeSession(c.GetInt64("userID"), sessionID); err != nil {
+			if errors.Is(err, service.ErrSessionNotFound) {
+				c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+				return
+			}
+			log.Printf("세션 종료 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "세션 종료 실패"})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    gin.H{"message": "세션이 종료되었습니다"},
+		})
+	}
+}
+
+// @Summary 모든 기기에서 로그아웃
+// @Description 현재 기기를 포함한 모든 로그인 세션 종료 (인증 필요)
+// @Tags 사용자
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Success 200 {object} model.ApiResponse "로그아웃 성공"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /users/sessions [delete]
+func handleRevokeAllSessions(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		// 모든 세션 종료
+		authService := service.NewAuthService(db.DB, cfg)
+		if err := authService.RevokeAllSessions(c.GetInt64("userID")); err != nil {
+			log.Printf("전체 세션 종료 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "전체 세션 종료 실패"})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    gin.H{"message": "모든 기기에서 로그아웃되었습니다"},
+		})
+	}
+}
+
+// @Summary 개인정보 내보내기 요청
+// @Description 계정 정보, 프로필, 시청 기록, 찜 목록, 로그인 세션을 JSON과 CSV로 담은 ZIP 파일 생성 요청 (인증 필요, 비동기 처리)
+// @Description 생성 중인 요청이 이미 있으면 그 요청을 반환하며, 상태 조회 API로 완료 여부를 확인
+// @Tags 사용자
+// @Produce json
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Success 202 {object} model.ApiResponse{data=model.DataExport} "내보내기 요청 접수"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /users/export [post]
+func handleRequestDataExport(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		exportService := service.NewDataExportService(db.DB, cfg)
+		export, created, err := exportService.RequestExport(c.GetInt64("userID"))
+		if err != nil {
+			log.Printf("개인정보 내보내기 요청 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "개인정보 내보내기 요청 실패"})
+			return
+		}
+
+		// ZIP 파일은 응답 후 백그라운드에서 생성
+		if created {
+			pending := *export
+			go func() {
+				if err := exportService.Build(&pending); err != nil {
+					log.Printf("개인정보 내보내기 생성 실패: export_id=%d: %v", pending.ID, err)
+				}
+			}()
+		}
+
+		c.JSON(http.StatusAccepted, gin.H{
+			"success": true,
+			"data":    export,
+		})
+	}
+}
+
+// @Summary 개인정보 내보내기 상태 조회
+// @Description 내보내기 요청의 생성 상태 조회 (완료되면 다운로드 경로 포함, 인증 필요)
+// @Tags 사용자
+// @Produce json
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Param id path int true "내보내기 요청 ID"
+// @Success 200 {object} model.ApiResponse{data=model.DataExport} "내보내기 상태"
+// @Failure 400 {object} model.ErrorResponse "유효하지 않은 요청 ID"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 404 {object} model.ErrorResponse "내보내기 요청 없음"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /users/export/{id} [get]
+func handleGetDataExport(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		export, ok := findDataExport(c, cfg)
+		if !ok {
+			return
+		}
+
+		if export.Status == model.DataExportCompleted {
+			export.DownloadURL = "/api/users/export/" + strconv.FormatInt(export.ID, 10) + "/download"
+		}
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    export,
+		})
+	}
+}
+
+// @Summary 개인정보 내보내기 파일 다운로드
+// @Description 생성이 완료된 내보내기 ZIP 파일 다운로드 (보관 기간 동안만 가능, 인증 필요)
+// @Tags 사용자
+// @Produce application/zip
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Param id path int true "내보내기 요청 ID"
+// @Success 200 {file} file "ZIP 파일"
+// @Failure 400 {object} model.ErrorResponse "유효하지 않은 요청 ID"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 404 {object} model.ErrorResponse "내보내기 요청 없음"
+// @Failure 409 {object} model.ErrorResponse "아직 생성 중이거나 생성 실패"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /users/export/{id}/download [get]
+func handleDownloadDataExport(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		export, ok := findDataExport(c, cfg)
+		if !ok {
+			return
+		}
+
+		if export.Status != model.DataExportCompleted {
+			c.JSON(http.StatusConflict, gin.H{"error": "내보내기 파일이 준비되지 않았습니다"})
+			return
+		}
+
+		c.FileAttachment(export.FilePath, "miniflix-data-"+export.CreatedAt.Format("20060102")+".zip")
+	}
+}
+
+// findDataExport 경로의 내보내기 요청 조회 (보관 기간이 지났거나 없으면 404 응답 후 false)
+func findDataExport(c *gin.Context, cfg *config.Config) (*model.DataExport, bool) {
+	exportID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 내보내기 요청 ID"})
+		return nil, false
+	}
+
+	// 데이터베이스 연결
+	db, err := helper.GetDB(cfg)
+	if err != nil {
+		log.Printf("데이터베이스 연결 실패: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+		return nil, false
+	}
+
+	export, err := model.GetDataExport(db.DB, c.GetInt64("userID"), exportID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			c.JSON(http.StatusNotFound, gin.H{"error": "내보내기 요청을 찾을 수 없습니다"})
+		} else {
+			log.Printf("내보내기 요청 조회 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "내보내기 요청 조회 실패"})
+		}
+		return nil, false
+	}
+	if !export.ExpiresAt.After(time.Now()) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "내보내기 파일 보관 기간이 지났습니다"})
+		return nil, false
+	}
+
+	return export, true
+}
+
+// @Summary 회원 탈퇴
+// @Description 비밀번호 확인 후 유예 기간 뒤로 탈퇴를 예약하고 모든 기기에서 로그아웃 (인증 필요)
+// @Description 유예 기간 중 다시 로그인하면 탈퇴가 취소되며, 기간이 끝나면 개인정보는 삭제/익명화되고 집계 통계만 남음
+// @Tags 사용자
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Param request body model.DeleteAccountRequest true "현재 비밀번호"
+// @Success 200 {object} model.ApiResponse{data=model.AccountDeletionResponse} "탈퇴 예약 성공"
+// @Failure 400 {object} model.ErrorResponse "유효하지 않은 요청"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 403 {object} model.ErrorResponse "비밀번호 불일치"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /users/me [delete]
+func handleDeleteAccount(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 요청 파싱
+		var req model.DeleteAccountRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 요청"})
+			return
+		}
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		deletionService := service.NewAccountDeletionService(db.DB, cfg)
+		scheduledAt, err := deletionService.RequestDeletion(c.GetInt64("userID"), &req)
+		if err != nil {
+			if errors.Is(err, service.ErrInvalidPassword) {
+				c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+				return
+			}
+			log.Printf("회원 탈퇴 요청 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "회원 탈퇴 요청 실패"})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data": model.AccountDeletionResponse{
+				Message:     "탈퇴가 예약되었습니다. 예정일 전에 다시 로그인하면 탈퇴가 취소됩니다",
+				ScheduledAt: scheduledAt,
+			},
+		})
+	}
+}
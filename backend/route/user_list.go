@@ -0,0 +1,656 @@
+package route
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+
+	"backend/config"
+	"backend/helper"
+	"backend/middleware"
+	"backend/model"
+	"backend/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// defaultUserListParam 기본 목록(찜 목록)을 가리키는 목록 ID 경로 값
+const defaultUserListParam = "default"
+
+// SetupUserListRoutes 사용자 목록 라우트 설정 (공유 목록 조회는 인증 선택)
+func SetupUserListRoutes(router *gin.RouterGroup, cfg *config.Config) {
+	listRoutes := router.Group("/lists")
+	listRoutes.Use(middleware.AuthMiddleware(cfg))
+	{
+		listRoutes.GET("", handleGetUserLists(cfg))
+		listRoutes.POST("", handleCreateUserList(cfg))
+		listRoutes.PUT("/order", handleReorderUserLists(cfg))
+		listRoutes.GET("/:id", handleGetUserList(cfg))
+		listRoutes.PUT("/:id", handleRenameUserList(cfg))
+		listRoutes.DELETE("/:id", handleDeleteUserList(cfg))
+		listRoutes.POST("/:id/items", handleAddUserListItem(cfg))
+		listRoutes.PUT("/:id/items/order", handleReorderUserListItems(cfg))
+		listRoutes.DELETE("/:id/items/:contentId", handleRemoveUserListItem(cfg))
+		listRoutes.POST("/:id/items/:contentId/move", handleMoveUserListItem(cfg))
+		listRoutes.POST("/:id/share", handleShareUserList(cfg))
+		listRoutes.DELETE("/:id/share", handleUnshareUserList(cfg))
+	}
+
+	router.GET("/shared-lists/:token", middleware.OptionalAuthMiddleware(cfg), handleGetSharedUserList(cfg))
+}
+
+// parseUserListID 목록 ID 경로 값 파싱 ("default"는 기본 목록을 뜻하는 0)
+func parseUserListID(c *gin.Context) (int64, bool) {
+	param := c.Param("id")
+	if param == defaultUserListParam {
+		return 0, true
+	}
+	listID, err := strconv.ParseInt(param, 10, 64)
+	if err != nil || listID <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 목록 ID"})
+		return 0, false
+	}
+	return listID, true
+}
+
+// respondUserListError 목록 처리 오류를 상태 코드로 변환하여 응답
+func respondUserListError(c *gin.Context, err error, action string) {
+	switch {
+	case errors.Is(err, service.ErrUserListNotFound), errors.Is(err, service.ErrUserListItemNotFound),
+		errors.Is(err, service.ErrContentNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, model.ErrUserListNameTaken), errors.Is(err, model.ErrUserListLimit):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case errors.Is(err, model.ErrDefaultUserList), errors.Is(err, model.ErrDefaultUserListOrder),
+		errors.Is(err, model.ErrUserListSameTarget):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		log.Printf("목록 %s 실패: %v", action, err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "목록 " + action + " 실패"})
+	}
+}
+
+// @Summary 내 목록 조회
+// @Description 프로필의 목록 조회 (기본 찜 목록이 처음, 나머지는 지정한 순서대로, 인증 필요)
+// @Tags 목록
+// @Produce json
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Success 200 {object} model.ArrayResponse{data=[]model.UserList} "목록"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /lists [get]
+func handleGetUserLists(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		listService := service.NewUserListService(db.DB, cfg)
+		lists, err := listService.GetLists(c.GetInt64("profileID"))
+		if err != nil {
+			respondUserListError(c, err, "조회")
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    lists,
+		})
+	}
+}
+
+// @Summary 목록 생성
+// @Description 이름 있는 목록 생성 (목록 끝에 추가, 인증 필요)
+// @Tags 목록
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Param request body model.UserListRequest true "목록 이름"
+// @Success 201 {object} model.ApiResponse{data=model.UserList} "생성된 목록"
+// @Failure 400 {object} model.ErrorResponse "유효하지 않은 요청"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 409 {object} model.ErrorResponse "같은 이름의 목록이 있거나 최대 목록 수 초과"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /lists [post]
+func handleCreateUserList(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 요청 데이터 파싱
+		var req model.UserListRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 목록 요청"})
+			return
+		}
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		listService := service.NewUserListService(db.DB, cfg)
+		list, err := listService.CreateList(c.GetInt64("profileID"), &req)
+		if err != nil {
+			respondUserListError(c, err, "생성")
+			return
+		}
+
+		c.JSON(http.StatusCreated, gin.H{
+			"success": true,
+			"data":    list,
+		})
+	}
+}
+
+// @Summary 목록 순서 변경
+// @Description 목록 ID 순서대로 목록 순서 변경 (빠진 목록은 뒤로, 기본 찜 목록은 항상 처음, 인증 필요)
+// @Tags 목록
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Param request body model.UserListOrderRequest true "목록 ID 순서"
+// @Success 200 {object} model.ArrayResponse{data=[]model.UserList} "변경된 목록 순서"
+// @Failure 400 {object} model.ErrorResponse "유효하지 않은 요청"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /lists/order [put]
+func handleReorderUserLists(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 요청 데이터 파싱
+		var req model.UserListOrderRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 목록 순서 요청"})
+			return
+		}
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		listService := service.NewUserListService(db.DB, cfg)
+		lists, err := listService.ReorderLists(c.GetInt64("profileID"), &req)
+		if err != nil {
+			respondUserListError(c, err, "순서 변경")
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    lists,
+		})
+	}
+}
+
+// @Summary 목록 상세 조회
+// @Description 목록 정보와 담긴 콘텐츠 조회 (기본 찜 목록은 id 대신 default 사용 가능, 인증 필요)
+// @Tags 목록
+// @Produce json
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Param id path string true "목록 ID 또는 default"
+// @Param lang query string false "표시 언어 (없으면 프로필 선호 언어, Accept-Language 순)"
+// @Success 200 {object} model.ApiResponse{data=model.UserListDetailResponse} "목록 상세"
+// @Failure 400 {object} model.ErrorResponse "유효하지 않은 목록 ID"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 404 {object} model.ErrorResponse "목록 없음"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /lists/{id} [get]
+func handleGetUserList(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 목록 ID 파싱
+		listID, ok := parseUserListID(c)
+		if !ok {
+			return
+		}
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		listService := service.NewUserListService(db.DB, cfg)
+		detail, err := listService.GetList(authenticatedViewer(c, db.DB), listID)
+		if err != nil {
+			respondUserListError(c, err, "조회")
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    detail,
+		})
+	}
+}
+
+// @Summary 목록 이름 변경
+// @Description 목록 이름 변경 (기본 찜 목록은 불가, 인증 필요)
+// @Tags 목록
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Param id path int true "목록 ID"
+// @Param request body model.UserListRequest true "목록 이름"
+// @Success 200 {object} model.ApiResponse{data=model.UserList} "변경된 목록"
+// @Failure 400 {object} model.ErrorResponse "유효하지 않은 요청 또는 기본 목록"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 404 {object} model.ErrorResponse "목록 없음"
+// @Failure 409 {object} model.ErrorResponse "같은 이름의 목록이 있음"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /lists/{id} [put]
+func handleRenameUserList(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 목록 ID 파싱
+		listID, ok := parseUserListID(c)
+		if !ok {
+			return
+		}
+
+		// 요청 데이터 파싱
+		var req model.UserListRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 목록 요청"})
+			return
+		}
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		listService := service.NewUserListService(db.DB, cfg)
+		list, err := listService.RenameList(c.GetInt64("profileID"), listID, &req)
+		if err != nil {
+			respondUserListError(c, err, "이름 변경")
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    list,
+		})
+	}
+}
+
+// @Summary 목록 삭제
+// @Description 목록과 담긴 콘텐츠 삭제 (기본 찜 목록은 불가, 인증 필요)
+// @Tags 목록
+// @Produce json
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Param id path int true "목록 ID"
+// @Success 200 {object} model.ApiResponse "삭제 성공"
+// @Failure 400 {object} model.ErrorResponse "유효하지 않은 목록 ID 또는 기본 목록"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 404 {object} model.ErrorResponse "목록 없음"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /lists/{id} [delete]
+func handleDeleteUserList(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 목록 ID 파싱
+		listID, ok := parseUserListID(c)
+		if !ok {
+			return
+		}
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		listService := service.NewUserListService(db.DB, cfg)
+		if err := listService.DeleteList(c.GetInt64("profileID"), listID); err != nil {
+			respondUserListError(c, err, "삭제")
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    gin.H{"message": "목록이 삭제되었습니다"},
+		})
+	}
+}
+
+// @Summary 목록에 콘텐츠 추가
+// @Description 목록 끝에 콘텐츠 추가 (이미 있으면 그대로 유지, 기본 찜 목록은 id 대신 default 사용 가능, 인증 필요)
+// @Tags 목록
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Param id path string true "목록 ID 또는 default"
+// @Param request body model.UserListItemRequest true "추가할 콘텐츠"
+// @Success 200 {object} model.ApiResponse "추가 성공"
+// @Failure 400 {object} model.ErrorResponse "유효하지 않은 요청"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 404 {object} model.ErrorResponse "목록 또는 콘텐츠 없음"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /lists/{id}/items [post]
+func handleAddUserListItem(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 목록 ID 파싱
+		listID, ok := parseUserListID(c)
+		if !ok {
+			return
+		}
+
+		// 요청 데이터 파싱
+		var req model.UserListItemRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 콘텐츠 추가 요청"})
+			return
+		}
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		listService := service.NewUserListService(db.DB, cfg)
+		added, err := listService.AddItem(c.GetInt64("profileID"), listID, req.ContentID)
+		if err != nil {
+			respondUserListError(c, err, "콘텐츠 추가")
+			return
+		}
+
+		message := "콘텐츠가 목록에 추가되었습니다"
+		if !added {
+			message = "이미 목록에 있는 콘텐츠입니다"
+		}
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    gin.H{"message": message, "added": added},
+		})
+	}
+}
+
+// @Summary 목록 콘텐츠 순서 변경
+// @Description 콘텐츠 ID 순서대로 목록 콘텐츠 순서 변경 (빠진 콘텐츠는 뒤로, 기본 찜 목록은 찜한 순서로 고정, 인증 필요)
+// @Tags 목록
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Param id path int true "목록 ID"
+// @Param request body model.UserListItemsOrderRequest true "콘텐츠 ID 순서"
+// @Success 200 {object} model.ApiResponse{data=model.UserListDetailResponse} "변경된 목록"
+// @Failure 400 {object} model.ErrorResponse "유효하지 않은 요청 또는 기본 목록"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 404 {object} model.ErrorResponse "목록 없음"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /lists/{id}/items/order [put]
+func handleReorderUserListItems(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 목록 ID 파싱
+		listID, ok := parseUserListID(c)
+		if !ok {
+			return
+		}
+
+		// 요청 데이터 파싱
+		var req model.UserListItemsOrderRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 콘텐츠 순서 요청"})
+			return
+		}
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		listService := service.NewUserListService(db.DB, cfg)
+		detail, err := listService.ReorderItems(authenticatedViewer(c, db.DB), listID, &req)
+		if err != nil {
+			respondUserListError(c, err, "콘텐츠 순서 변경")
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    detail,
+		})
+	}
+}
+
+// @Summary 목록에서 콘텐츠 제거
+// @Description 목록에서 콘텐츠 제거 (기본 찜 목록은 id 대신 default 사용 가능, 인증 필요)
+// @Tags 목록
+// @Produce json
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Param id path string true "목록 ID 또는 default"
+// @Param contentId path int true "콘텐츠 ID"
+// @Success 200 {object} model.ApiResponse "제거 성공"
+// @Failure 400 {object} model.ErrorResponse "유효하지 않은 ID"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 404 {object} model.ErrorResponse "목록 없음 또는 목록에 없는 콘텐츠"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /lists/{id}/items/{contentId} [delete]
+func handleRemoveUserListItem(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 목록 ID, 콘텐츠 ID 파싱
+		listID, ok := parseUserListID(c)
+		if !ok {
+			return
+		}
+		contentID, err := strconv.ParseInt(c.Param("contentId"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 콘텐츠 ID"})
+			return
+		}
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		listService := service.NewUserListService(db.DB, cfg)
+		if err := listService.RemoveItem(c.GetInt64("profileID"), listID, contentID); err != nil {
+			respondUserListError(c, err, "콘텐츠 제거")
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    gin.H{"message": "콘텐츠가 목록에서 제거되었습니다"},
+		})
+	}
+}
+
+// @Summary 콘텐츠를 다른 목록으로 이동
+// @Description 콘텐츠를 다른 목록 끝으로 이동 (대상 목록에 이미 있으면 원래 목록에서만 제거, 인증 필요)
+// @Tags 목록
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Param id path string true "원래 목록 ID 또는 default"
+// @Param contentId path int true "콘텐츠 ID"
+// @Param request body model.UserListMoveRequest true "대상 목록"
+// @Success 200 {object} model.ApiResponse "이동 성공"
+// @Failure 400 {object} model.ErrorResponse "유효하지 않은 요청 또는 같은 목록"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 404 {object} model.ErrorResponse "목록 없음 또는 목록에 없는 콘텐츠"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /lists/{id}/items/{contentId}/move [post]
+func handleMoveUserListItem(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 목록 ID, 콘텐츠 ID 파싱
+		listID, ok := parseUserListID(c)
+		if !ok {
+			return
+		}
+		contentID, err := strconv.ParseInt(c.Param("contentId"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 콘텐츠 ID"})
+			return
+		}
+
+		// 요청 데이터 파싱
+		var req model.UserListMoveRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "유효하지 않은 이동 요청"})
+			return
+		}
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		listService := service.NewUserListService(db.DB, cfg)
+		if err := listService.MoveItem(c.GetInt64("profileID"), listID, contentID, &req); err != nil {
+			respondUserListError(c, err, "콘텐츠 이동")
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    gin.H{"message": "콘텐츠를 다른 목록으로 옮겼습니다"},
+		})
+	}
+}
+
+// @Summary 목록 공유 링크 생성
+// @Description 추측할 수 없는 공유 링크 생성 (이미 공유 중이면 기존 링크 유지, 링크를 아는 누구나 읽기 전용으로 조회 가능, 인증 필요)
+// @Tags 목록
+// @Produce json
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Param id path string true "목록 ID 또는 default"
+// @Success 200 {object} model.ApiResponse{data=model.UserList} "공유 링크가 포함된 목록 (share_path)"
+// @Failure 400 {object} model.ErrorResponse "유효하지 않은 목록 ID"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 404 {object} model.ErrorResponse "목록 없음"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /lists/{id}/share [post]
+func handleShareUserList(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 목록 ID 파싱
+		listID, ok := parseUserListID(c)
+		if !ok {
+			return
+		}
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		listService := service.NewUserListService(db.DB, cfg)
+		list, err := listService.Share(c.GetInt64("profileID"), listID)
+		if err != nil {
+			respondUserListError(c, err, "공유")
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    list,
+		})
+	}
+}
+
+// @Summary 목록 공유 해제
+// @Description 공유 링크 삭제 (기존 링크는 더 이상 열리지 않으며, 다시 공유하면 새 링크 생성, 인증 필요)
+// @Tags 목록
+// @Produce json
+// @Param Authorization header string true "Bearer JWT 토큰"
+// @Param id path string true "목록 ID 또는 default"
+// @Success 200 {object} model.ApiResponse{data=model.UserList} "공유 해제된 목록"
+// @Failure 400 {object} model.ErrorResponse "유효하지 않은 목록 ID"
+// @Failure 401 {object} model.ErrorResponse "인증 필요"
+// @Failure 404 {object} model.ErrorResponse "목록 없음"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /lists/{id}/share [delete]
+func handleUnshareUserList(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 목록 ID 파싱
+		listID, ok := parseUserListID(c)
+		if !ok {
+			return
+		}
+
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		listService := service.NewUserListService(db.DB, cfg)
+		list, err := listService.Unshare(c.GetInt64("profileID"), listID)
+		if err != nil {
+			respondUserListError(c, err, "공유 해제")
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    list,
+		})
+	}
+}
+
+// @Summary 공유 목록 조회
+// @Description 공유 링크로 목록 조회 (읽기 전용, 인증 선택, 시청자의 시청 등급/지역에 맞는 콘텐츠만 표시)
+// @Tags 목록
+// @Produce json
+// @Param token path string true "공유 토큰"
+// @Param Authorization header string false "Bearer JWT 토큰"
+// @Param lang query string false "표시 언어 (없으면 프로필 선호 언어, Accept-Language 순)"
+// @Success 200 {object} model.ApiResponse{data=model.SharedUserListResponse} "공유 목록"
+// @Failure 404 {object} model.ErrorResponse "목록 없음 또는 공유 해제됨"
+// @Failure 500 {object} model.ErrorResponse "서버 오류"
+// @Router /shared-lists/{token} [get]
+func handleGetSharedUserList(cfg *config.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 데이터베이스 연결
+		db, err := helper.GetDB(cfg)
+		if err != nil {
+			log.Printf("데이터베이스 연결 실패: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "데이터베이스 연결 실패"})
+			return
+		}
+
+		listService := service.NewUserListService(db.DB, cfg)
+		shared, err := listService.GetShared(catalogViewer(c, db.DB), c.Param("token"))
+		if err != nil {
+			respondUserListError(c, err, "조회")
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    shared,
+		})
+	}
+}
@@ -1,9 +1,11 @@
 package route
 
 import (
+	"errors"
 	"log"
 	"net/http"
 	"strconv"
+	"time"
 
 	"backend/config"
 	"backend/helper"
@@ -29,7 +31,10 @@ func SetupWishlistRoutes(router *gin.RouterGroup, cfg *config.Config) {
 // @Accept json
 // @Produce json
 // @Param Authorization header string true "Bearer JWT 토큰"
-// @Success 200 {object} model.ArrayResponse{data=[]model.ContentListResponse} "찜 목록"
+// @Param cursor query string false "커서 (지정 시 커서 페이징 응답, 첫 페이지는 빈 값)"
+// @Param size query int false "커서 페이징 시 페이지당 항목 수 (기본값: 10)"
+// @Success 200 {object} model.ArrayResponse{data=[]model.ContentListResponse} "찜 목록 (커서 지정 시 model.CursorPage)"
+// @Failure 400 {object} model.ErrorResponse "유효하지 않거나 다른 목록에서 만든 커서"
 // @Failure 401 {object} model.ErrorResponse "인증 필요"
 // @Failure 500 {object} model.ErrorResponse "서버 오류"
 // @Router /wishlists [get]
@@ -43,11 +48,36 @@ func handleGetWishlist(cfg *config.Config) gin.HandlerFunc {
 			return
 		}
 
-		// 사용자 ID 가져오기
-		userID := c.GetInt64("userID")
+		// 시청자 정보 (프로필, 접속 국가, 선호 언어)
+		viewer := authenticatedViewer(c, db.DB)
+
+		// 커서 파라미터가 있으면 커서 페이징으로 조회
+		if cursor, ok := c.GetQuery("cursor"); ok {
+			size, err := strconv.Atoi(c.DefaultQuery("size", "10"))
+			if err != nil || size <= 0 {
+				size = 10
+			}
+
+			cursorPage, err := model.GetWishlistByCursor(db.DB, viewer, cursor, size)
+			if err != nil {
+				if errors.Is(err, model.ErrInvalidCursor) {
+					c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+					return
+				}
+				log.Printf("찜 목록 조회 실패: %v", err)
+				c.JSON(http.StatusInternalServerError, gin.H{"error": "찜 목록 조회 실패"})
+				return
+			}
+
+			c.JSON(http.StatusOK, gin.H{
+				"success": true,
+				"data":    cursorPage,
+			})
+			return
+		}
 
 		// 찜 목록 조회
-		wishlist, err := model.GetWishlist(db.DB, userID)
+		wishlist, err := model.GetWishlist(db.DB, viewer)
 		if err != nil {
 			log.Printf("찜 목록 조회 실패: %v", err)
 			c.JSON(http.StatusInternalServerError, gin.H{"error": "찜 목록 조회 실패"})
@@ -90,11 +120,11 @@ func handleToggleWishlist(cfg *config.Config) gin.HandlerFunc {
 			return
 		}
 
-		// 사용자 ID 가져오기
-		userID := c.GetInt64("userID")
+		// 프로필 ID 가져오기
+		profileID := c.GetInt64("profileID")
 
 		// 찜하기/취소
-		isWishlisted, err := model.ToggleWishlist(db.DB, userID, contentID)
+		isWishlisted, err := model.ToggleWishlist(db.DB, profileID, contentID)
 		if err != nil {
 			log.Printf("찜하기/취소 실패: %v", err)
 			c.JSON(http.StatusInternalServerError, gin.H{"error": "찜하기/취소 처리 실패"})
@@ -107,6 +137,11 @@ func handleToggleWishlist(cfg *config.Config) gin.HandlerFunc {
 
 		if isWishlisted {
 			response.Message = "콘텐츠가 찜 목록에 추가되었습니다"
+
+			// 찜 추가 인기도 반영 (프로필/콘텐츠별 처음 한 번만, 실패해도 응답은 정상 처리)
+			if _, err := model.RecordProfilePopularityEvent(db.DB, profileID, contentID, model.PopularityEventWishlistAdd, time.Now()); err != nil {
+				log.Printf("인기도 반영 실패: %v", err)
+			}
 		} else {
 			response.Message = "콘텐츠가 찜 목록에서 제거되었습니다"
 		}
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"database/sql"
+	"log"
+	"os"
+	"time"
+
+	"backend/config"
+	"backend/model"
+)
+
+// AccountDeletionService 회원 탈퇴 예약 및 유예 기간 후 개인정보 삭제 서비스
+type AccountDeletionService struct {
+	DB     *sql.DB
+	Config *config.Config
+}
+
+// NewAccountDeletionService 새 AccountDeletionService 생성
+func NewAccountDeletionService(db *sql.DB, cfg *config.Config) *AccountDeletionService {
+	return &AccountDeletionService{
+		DB:     db,
+		Config: cfg,
+	}
+}
+
+// RequestDeletion 비밀번호 확인 후 유예 기간 뒤로 탈퇴를 예약하고 모든 세션 종료
+// 유예 기간 중 다시 로그인하면 탈퇴가 취소됨
+func (s *AccountDeletionService) RequestDeletion(userID int64, req *model.DeleteAccountRequest) (time.Time, error) {
+	user, err := model.GetUserByID(s.DB, userID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if !model.CheckPasswordHash(req.CurrentPassword, user.Password) {
+		return time.Time{}, ErrInvalidPassword
+	}
+
+	scheduledAt := time.Now().AddDate(0, 0, s.Config.AccountDeletionGraceDays)
+	if err := model.ScheduleAccountDeletion(s.DB, userID, scheduledAt); err != nil {
+		return time.Time{}, err
+	}
+	if err := NewAuthService(s.DB, s.Config).RevokeAllSessions(userID); err != nil {
+		return time.Time{}, err
+	}
+
+	return scheduledAt, nil
+}
+
+// CancelDeletion 예약된 탈퇴 취소 (취소된 요청이 있으면 true)
+func (s *AccountDeletionService) CancelDeletion(userID int64) (bool, error) {
+	return model.CancelAccountDeletion(s.DB, userID)
+}
+
+// PurgeDueAccounts 유예 기간이 끝난 계정의 내보내기 파일을 지우고 개인정보 익명화
+// 한 계정에서 실패해도 나머지 계정은 계속 처리하고 처리한 계정 수 반환
+func (s *AccountDeletionService) PurgeDueAccounts() (int, error) {
+	userIDs, err := model.GetDueAccountDeletions(s.DB)
+	if err != nil {
+		return 0, err
+	}
+
+	purged := 0
+	for _, userID := range userIDs {
+		if err := s.purgeAccount(userID); err != nil {
+			log.Printf("탈퇴 계정 정리 실패: user_id=%d: %v", userID, err)
+			continue
+		}
+		purged++
+	}
+	return purged, nil
+}
+
+// purgeAccount 계정 하나의 내보내기 파일 삭제 후 익명화
+func (s *AccountDeletionService) purgeAccount(userID int64) error {
+	exports, err := model.GetUserDataExports(s.DB, userID)
+	if err != nil {
+		return err
+	}
+	for _, export := range exports {
+		if err := removeExportFile(export.FilePath); err != nil {
+			return err
+		}
+	}
+
+	return model.AnonymizeUser(s.DB, userID)
+}
+
+// removeExportFile 내보내기 파일 삭제 (경로가 비어 있거나 이미 없으면 무시)
+func removeExportFile(path string) error {
+	if path == "" {
+		return nil
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+package service
+
+import (
+	"database/sql"
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+
+	"backend/config"
+	"backend/helper"
+	"backend/model"
+)
+
+// 계정 메일 관련 오류
+var (
+	ErrInvalidAccountToken = errors.New("유효하지 않거나 만료된 링크입니다")
+	ErrEmailNotVerified    = errors.New("이메일 인증 후 이용할 수 있습니다")
+)
+
+// AccountService 비밀번호 재설정 및 이메일 인증 서비스
+type AccountService struct {
+	DB     *sql.DB
+	Mailer helper.Mailer
+	Config *config.Config
+}
+
+// NewAccountService 새 AccountService 생성
+func NewAccountService(db *sql.DB, mailer helper.Mailer, cfg *config.Config) *AccountService {
+	return &AccountService{
+		DB:     db,
+		Mailer: mailer,
+		Config: cfg,
+	}
+}
+
+// SendVerificationEmail 이메일 인증 메일 발송
+func (s *AccountService) SendVerificationEmail(user *model.User, language string) error {
+	ttl := time.Duration(s.Config.EmailVerificationExpireHours) * time.Hour
+	return s.sendTokenMail(user, model.UserTokenEmailVerification, mailTemplateEmailVerification, "/verify-email", ttl, language)
+}
+
+// VerifyEmail 이메일 인증 토큰 확인 후 인증 완료 처리
+func (s *AccountService) VerifyEmail(token string) error {
+	userID, err := s.consumeToken(model.UserTokenEmailVerification, token)
+	if err != nil {
+		return err
+	}
+	return model.MarkEmailVerified(s.DB, userID)
+}
+
+// RequestPasswordReset 비밀번호 재설정 메일 발송
+// 가입되지 않았거나 비활성화된 이메일이어도 오류 없이 종료 (가입 여부 노출 방지)
+// 가입된 이메일만 토큰 저장과 메일 발송을 하므로 응답 시간 차이가 없도록 요청 처리와 분리하여 호출
+func (s *AccountService) RequestPasswordReset(email, language string) error {
+	user, err := model.GetUserByEmail(s.DB, email)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil
+		}
+		return err
+	}
+	if !user.IsActive {
+		return nil
+	}
+
+	ttl := time.Duration(s.Config.PasswordResetExpireMinutes) * time.Minute
+	return s.sendTokenMail(user, model.UserTokenPasswordReset, mailTemplatePasswordReset, "/reset-password", ttl, language)
+}
+
+// ResetPassword 비밀번호 재설정 토큰 확인 후 비밀번호 변경
+// 메일 수신으로 이메일 소유가 확인되므로 인증 완료 처리하고, 기존 로그인 세션은 모두 폐기
+// 새 비밀번호가 정책을 충족하지 않으면 토큰을 사용하지 않고 *PasswordPolicyError 반환 (다시 시도 가능)
+func (s *AccountService) ResetPassword(token, newPassword string) error {
+	ownerID, err := model.GetUserTokenOwner(s.DB, model.UserTokenPasswordReset, helper.HashToken(token))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ErrInvalidAccountToken
+		}
+		return err
+	}
+	user, err := model.GetUserByID(s.DB, ownerID)
+	if err != nil {
+		return err
+	}
+	if err := NewPasswordPolicy(s.Config).Validate("new_password", newPassword, user.Email, user.Name); err != nil {
+		return err
+	}
+
+	userID, err := s.consumeToken(model.UserTokenPasswordReset, token)
+	if err != nil {
+		return err
+	}
+
+	if err := model.SetUserPassword(s.DB, userID, newPassword); err != nil {
+		return err
+	}
+	if err := model.MarkEmailVerified(s.DB, userID); err != nil {
+		return err
+	}
+	return model.RevokeUserRefreshTokens(s.DB, userID)
+}
+
+// RequireVerifiedEmail 설정에 따라 이메일 미인증 계정 제한 (제한 대상이면 ErrEmailNotVerified)
+func (s *AccountService) RequireVerifiedEmail(userID int64) error {
+	if !s.Config.RequireEmailVerificationStream {
+		return nil
+	}
+
+	verified, err := model.IsEmailVerified(s.DB, userID)
+	if err != nil {
+		return err
+	}
+	if !verified {
+		return ErrEmailNotVerified
+	}
+	return nil
+}
+
+// sendTokenMail 일회용 토큰을 발급하고 토큰 링크가 담긴 메일 발송
+func (s *AccountService) sendTokenMail(user *model.User, purpose, templateKind, path string, ttl time.Duration, language string) error {
+	token, err := helper.GenerateRandomToken(32)
+	if err != nil {
+		return err
+	}
+	if err := model.CreateUserToken(s.DB, user.ID, purpose, helper.HashToken(token), time.Now().Add(ttl)); err != nil {
+		return err
+	}
+
+	subject, body, err := renderMail(language, templateKind, mailTemplateData{
+		Name:          user.Name,
+		Link:          strings.TrimRight(s.Config.Mail.LinkBaseURL, "/") + path + "?token=" + url.QueryEscape(token),
+		ExpiresInText: formatMailDuration(language, ttl),
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.Mailer.Send(helper.MailMessage{To: user.Email, Subject: subject, Body: body})
+}
+
+// consumeToken 일회용 토큰 사용 처리 후 계정 ID 반환
+func (s *AccountService) consumeToken(purpose, token string) (int64, error) {
+	userID, err := model.ConsumeUserToken(s.DB, purpose, helper.HashToken(token))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, ErrInvalidAccountToken
+		}
+		return 0, err
+	}
+	return userID, nil
+}
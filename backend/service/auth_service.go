@@ -0,0 +1,201 @@
+package service
+
+import (
+	"database/sql"
+	"errors"
+	"log"
+	"time"
+
+	"backend/config"
+	"backend/helper"
+	"backend/model"
+)
+
+// 토큰 관련 오류
+var (
+	ErrInvalidRefreshToken = errors.New("유효하지 않은 리프레시 토큰입니다")
+	ErrRefreshTokenReused  = errors.New("이미 사용된 리프레시 토큰입니다. 보안을 위해 세션이 종료되었습니다")
+	ErrSessionNotFound     = errors.New("로그인 세션을 찾을 수 없습니다")
+)
+
+// AuthService 액세스/리프레시 토큰 발급 및 폐기 서비스
+type AuthService struct {
+	DB     *sql.DB
+	Config *config.Config
+}
+
+// NewAuthService 새 AuthService 생성
+func NewAuthService(db *sql.DB, cfg *config.Config) *AuthService {
+	return &AuthService{
+		DB:     db,
+		Config: cfg,
+	}
+}
+
+// IssueTokens 로그인 시 새 세션(토큰 계열)을 만들고 액세스/리프레시 토큰 발급
+func (s *AuthService) IssueTokens(user *model.User, profileID int64, client model.SessionClient) (*model.TokenResponse, error) {
+	familyID, err := helper.GenerateRandomToken(16)
+	if err != nil {
+		return nil, err
+	}
+	if err := model.CreateUserSession(s.DB, user.ID, familyID, client); err != nil {
+		return nil, err
+	}
+
+	return s.issueTokens(user, profileID, familyID, func(token *model.RefreshToken) (bool, error) {
+		return true, model.CreateRefreshToken(s.DB, token)
+	})
+}
+
+// RefreshTokens 리프레시 토큰을 교체하고 새 액세스 토큰 발급
+// 이미 교체된 토큰이 다시 사용되면 탈취로 간주하여 해당 세션 전체를 폐기
+func (s *AuthService) RefreshTokens(refreshToken string, client model.SessionClient) (*model.TokenResponse, error) {
+	current, err := model.GetRefreshTokenByHash(s.DB, helper.HashToken(refreshToken))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrInvalidRefreshToken
+		}
+		return nil, err
+	}
+
+	// 교체/폐기된 토큰 재사용 감지
+	if current.RevokedAt.Valid {
+		if err := model.RevokeRefreshTokenFamily(s.DB, current.FamilyID); err != nil {
+			return nil, err
+		}
+		log.Printf("리프레시 토큰 재사용 감지: user_id=%d family_id=%s", current.UserID, current.FamilyID)
+		return nil, ErrRefreshTokenReused
+	}
+	if !current.IsActive(time.Now()) {
+		return nil, ErrInvalidRefreshToken
+	}
+
+	// 비활성화된 계정은 세션 폐기
+	user, err := model.GetUserByID(s.DB, current.UserID)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+	if user == nil || !user.IsActive {
+		if err := model.RevokeRefreshTokenFamily(s.DB, current.FamilyID); err != nil {
+			return nil, err
+		}
+		return nil, ErrInvalidRefreshToken
+	}
+
+	tokens, err := s.issueTokens(user, current.ProfileID, current.FamilyID, func(next *model.RefreshToken) (bool, error) {
+		return model.RotateRefreshToken(s.DB, current.ID, next)
+	})
+	if err != nil {
+		if errors.Is(err, ErrRefreshTokenReused) {
+			// 동시에 같은 토큰으로 갱신을 시도한 경우
+			if revokeErr := model.RevokeRefreshTokenFamily(s.DB, current.FamilyID); revokeErr != nil {
+				return nil, revokeErr
+			}
+		}
+		return nil, err
+	}
+
+	// 세션 마지막 접속 정보 갱신 (실패해도 토큰은 발급)
+	if err := model.TouchUserSession(s.DB, current.FamilyID, client); err != nil {
+		log.Printf("세션 접속 정보 갱신 실패: %v", err)
+	}
+
+	return tokens, nil
+}
+
+// SwitchProfile 현재 세션의 프로필을 전환하고 새 프로필 전용 액세스 토큰 발급 (기존 액세스 토큰은 폐기)
+func (s *AuthService) SwitchProfile(currentJTI string, currentExpiresAt time.Time, userID, profileID int64, name, email string) (*helper.AccessToken, error) {
+	session, err := model.GetActiveRefreshTokenByAccessJTI(s.DB, currentJTI)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrSessionNotFound
+		}
+		return nil, err
+	}
+
+	accessToken, err := helper.GenerateAccessToken(userID, profileID, name, email, s.Config)
+	if err != nil {
+		return nil, err
+	}
+	if err := model.UpdateRefreshTokenAccess(s.DB, session.ID, profileID, accessToken.ID, accessToken.ExpiresAt); err != nil {
+		return nil, err
+	}
+	if err := model.RevokeAccessToken(s.DB, currentJTI, currentExpiresAt); err != nil {
+		return nil, err
+	}
+
+	return accessToken, nil
+}
+
+// Logout 현재 액세스 토큰과 해당 세션의 리프레시 토큰 폐기
+func (s *AuthService) Logout(accessJTI string, accessExpiresAt time.Time) error {
+	session, err := model.GetActiveRefreshTokenByAccessJTI(s.DB, accessJTI)
+	if err != nil && err != sql.ErrNoRows {
+		return err
+	}
+	if session != nil {
+		if err := model.RevokeRefreshTokenFamily(s.DB, session.FamilyID); err != nil {
+			return err
+		}
+	}
+
+	return model.RevokeAccessToken(s.DB, accessJTI, accessExpiresAt)
+}
+
+// ListSessions 계정의 로그인 세션 목록 조회 (currentJTI에 해당하는 세션은 현재 세션으로 표시)
+func (s *AuthService) ListSessions(userID int64, currentJTI string) ([]model.UserSession, error) {
+	return model.GetUserSessions(s.DB, userID, currentJTI)
+}
+
+// RevokeSession 계정에 속한 로그인 세션 하나 폐기 (다른 기기 로그아웃)
+func (s *AuthService) RevokeSession(userID, sessionID int64) error {
+	session, err := model.GetUserSession(s.DB, userID, sessionID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ErrSessionNotFound
+		}
+		return err
+	}
+	return model.RevokeRefreshTokenFamily(s.DB, session.FamilyID)
+}
+
+// RevokeAllSessions 계정의 모든 로그인 세션 폐기 (모든 기기에서 로그아웃)
+func (s *AuthService) RevokeAllSessions(userID int64) error {
+	return model.RevokeUserRefreshTokens(s.DB, userID)
+}
+
+// issueTokens 액세스 토큰과 리프레시 토큰을 생성하고 save로 리프레시 토큰 저장
+// save가 false를 반환하면 이미 교체된 토큰으로 간주
+func (s *AuthService) issueTokens(user *model.User, profileID int64, familyID string, save func(*model.RefreshToken) (bool, error)) (*model.TokenResponse, error) {
+	accessToken, err := helper.GenerateAccessToken(user.ID, profileID, user.Name, user.Email, s.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshToken, err := helper.GenerateRandomToken(32)
+	if err != nil {
+		return nil, err
+	}
+
+	saved, err := save(&model.RefreshToken{
+		UserID:          user.ID,
+		ProfileID:       profileID,
+		FamilyID:        familyID,
+		TokenHash:       helper.HashToken(refreshToken),
+		AccessJTI:       accessToken.ID,
+		AccessExpiresAt: accessToken.ExpiresAt,
+		ExpiresAt:       time.Now().Add(s.Config.RefreshTokenTTL()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !saved {
+		return nil, ErrRefreshTokenReused
+	}
+
+	return &model.TokenResponse{
+		Token:        accessToken.Token,
+		RefreshToken: refreshToken,
+		ExpiresIn:    int64(s.Config.AccessTokenTTL().Seconds()),
+	}, nil
+}
@@ -0,0 +1,182 @@
+package service
+
+import (
+	"database/sql"
+	"errors"
+	"log"
+
+	"backend/config"
+	"backend/helper"
+	"backend/model"
+)
+
+// 결제 관련 오류
+var (
+	ErrCheckoutSessionNotFound = errors.New("결제 세션을 찾을 수 없습니다")
+	ErrPaymentNotFound         = errors.New("환불 대상 결제를 찾을 수 없습니다")
+	ErrFakeBillingDisabled     = errors.New("모의 결제를 사용하지 않는 환경입니다")
+)
+
+// BillingService 결제 요청 및 결제 웹훅 처리 서비스
+type BillingService struct {
+	DB       *sql.DB
+	Provider helper.BillingProvider
+	Config   *config.Config
+}
+
+// NewBillingService 새 BillingService 생성
+func NewBillingService(db *sql.DB, provider helper.BillingProvider, cfg *config.Config) *BillingService {
+	return &BillingService{
+		DB:       db,
+		Provider: provider,
+		Config:   cfg,
+	}
+}
+
+// CreateCheckout 요금제 한 달 이용권 결제 세션 생성
+// 결제 결과는 결제 제공자의 웹훅으로 전달되며, 결제가 성공해야 구독이 생성됨
+func (s *BillingService) CreateCheckout(userID int64, email, planCode string) (*model.CheckoutSession, error) {
+	plan, err := model.GetPlanByCode(s.DB, planCode)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrPlanNotFound
+		}
+		return nil, err
+	}
+
+	session, err := s.Provider.CreateCheckoutSession(helper.CheckoutRequest{
+		Email:      email,
+		PlanCode:   plan.Code,
+		Amount:     plan.MonthlyPrice,
+		Currency:   s.Config.Billing.Currency,
+		SuccessURL: s.Config.Billing.SuccessURL,
+		CancelURL:  s.Config.Billing.CancelURL,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	checkout := &model.CheckoutSession{
+		UserID:            userID,
+		PlanID:            plan.ID,
+		PlanCode:          plan.Code,
+		Provider:          s.Provider.Name(),
+		ProviderSessionID: session.ID,
+		CheckoutURL:       session.URL,
+		Amount:            plan.MonthlyPrice,
+		Currency:          s.Config.Billing.Currency,
+		ExpiresAt:         session.ExpiresAt,
+	}
+	if err := model.CreateCheckoutSession(s.DB, checkout); err != nil {
+		return nil, err
+	}
+	return checkout, nil
+}
+
+// HandleWebhook 결제 웹훅의 서명을 검증하고 이벤트를 반영
+// 이미 처리한 이벤트나 처리하지 않는 유형의 이벤트는 아무것도 바꾸지 않고 false 반환
+func (s *BillingService) HandleWebhook(payload []byte, signature string) (bool, error) {
+	event, err := s.Provider.ParseWebhook(payload, signature)
+	if err != nil {
+		return false, err
+	}
+
+	paymentEvent := &model.PaymentEvent{
+		Provider:      s.Provider.Name(),
+		EventID:       event.ID,
+		Type:          event.Type,
+		SessionID:     event.SessionID,
+		PaymentID:     event.PaymentID,
+		Amount:        event.Amount,
+		Currency:      event.Currency,
+		FailureReason: event.FailureReason,
+	}
+
+	var processed bool
+	switch event.Type {
+	case helper.BillingEventPaymentSucceeded, helper.BillingEventPaymentFailed:
+		if event.SessionID == "" || event.PaymentID == "" {
+			return false, helper.ErrInvalidWebhookPayload
+		}
+		if event.Type == helper.BillingEventPaymentSucceeded {
+			processed, err = model.ApplyPaymentSucceeded(s.DB, paymentEvent)
+		} else {
+			processed, err = model.ApplyPaymentFailed(s.DB, paymentEvent)
+		}
+		if err == sql.ErrNoRows {
+			return false, ErrCheckoutSessionNotFound
+		}
+	case helper.BillingEventPaymentRefunded:
+		if event.PaymentID == "" {
+			return false, helper.ErrInvalidWebhookPayload
+		}
+		processed, err = model.ApplyPaymentRefunded(s.DB, paymentEvent)
+		if err == sql.ErrNoRows {
+			return false, ErrPaymentNotFound
+		}
+	default:
+		// 처리하지 않는 이벤트도 제공자가 재전송하지 않도록 정상 응답
+		log.Printf("처리하지 않는 결제 이벤트: %s (%s)", event.Type, event.ID)
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if !processed {
+		log.Printf("이미 처리한 결제 이벤트: %s (%s)", event.Type, event.ID)
+	}
+	return processed, nil
+}
+
+// GetCheckout 계정의 결제 세션 조회
+func (s *BillingService) GetCheckout(userID, checkoutID int64) (*model.CheckoutSession, error) {
+	session, err := model.GetCheckoutSession(s.DB, userID, checkoutID)
+	if err == sql.ErrNoRows {
+		return nil, ErrCheckoutSessionNotFound
+	}
+	return session, err
+}
+
+// SimulatePayment 모의 결제 제공자로 결제 결과 웹훅을 만들어 처리 (개발용, 실제 웹훅과 같은 경로로 반영)
+func (s *BillingService) SimulatePayment(userID, checkoutID int64, succeeded bool) (*model.CheckoutSession, error) {
+	provider, ok := s.Provider.(*helper.FakeBillingProvider)
+	if !ok {
+		return nil, ErrFakeBillingDisabled
+	}
+
+	session, err := s.GetCheckout(userID, checkoutID)
+	if err != nil {
+		return nil, err
+	}
+
+	paymentID, err := helper.GenerateRandomToken(16)
+	if err != nil {
+		return nil, err
+	}
+	event := helper.BillingEvent{
+		Type:      helper.BillingEventPaymentSucceeded,
+		SessionID: session.ProviderSessionID,
+		PaymentID: "pay_fake_" + paymentID,
+		Amount:    session.Amount,
+		Currency:  session.Currency,
+	}
+	if !succeeded {
+		event.Type = helper.BillingEventPaymentFailed
+		event.FailureReason = "모의 결제 실패"
+	}
+
+	payload, signature, err := provider.Event(event)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.HandleWebhook(payload, signature); err != nil {
+		return nil, err
+	}
+	return s.GetCheckout(userID, checkoutID)
+}
+
+// GetInvoices 계정의 청구서 목록 조회
+func (s *BillingService) GetInvoices(userID int64) ([]model.Invoice, error) {
+	return model.GetUserInvoices(s.DB, userID)
+}
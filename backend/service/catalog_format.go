@@ -0,0 +1,386 @@
+package service
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"backend/model"
+)
+
+// 카탈로그 파일 형식
+const (
+	CatalogFormatCSV  = "csv"  // 헤더가 있는 CSV (장르는 | 로 구분)
+	CatalogFormatJSON = "json" // CatalogEntry 배열 (또는 results 배열을 가진 객체)
+	CatalogFormatTMDB = "tmdb" // TMDB 영화 응답 형식 (results 배열 또는 영화 객체 배열)
+)
+
+// catalogCSVColumns CSV 열 순서 (가져오기는 헤더 이름으로 열을 찾으므로 순서 무관)
+var catalogCSVColumns = []string{
+	"external_id", "title", "description", "thumbnail_url", "video_url",
+	"duration", "release_year", "maturity_level", "genres",
+}
+
+// catalogCSVGenreSeparator CSV 장르 열의 장르 구분자
+const catalogCSVGenreSeparator = "|"
+
+// tmdbImageBaseURL TMDB 이미지 경로 앞에 붙이는 주소
+const tmdbImageBaseURL = "https://image.tmdb.org/t/p/w500"
+
+// tmdbGenreNames TMDB 영화 장르 ID → 장르 이름
+var tmdbGenreNames = map[int]string{
+	28:    "액션",
+	12:    "모험",
+	16:    "애니메이션",
+	35:    "코미디",
+	80:    "범죄",
+	99:    "다큐멘터리",
+	18:    "드라마",
+	10751: "가족",
+	14:    "판타지",
+	36:    "역사",
+	27:    "공포",
+	10402: "음악",
+	9648:  "미스터리",
+	10749: "로맨스",
+	878:   "SF",
+	10770: "TV 영화",
+	53:    "스릴러",
+	10752: "전쟁",
+	37:    "서부",
+}
+
+// ErrUnknownCatalogFormat 지원하지 않는 카탈로그 형식
+var ErrUnknownCatalogFormat = errors.New("지원하지 않는 카탈로그 형식입니다 (csv, json, tmdb)")
+
+// CatalogRecord 카탈로그 파일에서 읽은 항목과 위치
+type CatalogRecord struct {
+	Line  int
+	Entry model.CatalogEntry
+}
+
+// CatalogLineError 카탈로그 파일의 줄 단위 오류
+type CatalogLineError struct {
+	Line int
+	Err  error
+}
+
+// Error 오류 메시지 ("줄 N: ...")
+func (e CatalogLineError) Error() string {
+	return fmt.Sprintf("줄 %d: %v", e.Line, e.Err)
+}
+
+// ParseCatalog 카탈로그 파일 읽기
+// 형식이 잘못된 항목은 줄 단위 오류로 모으고, 파일 자체를 읽을 수 없으면 error 반환
+func ParseCatalog(r io.Reader, format string) ([]CatalogRecord, []CatalogLineError, error) {
+	switch format {
+	case CatalogFormatCSV:
+		return parseCatalogCSV(r)
+	case CatalogFormatJSON:
+		return parseCatalogJSON(r, decodeCatalogEntry)
+	case CatalogFormatTMDB:
+		return parseCatalogJSON(r, decodeTMDBMovie)
+	default:
+		return nil, nil, ErrUnknownCatalogFormat
+	}
+}
+
+// parseCatalogCSV 헤더가 있는 CSV 카탈로그 읽기
+func parseCatalogCSV(r io.Reader) ([]CatalogRecord, []CatalogLineError, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, nil, fmt.Errorf("CSV 헤더 읽기 실패: %w", err)
+	}
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))] = i
+	}
+	for _, name := range []string{"external_id", "title", "thumbnail_url", "video_url", "duration", "release_year"} {
+		if _, ok := columns[name]; !ok {
+			return nil, nil, fmt.Errorf("CSV 헤더에 %s 열이 없습니다", name)
+		}
+	}
+
+	var records []CatalogRecord
+	var lineErrors []CatalogLineError
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				return nil, nil, CatalogLineError{Line: parseErr.Line, Err: parseErr.Err}
+			}
+			return nil, nil, err
+		}
+		line, _ := reader.FieldPos(0)
+
+		field := func(name string) string {
+			if i, ok := columns[name]; ok && i < len(row) {
+				return strings.TrimSpace(row[i])
+			}
+			return ""
+		}
+		var problems []string
+		number := func(name string) int {
+			value := field(name)
+			if value == "" {
+				return 0
+			}
+			n, err := strconv.Atoi(value)
+			if err != nil {
+				problems = append(problems, name+"는 숫자여야 합니다: "+value)
+			}
+			return n
+		}
+
+		entry := model.CatalogEntry{
+			ExternalID:    field("external_id"),
+			Title:         field("title"),
+			Description:   field("description"),
+			ThumbnailURL:  field("thumbnail_url"),
+			VideoURL:      field("video_url"),
+			Duration:      number("duration"),
+			ReleaseYear:   number("release_year"),
+			MaturityLevel: number("maturity_level"),
+		}
+		if genres := field("genres"); genres != "" {
+			entry.Genres = strings.Split(genres, catalogCSVGenreSeparator)
+		}
+
+		if len(problems) > 0 {
+			lineErrors = append(lineErrors, CatalogLineError{Line: line, Err: errors.New(strings.Join(problems, ", "))})
+			continue
+		}
+		records = append(records, CatalogRecord{Line: line, Entry: entry})
+	}
+	return records, lineErrors, nil
+}
+
+// parseCatalogJSON JSON 배열(또는 results 배열을 가진 객체)의 각 항목을 decode로 변환
+func parseCatalogJSON(r io.Reader, decode func(json.RawMessage) (model.CatalogEntry, error)) ([]CatalogRecord, []CatalogLineError, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	elements, err := jsonArrayElements(data, "results")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var records []CatalogRecord
+	var lineErrors []CatalogLineError
+	for _, element := range elements {
+		entry, err := decode(element.raw)
+		if err != nil {
+			lineErrors = append(lineErrors, CatalogLineError{Line: element.line, Err: err})
+			continue
+		}
+		records = append(records, CatalogRecord{Line: element.line, Entry: entry})
+	}
+	return records, lineErrors, nil
+}
+
+// jsonElement JSON 배열 항목과 시작 줄 번호
+type jsonElement struct {
+	line int
+	raw  json.RawMessage
+}
+
+// jsonArrayElements 최상위 배열 또는 최상위 객체의 key 배열 항목을 줄 번호와 함께 읽기
+func jsonArrayElements(data []byte, key string) ([]jsonElement, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	syntaxError := func(err error) error {
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			return CatalogLineError{Line: lineAt(data, int(syntaxErr.Offset)), Err: err}
+		}
+		return err
+	}
+
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, syntaxError(err)
+	}
+	if token == json.Delim('{') {
+		found := false
+		for decoder.More() {
+			name, err := decoder.Token()
+			if err != nil {
+				return nil, syntaxError(err)
+			}
+			if name == key {
+				found = true
+				break
+			}
+			var skip json.RawMessage
+			if err := decoder.Decode(&skip); err != nil {
+				return nil, syntaxError(err)
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("JSON 객체에 %s 배열이 없습니다", key)
+		}
+		if token, err = decoder.Token(); err != nil {
+			return nil, syntaxError(err)
+		}
+	}
+	if token != json.Delim('[') {
+		return nil, errors.New("JSON 배열이 필요합니다")
+	}
+
+	var elements []jsonElement
+	for decoder.More() {
+		offset := int(decoder.InputOffset())
+		var raw json.RawMessage
+		if err := decoder.Decode(&raw); err != nil {
+			return nil, syntaxError(err)
+		}
+		elements = append(elements, jsonElement{line: lineAt(data, skipJSONSeparators(data, offset)), raw: raw})
+	}
+	return elements, nil
+}
+
+// skipJSONSeparators offset부터 공백과 쉼표를 건너뛴 위치
+func skipJSONSeparators(data []byte, offset int) int {
+	for offset < len(data) {
+		switch data[offset] {
+		case ' ', '\t', '\r', '\n', ',':
+			offset++
+		default:
+			return offset
+		}
+	}
+	return offset
+}
+
+// lineAt offset 위치의 줄 번호 (1부터)
+func lineAt(data []byte, offset int) int {
+	if offset > len(data) {
+		offset = len(data)
+	}
+	return bytes.Count(data[:offset], []byte("\n")) + 1
+}
+
+// decodeCatalogEntry JSON 카탈로그 항목 변환 (알 수 없는 필드는 오류)
+func decodeCatalogEntry(raw json.RawMessage) (model.CatalogEntry, error) {
+	var entry model.CatalogEntry
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&entry); err != nil {
+		return entry, err
+	}
+	return entry, nil
+}
+
+// tmdbMovie TMDB 영화 응답에서 사용하는 필드 (목록 응답의 genre_ids, 상세 응답의 genres 모두 지원)
+// TMDB 응답에는 영상 주소가 없으므로 video_url은 항목에 직접 추가해야 하고,
+// 목록 응답에는 runtime이 없으므로 duration(초)도 직접 추가해야 함
+type tmdbMovie struct {
+	VideoURL    string `json:"video_url"`
+	Duration    int    `json:"duration"`
+	ID          int64  `json:"id"`
+	Title       string `json:"title"`
+	Overview    string `json:"overview"`
+	PosterPath  string `json:"poster_path"`
+	ReleaseDate string `json:"release_date"`
+	Runtime     int    `json:"runtime"`
+	Adult       bool   `json:"adult"`
+	GenreIDs    []int  `json:"genre_ids"`
+	Genres      []struct {
+		ID int `json:"id"`
+	} `json:"genres"`
+}
+
+// decodeTMDBMovie TMDB 영화 객체를 카탈로그 항목으로 변환
+// 외부 ID는 tmdb:{id}, 영상 길이는 duration(초) 또는 runtime(분)을 초로, 성인 영화는 19세 등급으로 변환
+func decodeTMDBMovie(raw json.RawMessage) (model.CatalogEntry, error) {
+	var movie tmdbMovie
+	if err := json.Unmarshal(raw, &movie); err != nil {
+		return model.CatalogEntry{}, err
+	}
+	if movie.ID <= 0 {
+		return model.CatalogEntry{}, errors.New("TMDB id가 필요합니다")
+	}
+
+	entry := model.CatalogEntry{
+		ExternalID:  "tmdb:" + strconv.FormatInt(movie.ID, 10),
+		Title:       movie.Title,
+		Description: movie.Overview,
+		VideoURL:    strings.TrimSpace(movie.VideoURL),
+		Duration:    movie.Duration,
+	}
+	if entry.VideoURL == "" {
+		return entry, errors.New("video_url이 필요합니다 (TMDB 응답에는 영상 주소가 없으므로 항목에 직접 추가하세요)")
+	}
+	if entry.Duration <= 0 {
+		entry.Duration = movie.Runtime * 60
+	}
+	if entry.Duration <= 0 {
+		return entry, errors.New("duration(초) 또는 runtime(분)이 필요합니다 (TMDB 목록 응답에는 runtime이 없습니다)")
+	}
+	if movie.PosterPath != "" {
+		entry.ThumbnailURL = tmdbImageBaseURL + movie.PosterPath
+	}
+	if len(movie.ReleaseDate) >= 4 {
+		year, err := strconv.Atoi(movie.ReleaseDate[:4])
+		if err != nil {
+			return entry, errors.New("release_date가 올바르지 않습니다: " + movie.ReleaseDate)
+		}
+		entry.ReleaseYear = year
+	}
+	if movie.Adult {
+		entry.MaturityLevel = model.MaturityLevelAdult
+	}
+
+	genreIDs := movie.GenreIDs
+	for _, genre := range movie.Genres {
+		genreIDs = append(genreIDs, genre.ID)
+	}
+	for _, id := range genreIDs {
+		name, ok := tmdbGenreNames[id]
+		if !ok {
+			return entry, fmt.Errorf("알 수 없는 TMDB 장르 ID: %d", id)
+		}
+		entry.Genres = append(entry.Genres, name)
+	}
+	return entry, nil
+}
+
+// WriteCatalog 카탈로그 항목을 CSV 또는 JSON으로 쓰기
+func WriteCatalog(w io.Writer, format string, entries []model.CatalogEntry) error {
+	switch format {
+	case CatalogFormatCSV:
+		writer := csv.NewWriter(w)
+		if err := writer.Write(catalogCSVColumns); err != nil {
+			return err
+		}
+		for _, entry := range entries {
+			if err := writer.Write([]string{
+				entry.ExternalID, entry.Title, entry.Description, entry.ThumbnailURL, entry.VideoURL,
+				strconv.Itoa(entry.Duration), strconv.Itoa(entry.ReleaseYear), strconv.Itoa(entry.MaturityLevel),
+				strings.Join(entry.Genres, catalogCSVGenreSeparator),
+			}); err != nil {
+				return err
+			}
+		}
+		writer.Flush()
+		return writer.Error()
+	case CatalogFormatJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(entries)
+	default:
+		return ErrUnknownCatalogFormat
+	}
+}
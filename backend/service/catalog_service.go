@@ -0,0 +1,239 @@
+package service
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+
+	"backend/model"
+)
+
+// DefaultCatalogBatchSize 한 트랜잭션에서 저장하는 기본 콘텐츠 수
+const DefaultCatalogBatchSize = 100
+
+// 카탈로그 변경 종류
+const (
+	CatalogActionCreate    = "create"    // 새 콘텐츠
+	CatalogActionUpdate    = "update"    // 기존 콘텐츠 변경
+	CatalogActionUnchanged = "unchanged" // 변경 없음
+)
+
+// ErrInvalidCatalog 카탈로그 파일에 줄 단위 오류가 있음
+var ErrInvalidCatalog = errors.New("카탈로그 파일에 오류가 있습니다")
+
+// CatalogChange 카탈로그 항목 하나의 변경 내역
+type CatalogChange struct {
+	Line       int
+	Action     string
+	ExternalID string
+	Title      string
+	Fields     []string // 변경된 필드 (update인 경우)
+	content    model.CatalogContent
+}
+
+// CatalogPlan 카탈로그 가져오기 계획 (dry-run 결과이자 Apply 입력)
+type CatalogPlan struct {
+	Changes   []CatalogChange
+	NewGenres []string // 새로 만들어질 장르
+}
+
+// Count 변경 종류별 항목 수
+func (p *CatalogPlan) Count(action string) int {
+	count := 0
+	for _, change := range p.Changes {
+		if change.Action == action {
+			count++
+		}
+	}
+	return count
+}
+
+// CatalogService 카탈로그 일괄 가져오기/내보내기 서비스
+type CatalogService struct {
+	DB        *sql.DB
+	BatchSize int
+}
+
+// NewCatalogService 새 CatalogService 생성 (batchSize가 0 이하이면 기본값)
+func NewCatalogService(db *sql.DB, batchSize int) *CatalogService {
+	if batchSize <= 0 {
+		batchSize = DefaultCatalogBatchSize
+	}
+	return &CatalogService{
+		DB:        db,
+		BatchSize: batchSize,
+	}
+}
+
+// Plan 읽은 항목을 검증하고 저장된 콘텐츠와 비교하여 가져오기 계획 생성
+// 검증에 실패한 줄이 있으면 줄 단위 오류와 ErrInvalidCatalog 반환
+func (s *CatalogService) Plan(records []CatalogRecord) (*CatalogPlan, []CatalogLineError, error) {
+	// 항목 검증 (외부 ID 중복 포함)
+	var lineErrors []CatalogLineError
+	firstLine := map[string]int{}
+	for i := range records {
+		entry := &records[i].Entry
+		entry.Normalize()
+		if err := entry.Validate(); err != nil {
+			lineErrors = append(lineErrors, CatalogLineError{Line: records[i].Line, Err: err})
+			continue
+		}
+		if line, ok := firstLine[entry.ExternalID]; ok {
+			lineErrors = append(lineErrors, CatalogLineError{
+				Line: records[i].Line,
+				Err:  fmt.Errorf("external_id %s가 %d번째 줄과 중복됩니다", entry.ExternalID, line),
+			})
+			continue
+		}
+		firstLine[entry.ExternalID] = records[i].Line
+	}
+	if len(lineErrors) > 0 {
+		return nil, lineErrors, ErrInvalidCatalog
+	}
+
+	plan := &CatalogPlan{NewGenres: []string{}}
+	genreNames := []string{}
+	seenGenres := map[string]bool{}
+	for start := 0; start < len(records); start += s.BatchSize {
+		batch := records[start:minInt(start+s.BatchSize, len(records))]
+
+		externalIDs := make([]string, 0, len(batch))
+		for _, record := range batch {
+			externalIDs = append(externalIDs, record.Entry.ExternalID)
+		}
+		existing, err := model.GetCatalogContentsByExternalIDs(s.DB, externalIDs)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, record := range batch {
+			change := CatalogChange{
+				Line:       record.Line,
+				Action:     CatalogActionCreate,
+				ExternalID: record.Entry.ExternalID,
+				Title:      record.Entry.Title,
+				content:    model.CatalogContent{CatalogEntry: record.Entry},
+			}
+			if current, ok := existing[record.Entry.ExternalID]; ok {
+				change.content.ID = current.ID
+				change.Fields = catalogChangedFields(current.CatalogEntry, record.Entry)
+				change.Action = CatalogActionUpdate
+				if len(change.Fields) == 0 {
+					change.Action = CatalogActionUnchanged
+				}
+			}
+			plan.Changes = append(plan.Changes, change)
+
+			for _, genre := range record.Entry.Genres {
+				if !seenGenres[genre] {
+					seenGenres[genre] = true
+					genreNames = append(genreNames, genre)
+				}
+			}
+		}
+	}
+
+	// 새로 만들어질 장르 확인
+	for start := 0; start < len(genreNames); start += s.BatchSize {
+		batch := genreNames[start:minInt(start+s.BatchSize, len(genreNames))]
+		existing, err := model.GetExistingGenreNames(s.DB, batch)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, name := range batch {
+			if !existing[name] {
+				plan.NewGenres = append(plan.NewGenres, name)
+			}
+		}
+	}
+	sort.Strings(plan.NewGenres)
+
+	return plan, nil, nil
+}
+
+// Apply 가져오기 계획 중 추가/변경 항목을 배치 단위 트랜잭션으로 저장 후 저장한 항목 수 반환
+// 외부 ID로 기존 콘텐츠를 찾으므로 같은 파일을 다시 가져와도 결과가 같음
+func (s *CatalogService) Apply(plan *CatalogPlan) (int, error) {
+	var pending []model.CatalogContent
+	for _, change := range plan.Changes {
+		if change.Action != CatalogActionUnchanged {
+			pending = append(pending, change.content)
+		}
+	}
+
+	applied := 0
+	for start := 0; start < len(pending); start += s.BatchSize {
+		batch := pending[start:minInt(start+s.BatchSize, len(pending))]
+		if err := model.UpsertCatalogContents(s.DB, batch); err != nil {
+			return applied, err
+		}
+		applied += len(batch)
+	}
+	return applied, nil
+}
+
+// Export 전체 콘텐츠를 카탈로그 항목으로 조회
+func (s *CatalogService) Export() ([]model.CatalogEntry, error) {
+	contents, err := model.ExportCatalog(s.DB)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]model.CatalogEntry, 0, len(contents))
+	for _, content := range contents {
+		entries = append(entries, content.CatalogEntry)
+	}
+	return entries, nil
+}
+
+// catalogChangedFields 저장된 항목과 다른 필드 이름 목록
+func catalogChangedFields(current, next model.CatalogEntry) []string {
+	var fields []string
+	if current.Title != next.Title {
+		fields = append(fields, "title")
+	}
+	if current.Description != next.Description {
+		fields = append(fields, "description")
+	}
+	if current.ThumbnailURL != next.ThumbnailURL {
+		fields = append(fields, "thumbnail_url")
+	}
+	if current.VideoURL != next.VideoURL {
+		fields = append(fields, "video_url")
+	}
+	if current.Duration != next.Duration {
+		fields = append(fields, "duration")
+	}
+	if current.ReleaseYear != next.ReleaseYear {
+		fields = append(fields, "release_year")
+	}
+	if current.MaturityLevel != next.MaturityLevel {
+		fields = append(fields, "maturity_level")
+	}
+	if !equalStrings(current.Genres, next.Genres) {
+		fields = append(fields, "genres")
+	}
+	return fields
+}
+
+// equalStrings 두 문자열 목록이 순서까지 같은지 확인
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// minInt 두 정수 중 작은 값
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,137 @@
+package service
+
+import (
+	"database/sql"
+	"errors"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"backend/config"
+	"backend/helper"
+	"backend/model"
+)
+
+// 공개 알림 발송 설정
+const (
+	reminderBatchSize    = 100              // 한 번에 발송하는 공개 알림 최대 건수
+	reminderMaxAttempts  = 5                // 발송 실패 허용 횟수 (도달하면 더 발송하지 않음)
+	reminderRetryBase    = 5 * time.Minute  // 첫 실패 후 재시도 대기 시간 (실패할 때마다 두 배)
+	reminderClaimTimeout = 10 * time.Minute // 발송 작업이 가져간 뒤 완료/실패 기록이 없으면 다시 발송 대상이 되는 시간
+)
+
+// 공개 알림 관련 오류
+var (
+	ErrContentNotFound         = errors.New("콘텐츠를 찾을 수 없습니다")
+	ErrContentAlreadyPublic    = errors.New("이미 공개된 콘텐츠입니다")
+	ErrContentRegionRestricted = errors.New("현재 국가에서 이용할 수 없는 콘텐츠입니다")
+)
+
+// ContentReminderService 공개 예정 콘텐츠의 공개 알림 서비스
+type ContentReminderService struct {
+	DB     *sql.DB
+	Mailer helper.Mailer
+	Config *config.Config
+}
+
+// NewContentReminderService 새 ContentReminderService 생성
+func NewContentReminderService(db *sql.DB, mailer helper.Mailer, cfg *config.Config) *ContentReminderService {
+	return &ContentReminderService{
+		DB:     db,
+		Mailer: mailer,
+		Config: cfg,
+	}
+}
+
+// SetReminder 공개 예정 콘텐츠의 공개 알림 신청
+// 공개 예정 목록과 같이 프로필 시청 등급(ErrMaturityRestricted)과 공개 시점의 국가별 이용 가능 여부(ErrContentRegionRestricted) 확인
+func (s *ContentReminderService) SetReminder(viewer model.Viewer, contentID int64) (*model.ContentSchedule, error) {
+	schedule, err := model.GetContentSchedule(s.DB, contentID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrContentNotFound
+		}
+		return nil, err
+	}
+	if schedule.PublishAt == nil || !schedule.PublishAt.After(time.Now()) {
+		return nil, ErrContentAlreadyPublic
+	}
+
+	allowed, available, err := model.GetUpcomingContentAccess(s.DB, viewer, contentID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrContentNotFound
+		}
+		return nil, err
+	}
+	if !allowed {
+		return nil, ErrMaturityRestricted
+	}
+	if !available {
+		return nil, ErrContentRegionRestricted
+	}
+
+	if err := model.SetContentReminder(s.DB, viewer.ProfileID, contentID); err != nil {
+		return nil, err
+	}
+	return schedule, nil
+}
+
+// CancelReminder 공개 알림 신청 취소
+func (s *ContentReminderService) CancelReminder(profileID, contentID int64) error {
+	return model.DeleteContentReminder(s.DB, profileID, contentID)
+}
+
+// SendDueReminders 공개된 콘텐츠의 공개 알림 메일 발송 후 발송 건수 반환
+// 발송할 알림을 먼저 가져가 여러 인스턴스에서 실행해도 같은 알림을 중복 발송하지 않으며,
+// 발송에 실패한 알림은 대기 시간을 늘려 가며 reminderMaxAttempts번까지 다시 시도 (실패한 알림이 대기열을 막지 않음)
+func (s *ContentReminderService) SendDueReminders() (int, error) {
+	reminders, err := model.ClaimDueContentReminders(s.DB, reminderBatchSize, reminderMaxAttempts, reminderClaimTimeout)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for _, reminder := range reminders {
+		if err := s.sendReminder(reminder); err != nil {
+			failures := reminder.Attempts + 1
+			if failures >= reminderMaxAttempts {
+				log.Printf("공개 알림 발송 포기 (알림 %d, %d회 실패): %v", reminder.ID, failures, err)
+			} else {
+				log.Printf("공개 알림 발송 실패 (알림 %d, %d회 실패): %v", reminder.ID, failures, err)
+			}
+			if err := model.MarkContentReminderFailed(s.DB, reminder.ID, time.Now().Add(reminderRetryDelay(failures))); err != nil {
+				return sent, err
+			}
+			continue
+		}
+		if err := model.MarkContentReminderNotified(s.DB, reminder.ID); err != nil {
+			return sent, err
+		}
+		sent++
+	}
+	return sent, nil
+}
+
+// sendReminder 공개 알림 메일 작성 후 발송
+func (s *ContentReminderService) sendReminder(reminder model.DueContentReminder) error {
+	subject, body, err := renderMail(reminder.Language, mailTemplateContentReminder, mailTemplateData{
+		Name:         reminder.ProfileName,
+		Link:         strings.TrimRight(s.Config.Mail.LinkBaseURL, "/") + "/contents/" + strconv.FormatInt(reminder.ContentID, 10),
+		ContentTitle: reminder.ContentTitle,
+	})
+	if err != nil {
+		return err
+	}
+	return s.Mailer.Send(helper.MailMessage{To: reminder.Email, Subject: subject, Body: body})
+}
+
+// reminderRetryDelay 발송 실패 횟수에 따른 재시도 대기 시간 (실패할 때마다 두 배)
+func reminderRetryDelay(failures int) time.Duration {
+	delay := reminderRetryBase
+	for i := 1; i < failures; i++ {
+		delay *= 2
+	}
+	return delay
+}
@@ -4,6 +4,8 @@ import (
 	"database/sql"
 	"errors"
 	"log"
+	"net/url"
+	"strconv"
 	"time"
 
 	"backend/model"
@@ -22,7 +24,9 @@ func NewContentService(db *sql.DB) *ContentService {
 }
 
 // GetStreamingURL 콘텐츠 스트리밍 URL 조회
-func (s *ContentService) GetStreamingURL(contentID int64, userID int64) (*model.StreamingResponse, error) {
+// 계정의 구독 요금제를 확인해 재생을 거부하거나(ErrSubscriptionRequired, ErrSubscriptionExpired, ErrStreamLimitExceeded)
+// 새 재생 세션을 시작하고 요금제의 최대 화질로 제한된 URL을 반환
+func (s *ContentService) GetStreamingURL(contentID int64, profileID int64) (*model.StreamingResponse, error) {
 	// 콘텐츠 정보 조회
 	var videoURL string
 	var duration int
@@ -36,22 +40,42 @@ func (s *ContentService) GetStreamingURL(contentID int64, userID int64) (*model.
 		return nil, errors.New("콘텐츠를 찾을 수 없습니다")
 	}
 
+	// 구독 요금제 확인
+	userID, err := model.GetProfileUserID(s.DB, profileID)
+	if err != nil {
+		return nil, err
+	}
+	subscriptionService := NewSubscriptionService(s.DB)
+	plan, err := subscriptionService.CheckEntitlement(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 동시 시청 수 확인 후 재생 세션 시작
+	streamID, err := subscriptionService.StartStream(userID, profileID, contentID, plan)
+	if err != nil {
+		return nil, err
+	}
+
 	// 시청 기록 조회 (마지막 시청 위치)
 	var lastPosition sql.NullInt64
 	err = s.DB.QueryRow(`
 		SELECT last_position
 		FROM ViewingHistories
-		WHERE user_id = ? AND content_id = ?
+		WHERE profile_id = ? AND content_id = ?
 		ORDER BY watched_at DESC
 		LIMIT 1
-	`, userID, contentID).Scan(&lastPosition)
+	`, profileID, contentID).Scan(&lastPosition)
 
 	// 결과 구성
 	response := &model.StreamingResponse{
-		ContentID:    contentID,
-		StreamingURL: videoURL,
-		Duration:     duration,
-		LastPosition: 0,
+		ContentID:     contentID,
+		StreamingURL:  limitResolution(videoURL, plan.MaxResolution),
+		Duration:      duration,
+		LastPosition:  0,
+		Plan:          plan.Code,
+		MaxResolution: plan.MaxResolution,
+		StreamID:      streamID,
 	}
 
 	// 마지막 시청 위치가 있으면 설정
@@ -63,56 +87,116 @@ func (s *ContentService) GetStreamingURL(contentID int64, userID int64) (*model.
 	now := time.Now()
 	_, err = s.DB.Exec(`
 		INSERT INTO ViewingHistories 
-		(user_id, content_id, watch_duration, last_position, watched_at, is_completed)
+		(profile_id, content_id, watch_duration, last_position, watched_at, is_completed)
 		VALUES (?, ?, 0, ?, ?, false)
 		ON DUPLICATE KEY UPDATE
 		last_position = VALUES(last_position), watched_at = VALUES(watched_at), is_completed = VALUES(is_completed)
-	`, userID, contentID, response.LastPosition, now)
+	`, profileID, contentID, response.LastPosition, now)
 
 	if err != nil {
 		log.Printf("시청 기록 초기화 실패: %v", err)
 		// 실패해도 스트리밍은 계속 진행
 	}
 
+	// 스트리밍 시작 인기도 반영
+	s.recordPopularity(profileID, contentID, model.PopularityEventStreamStart, now)
+
 	return response, nil
 }
 
 // UpdatePlaybackPosition 재생 위치 업데이트
-func (s *ContentService) UpdatePlaybackPosition(userID int64, req *model.PlaybackPositionRequest) error {
+func (s *ContentService) UpdatePlaybackPosition(profileID int64, req *model.PlaybackPositionRequest) error {
 	// 현재 시간
 	now := time.Now()
 
+	// 시청 완료로 바뀌는 경우에만 인기도에 반영하기 위해 이전 완료 여부 확인
+	wasCompleted := s.isCompleted(profileID, req.ContentID)
+
 	// 시청 기록 업데이트
 	_, err := s.DB.Exec(`
 		UPDATE ViewingHistories
 		SET last_position = ?, watch_duration = ?, watched_at = ?, is_completed = ?
-		WHERE user_id = ? AND content_id = ?
-	`, req.CurrentPosition, req.WatchDuration, now, req.IsCompleted, userID, req.ContentID)
+		WHERE profile_id = ? AND content_id = ?
+	`, req.CurrentPosition, req.WatchDuration, now, req.IsCompleted, profileID, req.ContentID)
 
 	if err != nil {
 		log.Printf("재생 위치 업데이트 실패: %v", err)
 		return errors.New("재생 위치 업데이트 실패")
 	}
 
+	if req.IsCompleted && !wasCompleted {
+		s.recordPopularity(profileID, req.ContentID, model.PopularityEventCompletion, now)
+	}
+
+	// 재생 중임을 갱신 (동시 시청 수 계산)
+	if err := model.TouchStream(s.DB, profileID, req.ContentID, req.StreamID); err != nil {
+		log.Printf("재생 확인 시각 갱신 실패: %v", err)
+	}
+
 	return nil
 }
 
 // SaveFinalPosition 최종 재생 위치 저장
-func (s *ContentService) SaveFinalPosition(userID int64, req *model.FinalPositionRequest) error {
+func (s *ContentService) SaveFinalPosition(profileID int64, req *model.FinalPositionRequest) error {
 	// 현재 시간
 	now := time.Now()
 
+	// 시청 완료로 바뀌는 경우에만 인기도에 반영하기 위해 이전 완료 여부 확인
+	wasCompleted := s.isCompleted(profileID, req.ContentID)
+
 	// 최종 시청 기록 업데이트
 	_, err := s.DB.Exec(`
 		UPDATE ViewingHistories
 		SET last_position = ?, watch_duration = ?, watched_at = ?, is_completed = ?
-		WHERE user_id = ? AND content_id = ?
-	`, req.FinalPosition, req.WatchDuration, now, req.IsCompleted, userID, req.ContentID)
+		WHERE profile_id = ? AND content_id = ?
+	`, req.FinalPosition, req.WatchDuration, now, req.IsCompleted, profileID, req.ContentID)
 
 	if err != nil {
 		log.Printf("최종 재생 위치 저장 실패: %v", err)
 		return errors.New("최종 재생 위치 저장 실패")
 	}
 
+	if req.IsCompleted && !wasCompleted {
+		s.recordPopularity(profileID, req.ContentID, model.PopularityEventCompletion, now)
+	}
+
+	// 재생 종료로 동시 시청 수에서 제외
+	if err := model.EndStream(s.DB, profileID, req.ContentID, req.StreamID); err != nil {
+		log.Printf("재생 종료 기록 실패: %v", err)
+	}
+
 	return nil
 }
+
+// isCompleted 시청 기록의 현재 완료 여부 조회 (기록이 없으면 false)
+func (s *ContentService) isCompleted(profileID, contentID int64) bool {
+	var completed bool
+	err := s.DB.QueryRow(`
+		SELECT is_completed
+		FROM ViewingHistories
+		WHERE profile_id = ? AND content_id = ?
+	`, profileID, contentID).Scan(&completed)
+	if err != nil {
+		return false
+	}
+	return completed
+}
+
+// recordPopularity 프로필의 인기도 이벤트 반영 (같은 프로필의 반복 이벤트는 일정 시간에 한 번만, 실패해도 재생 흐름은 계속 진행)
+func (s *ContentService) recordPopularity(profileID, contentID int64, event model.PopularityEvent, at time.Time) {
+	if _, err := model.RecordProfilePopularityEvent(s.DB, profileID, contentID, event, at); err != nil {
+		log.Printf("인기도 반영 실패: %v", err)
+	}
+}
+
+// limitResolution 스트리밍 URL에 최대 화질 파라미터 추가 (플레이어/CDN이 해당 화질 이하로만 재생)
+func limitResolution(videoURL string, maxResolution int) string {
+	parsed, err := url.Parse(videoURL)
+	if err != nil {
+		return videoURL
+	}
+	query := parsed.Query()
+	query.Set("max_resolution", strconv.Itoa(maxResolution))
+	parsed.RawQuery = query.Encode()
+	return parsed.String()
+}
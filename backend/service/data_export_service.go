@@ -0,0 +1,255 @@
+package service
+
+import (
+	"archive/zip"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"backend/config"
+	"backend/model"
+)
+
+// exportTimeFormat 내보내기 CSV의 일시 형식
+const exportTimeFormat = time.RFC3339
+
+// dataExportStaleAfter 생성 중인 요청을 중단된 것으로 보는 시간 (서버 재시작 등으로 생성이 끊긴 경우 새 요청 허용)
+const dataExportStaleAfter = time.Hour
+
+// DataExportService 개인정보 내보내기(ZIP) 생성 서비스
+type DataExportService struct {
+	DB     *sql.DB
+	Config *config.Config
+}
+
+// NewDataExportService 새 DataExportService 생성
+func NewDataExportService(db *sql.DB, cfg *config.Config) *DataExportService {
+	return &DataExportService{
+		DB:     db,
+		Config: cfg,
+	}
+}
+
+// RequestExport 내보내기 요청 생성 (이미 생성 중인 요청이 있으면 그 요청 반환)
+// 새 요청이면 created가 true이며, 호출자가 Build로 파일을 생성해야 함
+func (s *DataExportService) RequestExport(userID int64) (export *model.DataExport, created bool, err error) {
+	export, err = model.GetPendingDataExport(s.DB, userID, time.Now().Add(-dataExportStaleAfter))
+	if err == nil {
+		return export, false, nil
+	}
+	if err != sql.ErrNoRows {
+		return nil, false, err
+	}
+
+	expiresAt := time.Now().Add(time.Duration(s.Config.DataExportExpireHours) * time.Hour)
+	export, err = model.CreateDataExport(s.DB, userID, expiresAt)
+	if err != nil {
+		return nil, false, err
+	}
+	return export, true, nil
+}
+
+// Build 내보내기 ZIP 파일 생성 후 완료 처리 (실패하면 실패 상태로 기록)
+func (s *DataExportService) Build(export *model.DataExport) error {
+	path, size, err := s.writeArchive(export)
+	if err != nil {
+		if failErr := model.FailDataExport(s.DB, export.ID); failErr != nil {
+			log.Printf("내보내기 실패 상태 저장 실패: %v", failErr)
+		}
+		return err
+	}
+
+	if err := model.CompleteDataExport(s.DB, export.ID, path, size); err != nil {
+		removeExportFile(path)
+		return err
+	}
+	return nil
+}
+
+// PurgeExpired 보관 기간이 지난 내보내기 파일과 요청 삭제
+func (s *DataExportService) PurgeExpired() error {
+	exports, err := model.GetExpiredDataExports(s.DB)
+	if err != nil {
+		return err
+	}
+
+	for _, export := range exports {
+		if err := removeExportFile(export.FilePath); err != nil {
+			return err
+		}
+		if err := model.DeleteDataExport(s.DB, export.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeArchive 계정 정보, 프로필, 시청 기록, 찜 목록, 로그인 세션을 JSON과 CSV로 담은 ZIP 파일 작성
+func (s *DataExportService) writeArchive(export *model.DataExport) (string, int64, error) {
+	user, err := model.GetUserByID(s.DB, export.UserID)
+	if err != nil {
+		return "", 0, err
+	}
+	profiles, err := model.GetProfilesByUserID(s.DB, export.UserID)
+	if err != nil {
+		return "", 0, err
+	}
+	histories, err := model.GetExportedViewingHistories(s.DB, export.UserID)
+	if err != nil {
+		return "", 0, err
+	}
+	wishlist, err := model.GetExportedWishlist(s.DB, export.UserID)
+	if err != nil {
+		return "", 0, err
+	}
+	sessions, err := model.GetExportedSessions(s.DB, export.UserID)
+	if err != nil {
+		return "", 0, err
+	}
+
+	if err := os.MkdirAll(s.Config.DataExportDir, 0o700); err != nil {
+		return "", 0, err
+	}
+	path := filepath.Join(s.Config.DataExportDir, fmt.Sprintf("export-%d-%d.zip", export.UserID, export.ID))
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+	if err != nil {
+		return "", 0, err
+	}
+
+	archive := zip.NewWriter(file)
+	err = writeExportTables(archive, user.ToUserResponse(), profiles, histories, wishlist, sessions)
+	if closeErr := archive.Close(); err == nil {
+		err = closeErr
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		removeExportFile(path)
+		return "", 0, err
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", 0, err
+	}
+	return path, info.Size(), nil
+}
+
+// exportTable ZIP에 JSON과 CSV로 함께 저장할 데이터
+type exportTable struct {
+	name   string
+	value  interface{}
+	header []string
+	rows   [][]string
+}
+
+// writeExportTables 각 데이터를 name.json, name.csv로 저장
+func writeExportTables(archive *zip.Writer, account model.UserResponse, profiles []model.Profile,
+	histories []model.ExportedViewingHistory, wishlist []model.ExportedWishlistItem, sessions []model.ExportedSession) error {
+	tables := []exportTable{
+		{
+			name:   "account",
+			value:  account,
+			header: []string{"id", "email", "name", "created_at", "email_verified"},
+			rows: [][]string{{
+				strconv.FormatInt(account.ID, 10), account.Email, account.Name,
+				account.CreatedAt.Format(exportTimeFormat), strconv.FormatBool(account.EmailVerified),
+			}},
+		},
+		{
+			name:   "profiles",
+			value:  profiles,
+			header: []string{"id", "name", "language", "maturity_level", "is_default", "is_kids", "created_at"},
+		},
+		{
+			name:   "viewing_history",
+			value:  histories,
+			header: []string{"profile_id", "profile_name", "content_id", "title", "watch_duration", "last_position", "is_completed", "watched_at"},
+		},
+		{
+			name:   "wishlist",
+			value:  wishlist,
+			header: []string{"profile_id", "profile_name", "content_id", "title", "added_at"},
+		},
+		{
+			name:   "sessions",
+			value:  sessions,
+			header: []string{"device_name", "user_agent", "ip_address", "created_at", "last_seen_at", "revoked_at"},
+		},
+	}
+
+	for _, p := range profiles {
+		tables[1].rows = append(tables[1].rows, []string{
+			strconv.FormatInt(p.ID, 10), p.Name, p.Language, strconv.Itoa(p.MaturityLevel),
+			strconv.FormatBool(p.IsDefault), strconv.FormatBool(p.IsKids), p.CreatedAt.Format(exportTimeFormat),
+		})
+	}
+	for _, h := range histories {
+		tables[2].rows = append(tables[2].rows, []string{
+			strconv.FormatInt(h.ProfileID, 10), h.ProfileName, strconv.FormatInt(h.ContentID, 10), h.Title,
+			strconv.Itoa(h.WatchDuration), strconv.Itoa(h.LastPosition), strconv.FormatBool(h.IsCompleted),
+			h.WatchedAt.Format(exportTimeFormat),
+		})
+	}
+	for _, w := range wishlist {
+		tables[3].rows = append(tables[3].rows, []string{
+			strconv.FormatInt(w.ProfileID, 10), w.ProfileName, strconv.FormatInt(w.ContentID, 10), w.Title,
+			w.AddedAt.Format(exportTimeFormat),
+		})
+	}
+	for _, session := range sessions {
+		revokedAt := ""
+		if session.RevokedAt != nil {
+			revokedAt = session.RevokedAt.Format(exportTimeFormat)
+		}
+		tables[4].rows = append(tables[4].rows, []string{
+			session.DeviceName, session.UserAgent, session.IPAddress,
+			session.CreatedAt.Format(exportTimeFormat), session.LastSeenAt.Format(exportTimeFormat), revokedAt,
+		})
+	}
+
+	for _, table := range tables {
+		if err := table.write(archive); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// write 데이터를 JSON 파일과 CSV 파일로 ZIP에 추가
+func (t exportTable) write(archive *zip.Writer) error {
+	jsonFile, err := archive.Create(t.name + ".json")
+	if err != nil {
+		return err
+	}
+	encoder := json.NewEncoder(jsonFile)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(t.value); err != nil {
+		return err
+	}
+
+	csvFile, err := archive.Create(t.name + ".csv")
+	if err != nil {
+		return err
+	}
+	// 엑셀에서 한글이 깨지지 않도록 UTF-8 BOM 추가
+	if _, err := io.WriteString(csvFile, "\ufeff"); err != nil {
+		return err
+	}
+	writer := csv.NewWriter(csvFile)
+	if err := writer.Write(t.header); err != nil {
+		return err
+	}
+	if err := writer.WriteAll(t.rows); err != nil {
+		return err
+	}
+	return writer.Error()
+}
@@ -0,0 +1,181 @@
+package service
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+
+	"backend/config"
+	"backend/model"
+)
+
+// HomeService 홈 화면 구성 서비스
+type HomeService struct {
+	DB     *sql.DB
+	Config config.HomeConfig
+}
+
+// NewHomeService 새 HomeService 생성
+func NewHomeService(db *sql.DB, homeConfig config.HomeConfig) *HomeService {
+	return &HomeService{
+		DB:     db,
+		Config: homeConfig,
+	}
+}
+
+// BuildHome 프로필에 맞는 홈 화면 행 목록 구성
+// 로그인 여부에 따라 설정된 행 구성을 사용하며, 콘텐츠가 없는 행은 제외
+func (s *HomeService) BuildHome(viewer model.Viewer) (*model.HomeResponse, error) {
+	rowConfigs := s.Config.AnonymousRows
+	if viewer.ProfileID > 0 {
+		rowConfigs = s.Config.AuthenticatedRows
+	}
+
+	rows := []model.HomeRow{}
+	for _, rowConfig := range rowConfigs {
+		built, err := s.buildRows(viewer, rowConfig)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, built...)
+	}
+
+	return &model.HomeResponse{
+		IsAuthenticated: viewer.ProfileID > 0,
+		Rows:            rows,
+	}, nil
+}
+
+// buildRows 행 정의 하나로부터 행 목록 생성 (장르/기반 콘텐츠 유형은 여러 행 생성)
+func (s *HomeService) buildRows(viewer model.Viewer, rowConfig config.HomeRowConfig) ([]model.HomeRow, error) {
+	profileID := viewer.ProfileID
+	limit := rowConfig.Limit
+	if limit <= 0 {
+		limit = 20
+	}
+	count := rowConfig.Count
+	if count <= 0 {
+		count = 1
+	}
+
+	switch rowConfig.Type {
+	case config.HomeRowContinueWatching:
+		if profileID == 0 {
+			return nil, nil
+		}
+		items, err := model.GetContinueWatching(s.DB, viewer, limit)
+		if err != nil || len(items) == 0 {
+			return nil, err
+		}
+		return []model.HomeRow{newHomeRow(rowConfig, rowConfig.Type, "", items)}, nil
+
+	case config.HomeRowMyList:
+		if profileID == 0 {
+			return nil, nil
+		}
+		page, err := model.GetWishlistByCursor(s.DB, viewer, "", limit)
+		if err != nil {
+			return nil, err
+		}
+		items := page.Content.([]model.ContentListResponse)
+		if len(items) == 0 {
+			return nil, nil
+		}
+		return []model.HomeRow{newHomeRow(rowConfig, rowConfig.Type, "", items)}, nil
+
+	case config.HomeRowTrending:
+		items, err := model.GetTrendingContents(s.DB, viewer, limit)
+		if err != nil || len(items) == 0 {
+			return nil, err
+		}
+		return []model.HomeRow{newHomeRow(rowConfig, rowConfig.Type, "", items)}, nil
+
+	case config.HomeRowNewReleases:
+		items, err := model.GetNewReleases(s.DB, viewer, limit)
+		if err != nil || len(items) == 0 {
+			return nil, err
+		}
+		return []model.HomeRow{newHomeRow(rowConfig, rowConfig.Type, "", items)}, nil
+
+	case config.HomeRowTopGenres:
+		genres, err := model.GetTopGenres(s.DB, viewer, count)
+		if err != nil {
+			return nil, err
+		}
+		rows := []model.HomeRow{}
+		for _, genre := range genres {
+			pageInfo, err := model.GetContentsByGenre(s.DB, genre.ID, viewer, 0, limit, model.ContentSortPopularity)
+			if err != nil {
+				return nil, err
+			}
+			items := pageInfo.Content.([]model.ContentListResponse)
+			if len(items) == 0 {
+				continue
+			}
+			key := fmt.Sprintf("%s:%d", rowConfig.Type, genre.ID)
+			rows = append(rows, newHomeRow(rowConfig, key, genre.Name, items))
+		}
+		return rows, nil
+
+	case config.HomeRowBecauseYouWatched:
+		if profileID == 0 {
+			return nil, nil
+		}
+		watched, err := model.GetRecentlyWatchedContents(s.DB, viewer, count)
+		if err != nil {
+			return nil, err
+		}
+		rows := []model.HomeRow{}
+		for _, content := range watched {
+			items, err := model.GetBecauseYouWatched(s.DB, viewer, content.ContentID, limit)
+			if err != nil {
+				return nil, err
+			}
+			if len(items) == 0 {
+				continue
+			}
+			key := fmt.Sprintf("%s:%d", rowConfig.Type, content.ContentID)
+			rows = append(rows, newHomeRow(rowConfig, key, content.Title, items))
+		}
+		return rows, nil
+
+	case config.HomeRowCollections:
+		collections, err := model.GetActiveCollections(s.DB)
+		if err != nil {
+			return nil, err
+		}
+		rows := []model.HomeRow{}
+		for _, collection := range collections {
+			if len(rows) >= count {
+				break
+			}
+			items, err := model.GetCollectionContents(s.DB, collection.ID, viewer, limit)
+			if err != nil {
+				return nil, err
+			}
+			if len(items) == 0 {
+				continue
+			}
+			row := newHomeRow(rowConfig, rowConfig.Type+":"+collection.Slug, collection.Title, items)
+			row.HeroImageURL = collection.HeroImageURL
+			rows = append(rows, row)
+		}
+		return rows, nil
+	}
+
+	log.Printf("알 수 없는 홈 화면 행 유형: %s", rowConfig.Type)
+	return nil, nil
+}
+
+// newHomeRow 행 정의와 조회 결과로 홈 화면 행 생성 (제목의 첫 %s만 장르명/콘텐츠명으로 대체, 나머지 %는 그대로)
+func newHomeRow(rowConfig config.HomeRowConfig, key, name string, items interface{}) model.HomeRow {
+	title := strings.Replace(rowConfig.Title, "%s", name, 1)
+
+	return model.HomeRow{
+		Key:   key,
+		Type:  rowConfig.Type,
+		Title: title,
+		Items: items,
+	}
+}
@@ -0,0 +1,361 @@
+package service
+
+import (
+	"database/sql"
+	"errors"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"backend/config"
+	"backend/helper"
+	"backend/model"
+)
+
+// 로그인 보호 관련 오류
+var (
+	ErrUserNotFound             = errors.New("사용자를 찾을 수 없습니다")
+	ErrTwoFactorChallengeClosed = errors.New("로그인 시간이 만료되었습니다. 다시 로그인해 주세요")
+)
+
+// LoginBlockedError 로그인 시도가 일시적으로 거부된 경우의 오류 (RetryAfter 후 다시 시도 가능)
+type LoginBlockedError struct {
+	RetryAfter time.Duration // 다시 시도할 수 있을 때까지 남은 시간
+	Locked     bool          // 계정 잠금 여부 (false면 실패 반복에 따른 대기)
+}
+
+// Error 오류 메시지
+func (e *LoginBlockedError) Error() string {
+	if e.Locked {
+		return "로그인 실패가 반복되어 계정이 일시적으로 잠겼습니다. 잠시 후 다시 시도해 주세요"
+	}
+	return "로그인 시도가 너무 많습니다. 잠시 후 다시 시도해 주세요"
+}
+
+// AttemptsExceededError 보호자 PIN 등 추가 확인 절차의 실패가 반복되어 일시적으로 거부된 경우의 오류
+type AttemptsExceededError struct {
+	Subject    string        // 확인 대상 (예: 보호자 PIN)
+	RetryAfter time.Duration // 다시 시도할 수 있을 때까지 남은 시간
+}
+
+// Error 오류 메시지
+func (e *AttemptsExceededError) Error() string {
+	return e.Subject + " 확인 실패가 반복되어 일시적으로 제한되었습니다. 잠시 후 다시 시도해 주세요"
+}
+
+// 로그인 실패 저장소 키 종류
+const (
+	loginKeyFailures    = "fail"     // 연속 실패 횟수
+	loginKeyBackoff     = "backoff"  // 실패 반복에 따른 대기
+	loginKeyLock        = "lock"     // 계정 잠금
+	loginKeyPINFailures = "pin-fail" // 보호자 PIN 연속 실패 횟수
+	loginKeyPINLock     = "pin-lock" // 보호자 PIN 확인 잠금
+
+	loginKeyTwoFactorFailures = "2fa-fail"    // 계정별 2단계 인증 코드 연속 실패 횟수
+	loginKeyTwoFactorLock     = "2fa-lock"    // 계정별 2단계 인증 코드 확인 잠금
+	loginKeyChallengeAttempts = "2fa-attempt" // 대기 토큰별 코드 확인 횟수
+	loginKeyChallengeClosed   = "2fa-closed"  // 로그인을 완료했거나 더 시도할 수 없는 대기 토큰
+)
+
+// 추가 확인 절차 제한 오류에 표시할 확인 대상
+const (
+	parentalPINSubject = "보호자 PIN"
+	twoFactorSubject   = "2단계 인증 코드"
+)
+
+// LoginGuardService 계정/IP별 로그인 실패를 추적하여 대기 시간과 계정 잠금을 적용하는 서비스
+type LoginGuardService struct {
+	DB     *sql.DB
+	Store  helper.AttemptStore
+	Config config.LoginProtectionConfig
+}
+
+// NewLoginGuardService 새 LoginGuardService 생성
+func NewLoginGuardService(db *sql.DB, store helper.AttemptStore, cfg config.LoginProtectionConfig) *LoginGuardService {
+	return &LoginGuardService{
+		DB:     db,
+		Store:  store,
+		Config: cfg,
+	}
+}
+
+// Check 로그인 시도 가능 여부 확인 (잠금/대기 중이면 *LoginBlockedError 반환)
+// 저장소 오류 시에는 로그인을 막지 않음
+func (s *LoginGuardService) Check(email, ip string) error {
+	account := normalizeLoginEmail(email)
+	checks := []struct {
+		key    string
+		locked bool
+	}{
+		{loginKey(loginKeyLock, "account", account), true},
+		{loginKey(loginKeyBackoff, "account", account), false},
+		{loginKey(loginKeyBackoff, "ip", ip), false},
+	}
+
+	for _, check := range checks {
+		remaining, err := s.Store.BlockedFor(check.key)
+		if err != nil {
+			log.Printf("로그인 차단 상태 조회 실패: %v", err)
+			continue
+		}
+		if remaining > 0 {
+			return &LoginBlockedError{RetryAfter: remaining, Locked: check.locked}
+		}
+	}
+	return nil
+}
+
+// RecordFailure 로그인 실패 기록 후 적용된 대기/잠금 반환 (없으면 nil)
+// 계정 실패가 잠금 기준에 도달하면 계정을 잠그고 감사 기록을 남김
+func (s *LoginGuardService) RecordFailure(email, ip string) *LoginBlockedError {
+	account := normalizeLoginEmail(email)
+	window := time.Duration(s.Config.FailureWindowMinutes) * time.Minute
+
+	accountFailures, err := s.Store.Increment(loginKey(loginKeyFailures, "account", account), window)
+	if err != nil {
+		log.Printf("로그인 실패 기록 실패: %v", err)
+		return nil
+	}
+	ipFailures, err := s.Store.Increment(loginKey(loginKeyFailures, "ip", ip), window)
+	if err != nil {
+		log.Printf("로그인 실패 기록 실패: %v", err)
+	}
+
+	if accountFailures >= int64(s.Config.AccountLockThreshold) {
+		return s.lock(account, ip, accountFailures)
+	}
+
+	blocked := &LoginBlockedError{}
+	if delay := s.backoff(accountFailures, s.Config.FreeAttempts); delay > 0 {
+		if err := s.Store.Block(loginKey(loginKeyBackoff, "account", account), delay); err != nil {
+			log.Printf("로그인 대기 설정 실패: %v", err)
+		}
+		blocked.RetryAfter = delay
+	}
+	if delay := s.backoff(ipFailures, s.Config.IPFreeAttempts); delay > 0 {
+		if err := s.Store.Block(loginKey(loginKeyBackoff, "ip", ip), delay); err != nil {
+			log.Printf("로그인 대기 설정 실패: %v", err)
+		}
+		if delay > blocked.RetryAfter {
+			blocked.RetryAfter = delay
+		}
+	}
+
+	if blocked.RetryAfter == 0 {
+		return nil
+	}
+	return blocked
+}
+
+// RecordSuccess 로그인 성공 시 계정의 실패 기록 초기화
+// IP 실패 횟수는 유지하여 한 계정의 성공으로 다른 계정 대입 기록이 지워지지 않도록 함
+func (s *LoginGuardService) RecordSuccess(email string) {
+	account := normalizeLoginEmail(email)
+	if err := s.Store.Reset(
+		loginKey(loginKeyFailures, "account", account),
+		loginKey(loginKeyBackoff, "account", account),
+	); err != nil {
+		log.Printf("로그인 실패 기록 초기화 실패: %v", err)
+	}
+}
+
+// Unlock 관리자가 계정 잠금과 실패 기록을 해제
+func (s *LoginGuardService) Unlock(userID, adminID int64) error {
+	user, err := model.GetUserByID(s.DB, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ErrUserNotFound
+		}
+		return err
+	}
+
+	account := normalizeLoginEmail(user.Email)
+	if err := s.Store.Reset(
+		loginKey(loginKeyLock, "account", account),
+		loginKey(loginKeyFailures, "account", account),
+		loginKey(loginKeyBackoff, "account", account),
+	); err != nil {
+		return err
+	}
+
+	log.Printf("계정 잠금 해제: user_id=%d admin_id=%d", userID, adminID)
+	return model.UnlockAccountLockouts(s.DB, userID, adminID)
+}
+
+// CheckParentalPIN 보호자 PIN 확인 가능 여부 (잠금 중이면 *AttemptsExceededError, 저장소 오류 시에는 막지 않음)
+func (s *LoginGuardService) CheckParentalPIN(userID int64) error {
+	return s.checkAttempts(loginKey(loginKeyPINLock, "user", userKey(userID)), parentalPINSubject)
+}
+
+// RecordParentalPINFailure 보호자 PIN 실패 기록 (허용 횟수에 도달하면 잠그고 *AttemptsExceededError 반환)
+func (s *LoginGuardService) RecordParentalPINFailure(userID int64) *AttemptsExceededError {
+	return s.recordAttemptFailure(
+		loginKey(loginKeyPINFailures, "user", userKey(userID)),
+		loginKey(loginKeyPINLock, "user", userKey(userID)),
+		s.Config.ParentalPINMaxAttempts, parentalPINSubject,
+	)
+}
+
+// RecordParentalPINSuccess 보호자 PIN 확인 성공 시 실패 기록 초기화
+func (s *LoginGuardService) RecordParentalPINSuccess(userID int64) {
+	if err := s.Store.Reset(loginKey(loginKeyPINFailures, "user", userKey(userID))); err != nil {
+		log.Printf("보호자 PIN 실패 기록 초기화 실패: %v", err)
+	}
+}
+
+// BeginTwoFactorAttempt 2단계 인증 코드 확인 전 시도 가능 여부 확인 후 대기 토큰의 시도 횟수 기록
+// 계정이 잠겼으면 *AttemptsExceededError, 대기 토큰을 이미 사용했거나 시도 횟수를 모두 썼으면 ErrTwoFactorChallengeClosed 반환
+func (s *LoginGuardService) BeginTwoFactorAttempt(userID int64, challengeID string) error {
+	if err := s.checkAttempts(loginKey(loginKeyTwoFactorLock, "user", userKey(userID)), twoFactorSubject); err != nil {
+		return err
+	}
+
+	remaining, err := s.Store.BlockedFor(loginKey(loginKeyChallengeClosed, "challenge", challengeID))
+	if err != nil {
+		log.Printf("2단계 인증 대기 토큰 상태 조회 실패: %v", err)
+	} else if remaining > 0 {
+		return ErrTwoFactorChallengeClosed
+	}
+
+	attempts, err := s.Store.Increment(loginKey(loginKeyChallengeAttempts, "challenge", challengeID), helper.TwoFactorChallengeTTL)
+	if err != nil {
+		log.Printf("2단계 인증 시도 기록 실패: %v", err)
+		return nil
+	}
+	if attempts > int64(s.Config.TwoFactorChallengeMaxAttempts) {
+		return ErrTwoFactorChallengeClosed
+	}
+	return nil
+}
+
+// RecordTwoFactorFailure 2단계 인증 코드 실패 기록 (허용 횟수에 도달하면 계정과 대기 토큰을 잠그고 *AttemptsExceededError 반환)
+func (s *LoginGuardService) RecordTwoFactorFailure(userID int64, challengeID string) *AttemptsExceededError {
+	exceeded := s.recordAttemptFailure(
+		loginKey(loginKeyTwoFactorFailures, "user", userKey(userID)),
+		loginKey(loginKeyTwoFactorLock, "user", userKey(userID)),
+		s.Config.TwoFactorMaxAttempts, twoFactorSubject,
+	)
+	if exceeded != nil {
+		s.closeChallenge(challengeID)
+	}
+	return exceeded
+}
+
+// RecordTwoFactorSuccess 2단계 인증 성공 시 대기 토큰을 사용 완료 처리하고 계정의 실패 기록 초기화
+func (s *LoginGuardService) RecordTwoFactorSuccess(userID int64, challengeID string) {
+	s.closeChallenge(challengeID)
+	if err := s.Store.Reset(loginKey(loginKeyTwoFactorFailures, "user", userKey(userID))); err != nil {
+		log.Printf("2단계 인증 실패 기록 초기화 실패: %v", err)
+	}
+}
+
+// closeChallenge 대기 토큰이 만료될 때까지 다시 사용할 수 없도록 표시
+func (s *LoginGuardService) closeChallenge(challengeID string) {
+	if err := s.Store.Block(loginKey(loginKeyChallengeClosed, "challenge", challengeID), helper.TwoFactorChallengeTTL); err != nil {
+		log.Printf("2단계 인증 대기 토큰 사용 처리 실패: %v", err)
+	}
+}
+
+// checkAttempts 추가 확인 절차의 잠금 상태 확인
+func (s *LoginGuardService) checkAttempts(lockKey, subject string) error {
+	remaining, err := s.Store.BlockedFor(lockKey)
+	if err != nil {
+		log.Printf("%s 차단 상태 조회 실패: %v", subject, err)
+		return nil
+	}
+	if remaining > 0 {
+		return &AttemptsExceededError{Subject: subject, RetryAfter: remaining}
+	}
+	return nil
+}
+
+// recordAttemptFailure 추가 확인 절차의 실패 기록 (maxAttempts번 연속 실패하면 계정 잠금 시간 동안 잠금)
+func (s *LoginGuardService) recordAttemptFailure(failureKey, lockKey string, maxAttempts int, subject string) *AttemptsExceededError {
+	failures, err := s.Store.Increment(failureKey, time.Duration(s.Config.FailureWindowMinutes)*time.Minute)
+	if err != nil {
+		log.Printf("%s 실패 기록 실패: %v", subject, err)
+		return nil
+	}
+	if failures < int64(maxAttempts) {
+		return nil
+	}
+
+	duration := time.Duration(s.Config.AccountLockMinutes) * time.Minute
+	if err := s.Store.Block(lockKey, duration); err != nil {
+		log.Printf("%s 잠금 설정 실패: %v", subject, err)
+	}
+	if err := s.Store.Reset(failureKey); err != nil {
+		log.Printf("%s 실패 기록 초기화 실패: %v", subject, err)
+	}
+	log.Printf("%s 확인 잠금: key=%s failures=%d", subject, failureKey, failures)
+	return &AttemptsExceededError{Subject: subject, RetryAfter: duration}
+}
+
+// lock 계정을 잠그고 감사 기록 저장
+func (s *LoginGuardService) lock(account, ip string, failures int64) *LoginBlockedError {
+	duration := time.Duration(s.Config.AccountLockMinutes) * time.Minute
+	if err := s.Store.Block(loginKey(loginKeyLock, "account", account), duration); err != nil {
+		log.Printf("계정 잠금 설정 실패: %v", err)
+	}
+	// 잠금 해제 후에는 처음부터 다시 계산
+	if err := s.Store.Reset(
+		loginKey(loginKeyFailures, "account", account),
+		loginKey(loginKeyBackoff, "account", account),
+	); err != nil {
+		log.Printf("로그인 실패 기록 초기화 실패: %v", err)
+	}
+
+	// 가입되지 않은 이메일도 같은 방식으로 잠가 계정 존재 여부가 드러나지 않도록 함
+	lockout := &model.AccountLockout{
+		Email:          account,
+		IPAddress:      ip,
+		FailedAttempts: int(failures),
+		LockedUntil:    time.Now().Add(duration),
+	}
+	user, err := model.GetUserByEmail(s.DB, account)
+	if err == nil {
+		lockout.UserID = &user.ID
+	} else if err != sql.ErrNoRows {
+		log.Printf("잠금 대상 사용자 조회 실패: %v", err)
+	}
+	if err := model.CreateAccountLockout(s.DB, lockout); err != nil {
+		log.Printf("계정 잠금 기록 저장 실패: %v", err)
+	}
+	log.Printf("계정 잠금: email=%s ip=%s failures=%d", account, ip, failures)
+
+	return &LoginBlockedError{RetryAfter: duration, Locked: true}
+}
+
+// backoff 연속 실패 횟수에 따른 대기 시간 (허용 횟수 초과 시 실패할 때마다 두 배, 최대값 제한)
+func (s *LoginGuardService) backoff(failures int64, free int) time.Duration {
+	over := failures - int64(free)
+	if over <= 0 {
+		return 0
+	}
+
+	delay := time.Duration(s.Config.BackoffBaseSeconds) * time.Second
+	max := time.Duration(s.Config.BackoffMaxSeconds) * time.Second
+	for i := int64(1); i < over && delay < max; i++ {
+		delay *= 2
+	}
+	if delay > max {
+		delay = max
+	}
+	return delay
+}
+
+// loginKey 로그인 실패 저장소 키 생성
+func loginKey(kind, scope, id string) string {
+	return "login:" + kind + ":" + scope + ":" + id
+}
+
+// userKey 사용자 ID 기준 저장소 키 값
+func userKey(userID int64) string {
+	return strconv.FormatInt(userID, 10)
+}
+
+// normalizeLoginEmail 대소문자/공백이 다른 같은 이메일을 하나로 취급
+func normalizeLoginEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
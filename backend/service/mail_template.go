@@ -0,0 +1,153 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// 메일 템플릿 종류
+const (
+	mailTemplatePasswordReset     = "password_reset"
+	mailTemplateEmailVerification = "email_verification"
+	mailTemplateContentReminder   = "content_reminder"
+)
+
+// 메일 언어
+const (
+	mailLanguageKorean  = "ko"
+	mailLanguageEnglish = "en"
+)
+
+// mailTemplateData 메일 템플릿 데이터
+type mailTemplateData struct {
+	Name          string // 수신자 이름
+	Link          string // 처리 링크
+	ExpiresInText string // 링크 유효 기간 표시
+	ContentTitle  string // 콘텐츠 제목 (공개 알림)
+}
+
+// mailTemplate 언어별 메일 제목/본문 템플릿
+type mailTemplate struct {
+	Subject string
+	Body    *template.Template
+}
+
+// mailTemplates 언어 → 종류 → 템플릿
+var mailTemplates = map[string]map[string]mailTemplate{
+	mailLanguageKorean: {
+		mailTemplatePasswordReset: {
+			Subject: "[MiniFlix] 비밀번호 재설정 안내",
+			Body: template.Must(template.New("ko_password_reset").Parse(`{{.Name}}님, 안녕하세요.
+
+비밀번호 재설정 요청을 받았습니다. 아래 링크에서 새 비밀번호를 설정해 주세요.
+
+{{.Link}}
+
+이 링크는 {{.ExpiresInText}} 동안 한 번만 사용할 수 있습니다.
+요청하지 않으셨다면 이 메일을 무시하셔도 됩니다.
+
+MiniFlix 드림`)),
+		},
+		mailTemplateEmailVerification: {
+			Subject: "[MiniFlix] 이메일 주소를 인증해 주세요",
+			Body: template.Must(template.New("ko_email_verification").Parse(`{{.Name}}님, MiniFlix에 가입해 주셔서 감사합니다.
+
+아래 링크를 눌러 이메일 주소 인증을 완료해 주세요.
+
+{{.Link}}
+
+이 링크는 {{.ExpiresInText}} 동안 유효합니다.
+
+MiniFlix 드림`)),
+		},
+		mailTemplateContentReminder: {
+			Subject: "[MiniFlix] 기다리시던 콘텐츠가 공개되었습니다",
+			Body: template.Must(template.New("ko_content_reminder").Parse(`{{.Name}}님, 안녕하세요.
+
+공개 알림을 신청하신 「{{.ContentTitle}}」이(가) 지금 공개되었습니다.
+
+{{.Link}}
+
+MiniFlix 드림`)),
+		},
+	},
+	mailLanguageEnglish: {
+		mailTemplatePasswordReset: {
+			Subject: "[MiniFlix] Reset your password",
+			Body: template.Must(template.New("en_password_reset").Parse(`Hi {{.Name}},
+
+We received a request to reset your password. Use the link below to choose a new one.
+
+{{.Link}}
+
+This link can be used once and expires in {{.ExpiresInText}}.
+If you didn't request this, you can safely ignore this email.
+
+The MiniFlix Team`)),
+		},
+		mailTemplateEmailVerification: {
+			Subject: "[MiniFlix] Verify your email address",
+			Body: template.Must(template.New("en_email_verification").Parse(`Hi {{.Name}}, thanks for signing up for MiniFlix.
+
+Please confirm your email address by opening the link below.
+
+{{.Link}}
+
+This link expires in {{.ExpiresInText}}.
+
+The MiniFlix Team`)),
+		},
+		mailTemplateContentReminder: {
+			Subject: "[MiniFlix] A title you're waiting for is now available",
+			Body: template.Must(template.New("en_content_reminder").Parse(`Hi {{.Name}},
+
+"{{.ContentTitle}}", which you asked us to remind you about, is now available to watch.
+
+{{.Link}}
+
+The MiniFlix Team`)),
+		},
+	},
+}
+
+// mailLanguage Accept-Language 등 언어 값을 지원하는 메일 언어로 변환 (기본값: 한국어)
+func mailLanguage(language string) string {
+	if strings.HasPrefix(strings.ToLower(strings.TrimSpace(language)), mailLanguageEnglish) {
+		return mailLanguageEnglish
+	}
+	return mailLanguageKorean
+}
+
+// renderMail 언어와 종류에 맞는 메일 제목과 본문 생성
+func renderMail(language, kind string, data mailTemplateData) (string, string, error) {
+	tmpl := mailTemplates[mailLanguage(language)][kind]
+
+	var body strings.Builder
+	if err := tmpl.Body.Execute(&body, data); err != nil {
+		return "", "", err
+	}
+	return tmpl.Subject, body.String(), nil
+}
+
+// formatMailDuration 링크 유효 기간을 메일 언어에 맞게 표시 (시간 단위로 나누어떨어지면 시간, 아니면 분)
+func formatMailDuration(language string, d time.Duration) string {
+	english := mailLanguage(language) == mailLanguageEnglish
+	if d%time.Hour == 0 {
+		hours := int(d / time.Hour)
+		if english {
+			if hours == 1 {
+				return "1 hour"
+			}
+			return fmt.Sprintf("%d hours", hours)
+		}
+		return fmt.Sprintf("%d시간", hours)
+	}
+
+	minutes := int(d / time.Minute)
+	if english {
+		return fmt.Sprintf("%d minutes", minutes)
+	}
+	return fmt.Sprintf("%d분", minutes)
+}
@@ -0,0 +1,185 @@
+package service
+
+import (
+	"context"
+	"crypto/subtle"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"backend/config"
+	"backend/helper"
+	"backend/model"
+)
+
+// 소셜 로그인 관련 오류
+var (
+	ErrOIDCProviderNotFound     = errors.New("지원하지 않는 로그인 제공자입니다")
+	ErrOIDCInvalidState         = errors.New("로그인 요청이 만료되었거나 유효하지 않습니다. 다시 시도해 주세요")
+	ErrOIDCAuthenticationFailed = errors.New("소셜 로그인 인증에 실패했습니다")
+	ErrOIDCEmailNotVerified     = errors.New("제공자 계정에 인증된 이메일이 없어 로그인할 수 없습니다")
+	ErrOIDCAccountConflict      = errors.New("같은 이메일로 가입된 계정이 있습니다. 비밀번호로 로그인하여 이메일 인증을 완료한 뒤 다시 시도해 주세요")
+)
+
+// maxUserNameLength Users.name 컬럼 최대 길이
+const maxUserNameLength = 50
+
+// OIDCService 소셜 로그인(OpenID Connect) 서비스
+type OIDCService struct {
+	DB     *sql.DB
+	Config *config.Config
+}
+
+// NewOIDCService 새 OIDCService 생성
+func NewOIDCService(db *sql.DB, cfg *config.Config) *OIDCService {
+	return &OIDCService{
+		DB:     db,
+		Config: cfg,
+	}
+}
+
+// Providers 사용 가능한 소셜 로그인 제공자 목록
+func (s *OIDCService) Providers() []model.OIDCProviderResponse {
+	providers := []model.OIDCProviderResponse{}
+	for _, provider := range s.Config.OIDCProviders {
+		if _, ok := s.Config.OIDCProvider(provider.Name); !ok {
+			continue
+		}
+		displayName := provider.DisplayName
+		if displayName == "" {
+			displayName = provider.Name
+		}
+		providers = append(providers, model.OIDCProviderResponse{Name: provider.Name, DisplayName: displayName})
+	}
+	return providers
+}
+
+// Authorize 새 state/nonce를 만들고 제공자 로그인 페이지 URL과 상태 토큰 반환
+func (s *OIDCService) Authorize(ctx context.Context, providerName string) (*model.OIDCAuthorizeResponse, error) {
+	provider, ok := s.Config.OIDCProvider(providerName)
+	if !ok {
+		return nil, ErrOIDCProviderNotFound
+	}
+
+	state, err := helper.NewOIDCState(provider.Name)
+	if err != nil {
+		return nil, err
+	}
+	stateToken, err := helper.GenerateOIDCStateToken(state, s.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	authURL, err := helper.GetOIDCClient(*provider).AuthCodeURL(ctx, state.State, state.Nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.OIDCAuthorizeResponse{
+		AuthorizationURL: authURL,
+		StateToken:       stateToken,
+		ExpiresIn:        int64(helper.OIDCStateTTL.Seconds()),
+	}, nil
+}
+
+// Authenticate 콜백의 state를 확인하고 인가 코드로 제공자 계정을 인증한 뒤 연결된 계정 반환
+// 연결된 계정이 없으면 제공자가 인증한 이메일로 기존 계정에 연결하거나 새 계정 생성
+func (s *OIDCService) Authenticate(ctx context.Context, providerName string, req *model.OIDCCallbackRequest) (*model.User, error) {
+	provider, ok := s.Config.OIDCProvider(providerName)
+	if !ok {
+		return nil, ErrOIDCProviderNotFound
+	}
+
+	// 시작 요청과 같은 제공자/state인지 확인
+	state, err := helper.ValidateOIDCStateToken(req.StateToken, s.Config)
+	if err != nil || state.Provider != provider.Name ||
+		subtle.ConstantTimeCompare([]byte(state.State), []byte(req.State)) != 1 {
+		return nil, ErrOIDCInvalidState
+	}
+
+	identity, err := helper.GetOIDCClient(*provider).Authenticate(ctx, req.Code, state.Nonce)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrOIDCAuthenticationFailed, err)
+	}
+
+	return s.resolveUser(provider.Name, identity)
+}
+
+// resolveUser 제공자 계정에 연결된 계정 조회 (없으면 인증된 이메일 기준으로 연결/생성)
+func (s *OIDCService) resolveUser(providerName string, identity *helper.OIDCIdentity) (*model.User, error) {
+	linked, err := model.GetUserIdentity(s.DB, providerName, identity.Subject)
+	if err == nil {
+		if err := model.TouchUserIdentity(s.DB, linked.ID); err != nil {
+			log.Printf("소셜 로그인 일시 갱신 실패: %v", err)
+		}
+		return model.GetUserByID(s.DB, linked.UserID)
+	}
+	if err != sql.ErrNoRows {
+		return nil, err
+	}
+
+	// 제공자가 소유를 확인한 이메일만 계정 연결에 사용
+	if identity.Email == "" || !identity.EmailVerified {
+		return nil, ErrOIDCEmailNotVerified
+	}
+
+	user, err := model.GetUserByEmail(s.DB, identity.Email)
+	switch {
+	case err == sql.ErrNoRows:
+		user, err = s.createUser(identity)
+		if err != nil {
+			return nil, err
+		}
+	case err != nil:
+		return nil, err
+	case !user.EmailVerified:
+		// 다른 사람이 이메일을 선점해 만든 미인증 계정에 연결되지 않도록 거부
+		return nil, ErrOIDCAccountConflict
+	}
+
+	if err := model.CreateUserIdentity(s.DB, &model.UserIdentity{
+		UserID:   user.ID,
+		Provider: providerName,
+		Subject:  identity.Subject,
+		Email:    identity.Email,
+	}); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// createUser 제공자 계정 정보로 새 계정 생성
+// 비밀번호는 무작위 값으로 설정되며, 비밀번호 로그인이 필요하면 비밀번호 재설정으로 지정
+func (s *OIDCService) createUser(identity *helper.OIDCIdentity) (*model.User, error) {
+	password, err := helper.GenerateRandomToken(32)
+	if err != nil {
+		return nil, err
+	}
+
+	name := identity.Name
+	if name == "" {
+		name = strings.SplitN(identity.Email, "@", 2)[0]
+	}
+	if runes := []rune(name); len(runes) > maxUserNameLength {
+		name = string(runes[:maxUserNameLength])
+	}
+
+	user, err := model.CreateUser(s.DB, &model.RegisterRequest{
+		Email:    identity.Email,
+		Password: password,
+		Name:     name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := model.MarkEmailVerified(s.DB, user.ID); err != nil {
+		return nil, err
+	}
+	user.EmailVerified = true
+
+	return user, nil
+}
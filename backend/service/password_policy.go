@@ -0,0 +1,128 @@
+package service
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"backend/config"
+	"backend/helper"
+	"backend/model"
+)
+
+// 비밀번호 정책 위반 코드
+const (
+	PasswordTooShort             = "too_short"              // 최소 길이 미달
+	PasswordTooLong              = "too_long"               // 최대 길이 초과
+	PasswordInsufficientClasses  = "insufficient_classes"   // 문자 종류 부족
+	PasswordContainsPersonalInfo = "contains_personal_info" // 이메일 아이디/이름 포함
+	PasswordBreached             = "breached"               // 유출된 비밀번호
+)
+
+// minPersonalInfoLength 비밀번호 포함 여부를 검사할 개인정보 최소 길이 (너무 짧은 이름은 제외)
+const minPersonalInfoLength = 3
+
+// PasswordPolicyError 비밀번호 정책 위반 (필드별 오류 목록 포함)
+type PasswordPolicyError struct {
+	Fields []model.FieldError
+}
+
+// Error 오류 메시지
+func (e *PasswordPolicyError) Error() string {
+	return "비밀번호가 보안 정책을 충족하지 않습니다"
+}
+
+// PasswordPolicy 설정된 비밀번호 정책 검사기
+type PasswordPolicy struct {
+	Config   config.PasswordPolicyConfig
+	Breached helper.BreachedPasswordSource // nil이면 유출 여부 검사 생략
+}
+
+// NewPasswordPolicy 설정의 비밀번호 정책으로 PasswordPolicy 생성
+func NewPasswordPolicy(cfg *config.Config) *PasswordPolicy {
+	return &PasswordPolicy{
+		Config:   cfg.PasswordPolicy,
+		Breached: helper.GetBreachedPasswordSource(cfg.PasswordPolicy.BreachedPasswordFile),
+	}
+}
+
+// Validate 비밀번호가 정책을 충족하는지 검사 (위반 시 *PasswordPolicyError)
+// field는 오류에 표시할 요청 필드 이름, email/name은 비밀번호에 포함되면 안 되는 개인정보
+func (p *PasswordPolicy) Validate(field, password, email, name string) error {
+	var fields []model.FieldError
+	violate := func(code, message string) {
+		fields = append(fields, model.FieldError{Field: field, Code: code, Message: message})
+	}
+
+	if utf8.RuneCountInString(password) < p.Config.MinLength {
+		violate(PasswordTooShort, fmt.Sprintf("비밀번호는 최소 %d자 이상이어야 합니다", p.Config.MinLength))
+	}
+	if p.Config.MaxLength > 0 && len(password) > p.Config.MaxLength {
+		violate(PasswordTooLong, fmt.Sprintf("비밀번호는 %d바이트를 넘을 수 없습니다", p.Config.MaxLength))
+	}
+	if countCharClasses(password) < p.Config.MinCharClasses {
+		violate(PasswordInsufficientClasses, fmt.Sprintf(
+			"비밀번호에는 영문 소문자, 대문자, 숫자, 특수문자 중 %d종류 이상이 포함되어야 합니다", p.Config.MinCharClasses))
+	}
+	if !p.Config.AllowPersonalInfo && containsPersonalInfo(password, email, name) {
+		violate(PasswordContainsPersonalInfo, "비밀번호에 이메일 아이디나 이름을 포함할 수 없습니다")
+	}
+
+	if p.Breached != nil {
+		count, err := helper.PasswordBreachCount(p.Breached, password)
+		if err != nil {
+			// 목록을 읽지 못해도 가입/변경은 막지 않음
+			log.Printf("유출 비밀번호 확인 실패: %v", err)
+		} else if count > 0 {
+			violate(PasswordBreached, "유출된 적이 있는 비밀번호입니다. 다른 비밀번호를 사용해 주세요")
+		}
+	}
+
+	if len(fields) > 0 {
+		return &PasswordPolicyError{Fields: fields}
+	}
+	return nil
+}
+
+// countCharClasses 비밀번호에 포함된 문자 종류 수 (소문자, 대문자, 숫자, 그 외 문자)
+func countCharClasses(password string) int {
+	var lower, upper, digit, other bool
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsDigit(r):
+			digit = true
+		default:
+			other = true
+		}
+	}
+
+	count := 0
+	for _, present := range []bool{lower, upper, digit, other} {
+		if present {
+			count++
+		}
+	}
+	return count
+}
+
+// containsPersonalInfo 비밀번호에 이메일 아이디나 이름(공백 제외)이 대소문자 구분 없이 포함되는지 확인
+func containsPersonalInfo(password, email, name string) bool {
+	lowered := strings.ToLower(password)
+
+	localPart, _, _ := strings.Cut(strings.ToLower(email), "@")
+	candidates := []string{localPart, strings.ToLower(strings.Join(strings.Fields(name), ""))}
+	candidates = append(candidates, strings.Fields(strings.ToLower(name))...)
+
+	for _, candidate := range candidates {
+		if utf8.RuneCountInString(candidate) >= minPersonalInfoLength && strings.Contains(lowered, candidate) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,222 @@
+package service
+
+import (
+	"database/sql"
+	"errors"
+
+	"backend/model"
+)
+
+// 프로필 관련 오류
+var (
+	ErrProfileNotFound      = errors.New("프로필을 찾을 수 없습니다")
+	ErrProfileLimitExceeded = errors.New("더 이상 프로필을 만들 수 없습니다")
+	ErrDefaultProfileDelete = errors.New("기본 프로필은 삭제할 수 없습니다")
+	ErrCurrentProfileDelete = errors.New("현재 사용 중인 프로필은 삭제할 수 없습니다")
+)
+
+// 보호자 통제 관련 오류
+var (
+	ErrParentalPINRequired = errors.New("보호자 PIN이 필요합니다")
+	ErrInvalidParentalPIN  = errors.New("보호자 PIN이 올바르지 않습니다")
+	ErrParentalPINNotSet   = errors.New("보호자 PIN이 설정되지 않았습니다")
+	ErrMaturityRestricted  = errors.New("시청 등급 제한으로 이용할 수 없는 콘텐츠입니다")
+	ErrInvalidPassword     = errors.New("현재 비밀번호가 일치하지 않습니다")
+)
+
+// ProfileService 시청자 프로필 관련 서비스
+type ProfileService struct {
+	DB          *sql.DB
+	MaxProfiles int
+	Guard       *LoginGuardService // 보호자 PIN 실패 제한 (nil이면 제한하지 않음)
+}
+
+// NewProfileService 새 ProfileService 생성
+func NewProfileService(db *sql.DB, maxProfiles int) *ProfileService {
+	return &ProfileService{
+		DB:          db,
+		MaxProfiles: maxProfiles,
+	}
+}
+
+// ListProfiles 계정의 프로필 목록 조회
+func (s *ProfileService) ListProfiles(userID int64) ([]model.Profile, error) {
+	return model.GetProfilesByUserID(s.DB, userID)
+}
+
+// GetProfile 계정에 속한 프로필 조회
+func (s *ProfileService) GetProfile(userID, profileID int64) (*model.Profile, error) {
+	profile, err := model.GetProfile(s.DB, userID, profileID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrProfileNotFound
+		}
+		return nil, err
+	}
+	return profile, nil
+}
+
+// CreateProfile 프로필 생성 (계정당 최대 프로필 수 제한)
+func (s *ProfileService) CreateProfile(userID int64, req *model.ProfileRequest) (*model.Profile, error) {
+	count, err := s.CountProfiles(userID)
+	if err != nil {
+		return nil, err
+	}
+	if count >= s.MaxProfiles {
+		return nil, ErrProfileLimitExceeded
+	}
+
+	return model.CreateProfile(s.DB, userID, req, count == 0)
+}
+
+// CountProfiles 계정의 프로필 수 조회
+func (s *ProfileService) CountProfiles(userID int64) (int, error) {
+	return model.CountProfiles(s.DB, userID)
+}
+
+// UpdateProfile 프로필 수정 후 수정된 프로필 반환
+func (s *ProfileService) UpdateProfile(userID, profileID int64, req *model.ProfileRequest) (*model.Profile, error) {
+	if _, err := s.GetProfile(userID, profileID); err != nil {
+		return nil, err
+	}
+
+	if err := model.UpdateProfile(s.DB, userID, profileID, req); err != nil {
+		return nil, err
+	}
+
+	return s.GetProfile(userID, profileID)
+}
+
+// DeleteProfile 프로필 삭제 (기본 프로필과 현재 사용 중인 프로필은 삭제 불가)
+func (s *ProfileService) DeleteProfile(userID, profileID, currentProfileID int64) error {
+	profile, err := s.GetProfile(userID, profileID)
+	if err != nil {
+		return err
+	}
+	if profile.IsDefault {
+		return ErrDefaultProfileDelete
+	}
+	if profile.ID == currentProfileID {
+		return ErrCurrentProfileDelete
+	}
+
+	return model.DeleteProfile(s.DB, userID, profileID)
+}
+
+// EnsureDefaultProfile 계정의 기본 프로필 조회 (프로필 도입 이전 계정이면 계정 이름으로 생성)
+func (s *ProfileService) EnsureDefaultProfile(user *model.User) (*model.Profile, error) {
+	profile, err := model.GetDefaultProfile(s.DB, user.ID)
+	if err == nil {
+		return profile, nil
+	}
+	if err != sql.ErrNoRows {
+		return nil, err
+	}
+
+	return model.CreateProfile(s.DB, user.ID, &model.ProfileRequest{Name: user.Name}, true)
+}
+
+// SetParentalPIN 계정 비밀번호 확인 후 보호자 PIN 설정
+func (s *ProfileService) SetParentalPIN(userID int64, req *model.ParentalPINRequest) error {
+	user, err := model.GetUserByID(s.DB, userID)
+	if err != nil {
+		return err
+	}
+	if !model.CheckPasswordHash(req.CurrentPassword, user.Password) {
+		return ErrInvalidPassword
+	}
+
+	return model.SetParentalPIN(s.DB, userID, req.PIN)
+}
+
+// VerifyParentalPIN 보호자 PIN 확인 (Guard가 있으면 연속 실패 시 일정 시간 잠금)
+func (s *ProfileService) VerifyParentalPIN(userID int64, pin string) error {
+	hash, err := model.GetParentalPINHash(s.DB, userID)
+	if err != nil {
+		return err
+	}
+	if hash == "" {
+		return ErrParentalPINNotSet
+	}
+	if pin == "" {
+		return ErrParentalPINRequired
+	}
+
+	// 반복 실패로 잠긴 경우 PIN을 비교하지 않음
+	if s.Guard != nil {
+		if err := s.Guard.CheckParentalPIN(userID); err != nil {
+			return err
+		}
+	}
+	if !model.CheckPasswordHash(pin, hash) {
+		if s.Guard != nil {
+			if exceeded := s.Guard.RecordParentalPINFailure(userID); exceeded != nil {
+				return exceeded
+			}
+		}
+		return ErrInvalidParentalPIN
+	}
+	if s.Guard != nil {
+		s.Guard.RecordParentalPINSuccess(userID)
+	}
+	return nil
+}
+
+// AuthorizeFromKidsProfile 키즈 프로필에서 보호자 권한이 필요한 작업(프로필 전환/관리) 허용 여부 확인
+// 현재 프로필이 키즈 프로필이 아니거나 보호자 PIN이 설정되지 않은 계정이면 허용
+// 현재 프로필이 삭제되었거나 다른 계정의 프로필이면 ErrProfileNotFound
+func (s *ProfileService) AuthorizeFromKidsProfile(userID, currentProfileID int64, pin string) error {
+	current, err := model.GetProfile(s.DB, userID, currentProfileID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ErrProfileNotFound
+		}
+		return err
+	}
+	if !current.IsKids {
+		return nil
+	}
+
+	err = s.VerifyParentalPIN(userID, pin)
+	if errors.Is(err, ErrParentalPINNotSet) {
+		return nil
+	}
+	return err
+}
+
+// AuthorizeProfileSwitch 프로필 전환 허용 여부 확인 (키즈 프로필에서 일반 프로필로 전환 시 보호자 PIN 필요)
+func (s *ProfileService) AuthorizeProfileSwitch(userID, currentProfileID int64, target *model.Profile, pin string) error {
+	if target.IsKids {
+		return nil
+	}
+	return s.AuthorizeFromKidsProfile(userID, currentProfileID, pin)
+}
+
+// AuthorizeContent 콘텐츠 이용 허용 여부 확인 (콘텐츠가 없으면 sql.ErrNoRows)
+func (s *ProfileService) AuthorizeContent(userID, profileID, contentID int64, pin string) error {
+	contentLevel, err := model.GetContentMaturityLevel(s.DB, contentID)
+	if err != nil {
+		return err
+	}
+	return s.AuthorizeMaturityLevel(userID, profileID, contentLevel, pin)
+}
+
+// AuthorizeMaturityLevel 프로필 시청 등급을 초과하는 콘텐츠 이용 허용 여부 확인 (보호자 PIN 확인 시 허용)
+func (s *ProfileService) AuthorizeMaturityLevel(userID, profileID int64, contentLevel int, pin string) error {
+	profile, err := model.GetProfile(s.DB, userID, profileID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ErrProfileNotFound
+		}
+		return err
+	}
+	if contentLevel <= profile.MaturityLevel {
+		return nil
+	}
+
+	err = s.VerifyParentalPIN(userID, pin)
+	if errors.Is(err, ErrParentalPINNotSet) || errors.Is(err, ErrParentalPINRequired) {
+		return ErrMaturityRestricted
+	}
+	return err
+}
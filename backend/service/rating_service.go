@@ -0,0 +1,55 @@
+package service
+
+import (
+	"database/sql"
+	"errors"
+
+	"backend/model"
+)
+
+// 콘텐츠 평가 관련 오류
+var (
+	ErrContentNotRateable = errors.New("아직 공개되지 않은 콘텐츠는 평가할 수 없습니다")
+	ErrRatingNotFound     = errors.New("평가 기록이 없습니다")
+)
+
+// RatingService 사용자 콘텐츠 평가(엄지, 별점) 서비스
+type RatingService struct {
+	DB *sql.DB
+}
+
+// NewRatingService 새 RatingService 생성
+func NewRatingService(db *sql.DB) *RatingService {
+	return &RatingService{
+		DB: db,
+	}
+}
+
+// SetRating 프로필의 콘텐츠 평가 저장 (공개 중인 콘텐츠만 평가 가능)
+func (s *RatingService) SetRating(profileID, contentID int64, req *model.UserRatingRequest) (*model.UserRatingResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	published, err := model.IsContentPublished(s.DB, contentID)
+	if err == sql.ErrNoRows {
+		return nil, ErrContentNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !published {
+		return nil, ErrContentNotRateable
+	}
+
+	return model.SetUserRating(s.DB, profileID, contentID, req)
+}
+
+// DeleteRating 프로필의 콘텐츠 평가 삭제 후 갱신된 평가 집계 반환
+func (s *RatingService) DeleteRating(profileID, contentID int64) (*model.UserRatingResponse, error) {
+	response, err := model.DeleteUserRating(s.DB, profileID, contentID)
+	if err == sql.ErrNoRows {
+		return nil, ErrRatingNotFound
+	}
+	return response, err
+}
@@ -0,0 +1,238 @@
+package service
+
+import (
+	"database/sql"
+	"log"
+	"math"
+	"sort"
+	"time"
+
+	"backend/model"
+)
+
+// 추천 유사도 계산 설정
+const (
+	similarityTopK    = 20  // 콘텐츠별로 저장할 유사 콘텐츠 수
+	genreScoreWeight  = 0.5 // 장르 겹침 유사도 가중치 (동시 발생 유사도보다 낮게 반영)
+	recommendationMax = 50  // 추천 목록 최대 크기
+)
+
+// RecommendationService 콘텐츠 추천 서비스
+type RecommendationService struct {
+	DB *sql.DB
+}
+
+// NewRecommendationService 새 RecommendationService 생성
+func NewRecommendationService(db *sql.DB) *RecommendationService {
+	return &RecommendationService{
+		DB: db,
+	}
+}
+
+// RecomputeSimilarities 시청 기록, 찜 목록, 평가로부터 콘텐츠 유사도를 다시 계산하여 저장
+func (s *RecommendationService) RecomputeSimilarities() error {
+	startedAt := time.Now()
+
+	interactions, err := model.LoadProfileInteractions(s.DB)
+	if err != nil {
+		return err
+	}
+	contentGenres, err := model.LoadContentGenreIDs(s.DB)
+	if err != nil {
+		return err
+	}
+
+	similarities := ComputeSimilarities(interactions, contentGenres, similarityTopK)
+	if err := model.ReplaceContentSimilarities(s.DB, similarities); err != nil {
+		return err
+	}
+
+	log.Printf("콘텐츠 유사도 계산 완료: %d건 (%v)", len(similarities), time.Since(startedAt))
+	return nil
+}
+
+// GetSimilarContents 유사 콘텐츠 조회 (유사도 계산 전이면 장르 기반으로 대체)
+func (s *RecommendationService) GetSimilarContents(contentID int64, viewer model.Viewer, limit int) ([]model.ContentListResponse, error) {
+	limit = normalizeRecommendationLimit(limit)
+
+	contents, err := model.GetSimilarContents(s.DB, contentID, viewer, limit)
+	if err != nil {
+		return nil, err
+	}
+	if len(contents) > 0 {
+		return contents, nil
+	}
+
+	return model.GetBecauseYouWatched(s.DB, viewer, contentID, limit)
+}
+
+// GetRecommendations 프로필 맞춤 추천 조회 (추천 결과가 없으면 인기 콘텐츠, 최신 콘텐츠 순으로 대체)
+func (s *RecommendationService) GetRecommendations(viewer model.Viewer, limit int) ([]model.ContentListResponse, error) {
+	limit = normalizeRecommendationLimit(limit)
+
+	contents, err := model.GetRecommendations(s.DB, viewer, limit)
+	if err != nil {
+		return nil, err
+	}
+	if len(contents) > 0 {
+		return contents, nil
+	}
+
+	contents, err = model.GetTrendingContents(s.DB, viewer, limit)
+	if err != nil {
+		return nil, err
+	}
+	if len(contents) > 0 {
+		return contents, nil
+	}
+
+	return model.GetNewReleases(s.DB, viewer, limit)
+}
+
+// ComputeSimilarities 아이템 기반 협업 필터링 유사도 계산
+// 같은 프로필이 시청/찜한 콘텐츠 쌍의 동시 발생 횟수를 코사인 유사도로 정규화하고,
+// 유사 콘텐츠가 topK개에 못 미치는 콘텐츠(콜드 스타트)는 장르 자카드 유사도로 보충
+func ComputeSimilarities(interactions map[int64][]int64, contentGenres map[int64][]int64, topK int) []model.ContentSimilarity {
+	// 콘텐츠별 상호작용 프로필 수와 콘텐츠 쌍별 동시 발생 횟수 집계
+	itemCounts := map[int64]int{}
+	coCounts := map[int64]map[int64]int{}
+	for _, contentIDs := range interactions {
+		items := uniqueIDs(contentIDs)
+		for _, i := range items {
+			itemCounts[i]++
+		}
+		for _, i := range items {
+			for _, j := range items {
+				if i == j {
+					continue
+				}
+				if coCounts[i] == nil {
+					coCounts[i] = map[int64]int{}
+				}
+				coCounts[i][j]++
+			}
+		}
+	}
+
+	// 장르별 콘텐츠 역색인 (장르 유사도 후보 탐색용)
+	genreContents := map[int64][]int64{}
+	for contentID, genreIDs := range contentGenres {
+		for _, genreID := range genreIDs {
+			genreContents[genreID] = append(genreContents[genreID], contentID)
+		}
+	}
+
+	// 계산 대상 콘텐츠 (카탈로그 전체 + 상호작용이 있는 콘텐츠)
+	contentIDs := []int64{}
+	for contentID := range contentGenres {
+		contentIDs = append(contentIDs, contentID)
+	}
+	for contentID := range itemCounts {
+		if _, ok := contentGenres[contentID]; !ok {
+			contentIDs = append(contentIDs, contentID)
+		}
+	}
+	sort.Slice(contentIDs, func(a, b int) bool { return contentIDs[a] < contentIDs[b] })
+
+	similarities := []model.ContentSimilarity{}
+	for _, i := range contentIDs {
+		// 동시 발생 기반 유사도
+		neighbours := []model.ContentSimilarity{}
+		for j, count := range coCounts[i] {
+			score := float64(count) / math.Sqrt(float64(itemCounts[i]*itemCounts[j]))
+			neighbours = append(neighbours, model.ContentSimilarity{
+				ContentID: i, SimilarContentID: j, Score: score, Source: model.SimilaritySourceCoOccurrence,
+			})
+		}
+		sortSimilarities(neighbours)
+		if len(neighbours) > topK {
+			neighbours = neighbours[:topK]
+		}
+
+		// 장르 기반 유사도로 부족한 이웃 보충
+		if len(neighbours) < topK {
+			existing := map[int64]bool{i: true}
+			for _, neighbour := range neighbours {
+				existing[neighbour.SimilarContentID] = true
+			}
+
+			genreNeighbours := []model.ContentSimilarity{}
+			for _, genreID := range contentGenres[i] {
+				for _, j := range genreContents[genreID] {
+					if existing[j] {
+						continue
+					}
+					existing[j] = true
+					score := jaccard(contentGenres[i], contentGenres[j]) * genreScoreWeight
+					genreNeighbours = append(genreNeighbours, model.ContentSimilarity{
+						ContentID: i, SimilarContentID: j, Score: score, Source: model.SimilaritySourceGenre,
+					})
+				}
+			}
+			sortSimilarities(genreNeighbours)
+			if remaining := topK - len(neighbours); len(genreNeighbours) > remaining {
+				genreNeighbours = genreNeighbours[:remaining]
+			}
+			neighbours = append(neighbours, genreNeighbours...)
+		}
+
+		similarities = append(similarities, neighbours...)
+	}
+
+	return similarities
+}
+
+// sortSimilarities 유사도 내림차순 정렬 (동점이면 콘텐츠 ID 오름차순)
+func sortSimilarities(similarities []model.ContentSimilarity) {
+	sort.Slice(similarities, func(a, b int) bool {
+		if similarities[a].Score != similarities[b].Score {
+			return similarities[a].Score > similarities[b].Score
+		}
+		return similarities[a].SimilarContentID < similarities[b].SimilarContentID
+	})
+}
+
+// jaccard 두 ID 집합의 자카드 유사도
+func jaccard(a, b []int64) float64 {
+	set := map[int64]bool{}
+	for _, id := range a {
+		set[id] = true
+	}
+	intersection := 0
+	union := len(set)
+	for _, id := range uniqueIDs(b) {
+		if set[id] {
+			intersection++
+		} else {
+			union++
+		}
+	}
+	if union == 0 {
+		return 0
+	}
+	return float64(intersection) / float64(union)
+}
+
+// uniqueIDs 중복 ID 제거 (순서 유지)
+func uniqueIDs(ids []int64) []int64 {
+	seen := map[int64]bool{}
+	unique := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
+
+// normalizeRecommendationLimit 추천 목록 크기 기본값 및 최대값 적용
+func normalizeRecommendationLimit(limit int) int {
+	if limit <= 0 {
+		return 20
+	}
+	if limit > recommendationMax {
+		return recommendationMax
+	}
+	return limit
+}
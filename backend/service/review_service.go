@@ -0,0 +1,199 @@
+package service
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+
+	"backend/config"
+	"backend/helper"
+	"backend/model"
+)
+
+// 리뷰 관련 오류
+var (
+	ErrReviewEmpty          = errors.New("리뷰 내용을 입력해 주세요")
+	ErrReviewProfanity      = errors.New("리뷰에 사용할 수 없는 단어가 포함되어 있습니다")
+	ErrReviewBanned         = errors.New("리뷰 작성이 제한된 계정입니다")
+	ErrContentNotReviewable = errors.New("아직 공개되지 않은 콘텐츠에는 리뷰를 작성할 수 없습니다")
+	ErrReviewNotFound       = errors.New("리뷰를 찾을 수 없습니다")
+	ErrInvalidReviewAction  = errors.New("유효하지 않은 리뷰 검토 처리입니다")
+)
+
+// 자동 검토 대기 사유
+const (
+	reviewSpamWordFlagReason = "스팸 의심 단어"
+	reviewLinkFlagReason     = "링크 포함"
+)
+
+// reviewLinkMarkers 리뷰 내용에서 링크로 보는 표시
+var reviewLinkMarkers = []string{"http://", "https://", "www."}
+
+// ReviewService 콘텐츠 리뷰 서비스 (금칙어/스팸 필터, 추천, 신고, 관리자 검토)
+type ReviewService struct {
+	DB     *sql.DB
+	Config *config.Config
+}
+
+// NewReviewService 새 ReviewService 생성
+func NewReviewService(db *sql.DB, cfg *config.Config) *ReviewService {
+	return &ReviewService{
+		DB:     db,
+		Config: cfg,
+	}
+}
+
+// checkReviewBody 리뷰 내용 검사 후 저장할 상태와 검토 대기 사유 반환
+// 금칙어가 포함되면 ErrReviewProfanity, 스팸 의심 단어나 허용 개수를 넘는 링크가 포함되면 검토 대기
+func (s *ReviewService) checkReviewBody(body string) (status, flagReason string, err error) {
+	bannedWords, err := helper.GetBannedWords(s.Config.Review.BannedWordsFile)
+	if err != nil {
+		return "", "", err
+	}
+	words := append(append([]string{}, bannedWords...), s.Config.Review.BannedWords...)
+	if _, found := helper.NewWordFilter(words).Match(body); found {
+		return "", "", ErrReviewProfanity
+	}
+
+	if _, found := helper.NewWordFilter(s.Config.Review.SpamWords).Match(body); found {
+		return model.ReviewStatusPending, reviewSpamWordFlagReason, nil
+	}
+
+	lowerBody := strings.ToLower(body)
+	links := 0
+	for _, marker := range reviewLinkMarkers {
+		links += strings.Count(lowerBody, marker)
+	}
+	if links > s.Config.Review.MaxLinks {
+		return model.ReviewStatusPending, reviewLinkFlagReason, nil
+	}
+
+	return model.ReviewStatusPublished, "", nil
+}
+
+// prepareReview 리뷰 작성/수정 공통 검사 (작성 금지 계정, 공개 중인 콘텐츠, 내용 필터)
+func (s *ReviewService) prepareReview(userID, contentID int64, req *model.ReviewRequest) (body, status, flagReason string, err error) {
+	body = strings.TrimSpace(req.Body)
+	if body == "" {
+		return "", "", "", ErrReviewEmpty
+	}
+
+	banned, err := model.IsReviewBanned(s.DB, userID)
+	if err != nil {
+		return "", "", "", err
+	}
+	if banned {
+		return "", "", "", ErrReviewBanned
+	}
+
+	published, err := model.IsContentPublished(s.DB, contentID)
+	if err == sql.ErrNoRows {
+		return "", "", "", ErrContentNotFound
+	}
+	if err != nil {
+		return "", "", "", err
+	}
+	if !published {
+		return "", "", "", ErrContentNotReviewable
+	}
+
+	status, flagReason, err = s.checkReviewBody(body)
+	return body, status, flagReason, err
+}
+
+// GetReviews 콘텐츠의 공개 리뷰 목록 조회 (userID가 0이면 비로그인)
+func (s *ReviewService) GetReviews(contentID, userID int64, page, size int, sort string) (*model.PageInfo, error) {
+	return model.GetContentReviews(s.DB, contentID, userID, page, size, sort)
+}
+
+// GetMyReview 사용자가 콘텐츠에 작성한 리뷰 조회 (검토 대기/숨김 상태 포함)
+func (s *ReviewService) GetMyReview(userID, contentID int64) (*model.Review, error) {
+	review, err := model.GetMyReview(s.DB, userID, contentID)
+	if err == sql.ErrNoRows {
+		return nil, ErrReviewNotFound
+	}
+	return review, err
+}
+
+// CreateReview 리뷰 작성 (계정당 콘텐츠별 1건, 스팸 의심 리뷰는 검토 대기 상태로 저장)
+func (s *ReviewService) CreateReview(userID, profileID, contentID int64, req *model.ReviewRequest) (*model.Review, error) {
+	body, status, flagReason, err := s.prepareReview(userID, contentID, req)
+	if err != nil {
+		return nil, err
+	}
+	return model.CreateReview(s.DB, userID, profileID, contentID, body, status, flagReason)
+}
+
+// UpdateReview 사용자가 작성한 리뷰 수정
+func (s *ReviewService) UpdateReview(userID, contentID int64, req *model.ReviewRequest) (*model.Review, error) {
+	body, status, flagReason, err := s.prepareReview(userID, contentID, req)
+	if err != nil {
+		return nil, err
+	}
+	review, err := model.UpdateReview(s.DB, userID, contentID, body, status, flagReason)
+	if err == sql.ErrNoRows {
+		return nil, ErrReviewNotFound
+	}
+	return review, err
+}
+
+// DeleteReview 사용자가 작성한 리뷰 삭제
+func (s *ReviewService) DeleteReview(userID, contentID int64) error {
+	err := model.DeleteReview(s.DB, userID, contentID)
+	if err == sql.ErrNoRows {
+		return ErrReviewNotFound
+	}
+	return err
+}
+
+// Vote 리뷰 추천 저장 (공개 리뷰만, 본인 리뷰는 불가)
+func (s *ReviewService) Vote(userID, reviewID int64, helpful bool) (*model.ReviewVoteResponse, error) {
+	response, err := model.SetReviewVote(s.DB, userID, reviewID, helpful)
+	if err == sql.ErrNoRows {
+		return nil, ErrReviewNotFound
+	}
+	return response, err
+}
+
+// DeleteVote 리뷰 추천 취소
+func (s *ReviewService) DeleteVote(userID, reviewID int64) (*model.ReviewVoteResponse, error) {
+	response, err := model.DeleteReviewVote(s.DB, userID, reviewID)
+	if err == sql.ErrNoRows {
+		return nil, ErrReviewNotFound
+	}
+	return response, err
+}
+
+// Report 리뷰 신고 (처리되지 않은 신고가 설정된 수 이상 쌓이면 검토 전까지 숨김)
+func (s *ReviewService) Report(userID, reviewID int64, req *model.ReviewReportRequest) (*model.ReviewReportResponse, error) {
+	hidden, err := model.ReportReview(s.DB, userID, reviewID, req, s.Config.Review.ReportHideThreshold)
+	if err == sql.ErrNoRows {
+		return nil, ErrReviewNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	response := &model.ReviewReportResponse{Message: "신고가 접수되었습니다"}
+	if hidden {
+		response.Message = "신고가 접수되었습니다. 관리자 검토 전까지 리뷰가 숨겨집니다"
+	}
+	return response, nil
+}
+
+// GetModerationQueue 리뷰 검토 대기열 조회
+func (s *ReviewService) GetModerationQueue(page, size int) (*model.PageInfo, error) {
+	return model.GetModerationQueue(s.DB, page, size)
+}
+
+// Moderate 리뷰 검토 처리 (approve: 공개, hide: 숨김, ban: 작성자 리뷰 작성 금지)
+func (s *ReviewService) Moderate(reviewID int64, action string) error {
+	if !model.IsValidReviewAction(action) {
+		return ErrInvalidReviewAction
+	}
+	err := model.ModerateReview(s.DB, reviewID, action)
+	if err == sql.ErrNoRows {
+		return ErrReviewNotFound
+	}
+	return err
+}
@@ -0,0 +1,92 @@
+package service
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"backend/helper"
+	"backend/model"
+)
+
+// 구독/이용권 관련 오류
+var (
+	ErrSubscriptionRequired = errors.New("구독 중인 요금제가 없습니다. 요금제를 선택한 뒤 시청해 주세요")
+	ErrSubscriptionExpired  = errors.New("구독 기간이 만료되었습니다. 요금제를 갱신한 뒤 시청해 주세요")
+	ErrStreamLimitExceeded  = errors.New("요금제의 동시 시청 가능 수를 초과했습니다. 다른 기기의 재생을 종료한 뒤 다시 시도해 주세요")
+	ErrPlanNotFound         = errors.New("요금제를 찾을 수 없습니다")
+)
+
+// activeStreamTimeout 재생 확인이 없으면 재생이 끝난 것으로 보는 시간 (재생 위치 업데이트 주기보다 길게)
+const activeStreamTimeout = 2 * time.Minute
+
+// SubscriptionService 요금제 구독 및 이용권 확인 서비스
+type SubscriptionService struct {
+	DB *sql.DB
+}
+
+// NewSubscriptionService 새 SubscriptionService 생성
+func NewSubscriptionService(db *sql.DB) *SubscriptionService {
+	return &SubscriptionService{
+		DB: db,
+	}
+}
+
+// GetCurrent 계정의 최근 구독 조회 (만료된 구독 포함, 구독한 적이 없으면 nil)
+func (s *SubscriptionService) GetCurrent(userID int64) (*model.Subscription, error) {
+	subscription, err := model.GetLatestSubscription(s.DB, userID)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return subscription, err
+}
+
+// Grant 계정에 요금제 구독 부여
+// 시작 예정인 구독을 포함해 가장 늦게 끝나는 구독의 종료일부터 이어서 시작하고, 남은 구독이 없으면 지금부터 시작
+func (s *SubscriptionService) Grant(userID int64, planCode string, days int) (*model.Subscription, error) {
+	plan, err := model.GetPlanByCode(s.DB, planCode)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrPlanNotFound
+		}
+		return nil, err
+	}
+
+	return model.AppendSubscription(s.DB, userID, plan, days)
+}
+
+// CheckEntitlement 계정이 재생할 수 있는 구독을 이용 중인지 확인하고 적용할 요금제 반환
+// 구독이 없거나 만료되었으면 ErrSubscriptionRequired/ErrSubscriptionExpired
+func (s *SubscriptionService) CheckEntitlement(userID int64) (*model.Plan, error) {
+	subscription, err := s.GetCurrent(userID)
+	if err != nil {
+		return nil, err
+	}
+	if subscription == nil {
+		return nil, ErrSubscriptionRequired
+	}
+	if !subscription.IsActive(time.Now()) {
+		return nil, ErrSubscriptionExpired
+	}
+
+	return &subscription.Plan, nil
+}
+
+// StartStream 요금제 동시 시청 수 안에서 새 재생 세션을 시작하고 재생 세션 ID 반환
+// 같은 계정의 재생 세션(다른 기기의 같은 프로필 포함)이 동시 시청 수에 도달했으면 ErrStreamLimitExceeded
+func (s *SubscriptionService) StartStream(userID, profileID, contentID int64, plan *model.Plan) (string, error) {
+	streamID, err := helper.GenerateRandomToken(16)
+	if err != nil {
+		return "", err
+	}
+
+	started, err := model.StartStream(s.DB, streamID, userID, profileID, contentID,
+		plan.MaxConcurrentStreams, time.Now().Add(-activeStreamTimeout))
+	if err != nil {
+		return "", err
+	}
+	if !started {
+		return "", ErrStreamLimitExceeded
+	}
+	return streamID, nil
+}
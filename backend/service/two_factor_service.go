@@ -0,0 +1,175 @@
+package service
+
+import (
+	"crypto/rand"
+	"database/sql"
+	"encoding/base32"
+	"errors"
+	"strings"
+	"time"
+
+	"backend/helper"
+	"backend/model"
+)
+
+// 2단계 인증 관련 오류
+var (
+	ErrTwoFactorAlreadyEnabled = errors.New("이미 2단계 인증이 활성화되어 있습니다")
+	ErrTwoFactorNotEnrolled    = errors.New("2단계 인증 등록을 먼저 시작해 주세요")
+	ErrTwoFactorNotEnabled     = errors.New("2단계 인증이 활성화되어 있지 않습니다")
+	ErrInvalidTwoFactorCode    = errors.New("인증 코드가 올바르지 않습니다")
+)
+
+// twoFactorIssuer 인증 앱에 표시되는 서비스 이름
+const twoFactorIssuer = "MiniFlix"
+
+// recoveryCodeEncoding 복구 코드 문자 집합 (소문자 base32)
+var recoveryCodeEncoding = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").WithPadding(base32.NoPadding)
+
+// TwoFactorService TOTP 2단계 인증 서비스
+type TwoFactorService struct {
+	DB *sql.DB
+}
+
+// NewTwoFactorService 새 TwoFactorService 생성
+func NewTwoFactorService(db *sql.DB) *TwoFactorService {
+	return &TwoFactorService{
+		DB: db,
+	}
+}
+
+// IsEnabled 계정의 2단계 인증 활성화 여부 확인
+func (s *TwoFactorService) IsEnabled(userID int64) (bool, error) {
+	state, err := model.GetTwoFactorState(s.DB, userID)
+	if err != nil {
+		return false, err
+	}
+	return state.Enabled, nil
+}
+
+// Enroll 2단계 인증 등록 시작 (새 비밀 키 발급, 코드 확인 전까지 비활성)
+func (s *TwoFactorService) Enroll(user *model.User) (*model.TwoFactorEnrollResponse, error) {
+	state, err := model.GetTwoFactorState(s.DB, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if state.Enabled {
+		return nil, ErrTwoFactorAlreadyEnabled
+	}
+
+	secret, err := helper.GenerateTOTPSecret()
+	if err != nil {
+		return nil, err
+	}
+	if err := model.SetPendingTOTPSecret(s.DB, user.ID, secret); err != nil {
+		return nil, err
+	}
+
+	return &model.TwoFactorEnrollResponse{
+		Secret:     secret,
+		OTPAuthURI: helper.TOTPURI(twoFactorIssuer, user.Email, secret),
+	}, nil
+}
+
+// Confirm 인증 앱 코드 확인 후 2단계 인증 활성화 및 복구 코드 발급
+func (s *TwoFactorService) Confirm(userID int64, code string) ([]string, error) {
+	state, err := model.GetTwoFactorState(s.DB, userID)
+	if err != nil {
+		return nil, err
+	}
+	if state.Enabled {
+		return nil, ErrTwoFactorAlreadyEnabled
+	}
+	if state.Secret == "" {
+		return nil, ErrTwoFactorNotEnrolled
+	}
+
+	step, ok := helper.ValidateTOTP(state.Secret, code, time.Now())
+	if !ok {
+		return nil, ErrInvalidTwoFactorCode
+	}
+
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	if err := model.EnableTwoFactor(s.DB, userID, step, hashes); err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+// Disable 계정 비밀번호와 인증 코드(또는 복구 코드) 확인 후 2단계 인증 해제
+func (s *TwoFactorService) Disable(userID int64, req *model.TwoFactorDisableRequest) error {
+	user, err := model.GetUserByID(s.DB, userID)
+	if err != nil {
+		return err
+	}
+	if !model.CheckPasswordHash(req.CurrentPassword, user.Password) {
+		return ErrInvalidPassword
+	}
+
+	if err := s.VerifyCode(userID, req.Code, req.RecoveryCode); err != nil {
+		return err
+	}
+	return model.DisableTwoFactor(s.DB, userID)
+}
+
+// VerifyCode TOTP 코드 또는 복구 코드 확인 (한 번 사용한 코드는 다시 사용할 수 없음)
+func (s *TwoFactorService) VerifyCode(userID int64, code, recoveryCode string) error {
+	state, err := model.GetTwoFactorState(s.DB, userID)
+	if err != nil {
+		return err
+	}
+	if !state.Enabled {
+		return ErrTwoFactorNotEnabled
+	}
+
+	if recoveryCode != "" {
+		used, err := model.UseRecoveryCode(s.DB, userID, hashRecoveryCode(recoveryCode))
+		if err != nil {
+			return err
+		}
+		if !used {
+			return ErrInvalidTwoFactorCode
+		}
+		return nil
+	}
+
+	step, ok := helper.ValidateTOTP(state.Secret, code, time.Now())
+	if !ok || step <= state.LastStep {
+		return ErrInvalidTwoFactorCode
+	}
+	used, err := model.UseTOTPStep(s.DB, userID, step)
+	if err != nil {
+		return err
+	}
+	if !used {
+		return ErrInvalidTwoFactorCode
+	}
+	return nil
+}
+
+// generateRecoveryCodes 복구 코드와 저장용 해시 생성 (형식: xxxxx-xxxxx)
+func generateRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, 0, model.RecoveryCodeCount)
+	hashes := make([]string, 0, model.RecoveryCodeCount)
+	for i := 0; i < model.RecoveryCodeCount; i++ {
+		buf := make([]byte, 7)
+		if _, err := rand.Read(buf); err != nil {
+			return nil, nil, err
+		}
+		raw := recoveryCodeEncoding.EncodeToString(buf)[:10]
+		code := raw[:5] + "-" + raw[5:]
+		codes = append(codes, code)
+		hashes = append(hashes, hashRecoveryCode(code))
+	}
+	return codes, hashes, nil
+}
+
+// hashRecoveryCode 입력 형식(대소문자, 하이픈, 공백)과 관계없이 같은 해시가 나오도록 정규화 후 해시
+func hashRecoveryCode(code string) string {
+	normalized := strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(strings.TrimSpace(code)))
+	return helper.HashToken(normalized)
+}
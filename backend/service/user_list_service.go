@@ -0,0 +1,227 @@
+package service
+
+import (
+	"database/sql"
+	"errors"
+	"log"
+	"time"
+
+	"backend/config"
+	"backend/helper"
+	"backend/model"
+)
+
+// 사용자 목록 관련 오류
+var (
+	ErrUserListNotFound     = errors.New("목록을 찾을 수 없습니다")
+	ErrUserListItemNotFound = errors.New("목록에 없는 콘텐츠입니다")
+)
+
+// userListShareTokenBytes 공유 토큰 난수 길이 (바이트)
+const userListShareTokenBytes = 24
+
+// UserListService 프로필별 이름 있는 목록 서비스 (기본 목록은 기존 찜 목록)
+type UserListService struct {
+	DB     *sql.DB
+	Config *config.Config
+}
+
+// NewUserListService 새 UserListService 생성
+func NewUserListService(db *sql.DB, cfg *config.Config) *UserListService {
+	return &UserListService{
+		DB:     db,
+		Config: cfg,
+	}
+}
+
+// getList 프로필의 목록 조회 (listID가 0이면 기본 목록, 없으면 ErrUserListNotFound)
+func (s *UserListService) getList(profileID, listID int64) (*model.UserList, error) {
+	if listID == 0 {
+		defaultID, err := model.EnsureDefaultUserList(s.DB, profileID)
+		if err != nil {
+			return nil, err
+		}
+		listID = defaultID
+	}
+
+	list, err := model.GetUserList(s.DB, profileID, listID)
+	if err == sql.ErrNoRows {
+		return nil, ErrUserListNotFound
+	}
+	return list, err
+}
+
+// GetLists 프로필의 목록 조회 (기본 목록이 없으면 생성)
+func (s *UserListService) GetLists(profileID int64) ([]model.UserList, error) {
+	if _, err := model.EnsureDefaultUserList(s.DB, profileID); err != nil {
+		return nil, err
+	}
+	return model.GetUserLists(s.DB, profileID)
+}
+
+// GetList 목록 정보와 콘텐츠 조회
+func (s *UserListService) GetList(viewer model.Viewer, listID int64) (*model.UserListDetailResponse, error) {
+	list, err := s.getList(viewer.ProfileID, listID)
+	if err != nil {
+		return nil, err
+	}
+	items, err := model.GetUserListItems(s.DB, viewer, list)
+	if err != nil {
+		return nil, err
+	}
+	return &model.UserListDetailResponse{UserList: *list, Items: items}, nil
+}
+
+// CreateList 목록 생성
+func (s *UserListService) CreateList(profileID int64, req *model.UserListRequest) (*model.UserList, error) {
+	return model.CreateUserList(s.DB, profileID, req.Name, s.Config.MaxListsPerProfile)
+}
+
+// RenameList 목록 이름 변경
+func (s *UserListService) RenameList(profileID, listID int64, req *model.UserListRequest) (*model.UserList, error) {
+	list, err := s.getList(profileID, listID)
+	if err != nil {
+		return nil, err
+	}
+	return model.RenameUserList(s.DB, list, req.Name)
+}
+
+// DeleteList 목록 삭제
+func (s *UserListService) DeleteList(profileID, listID int64) error {
+	list, err := s.getList(profileID, listID)
+	if err != nil {
+		return err
+	}
+	return model.DeleteUserList(s.DB, list)
+}
+
+// ReorderLists 목록 순서 변경
+func (s *UserListService) ReorderLists(profileID int64, req *model.UserListOrderRequest) ([]model.UserList, error) {
+	if err := model.ReorderUserLists(s.DB, profileID, req.ListIDs); err != nil {
+		return nil, err
+	}
+	return s.GetLists(profileID)
+}
+
+// AddItem 목록 끝에 콘텐츠 추가 (새로 추가된 경우 인기도 반영)
+func (s *UserListService) AddItem(profileID, listID, contentID int64) (bool, error) {
+	list, err := s.getList(profileID, listID)
+	if err != nil {
+		return false, err
+	}
+
+	exists, err := model.ContentExists(s.DB, contentID)
+	if err != nil {
+		return false, err
+	}
+	if !exists {
+		return false, ErrContentNotFound
+	}
+
+	added, err := model.AddUserListItem(s.DB, list, contentID)
+	if err != nil || !added {
+		return added, err
+	}
+
+	// 목록 추가 인기도 반영 (프로필/콘텐츠별 처음 한 번만, 실패해도 추가는 정상 처리)
+	if _, err := model.RecordProfilePopularityEvent(s.DB, profileID, contentID, model.PopularityEventWishlistAdd, time.Now()); err != nil {
+		log.Printf("인기도 반영 실패: %v", err)
+	}
+	return true, nil
+}
+
+// RemoveItem 목록에서 콘텐츠 제거
+func (s *UserListService) RemoveItem(profileID, listID, contentID int64) error {
+	list, err := s.getList(profileID, listID)
+	if err != nil {
+		return err
+	}
+	err = model.RemoveUserListItem(s.DB, list, contentID)
+	if err == sql.ErrNoRows {
+		return ErrUserListItemNotFound
+	}
+	return err
+}
+
+// MoveItem 콘텐츠를 다른 목록으로 이동
+func (s *UserListService) MoveItem(profileID, listID, contentID int64, req *model.UserListMoveRequest) error {
+	source, err := s.getList(profileID, listID)
+	if err != nil {
+		return err
+	}
+	target, err := s.getList(profileID, req.TargetListID)
+	if err != nil {
+		return err
+	}
+	err = model.MoveUserListItem(s.DB, source, target, contentID)
+	if err == sql.ErrNoRows {
+		return ErrUserListItemNotFound
+	}
+	return err
+}
+
+// ReorderItems 목록 콘텐츠 순서 변경
+func (s *UserListService) ReorderItems(viewer model.Viewer, listID int64, req *model.UserListItemsOrderRequest) (*model.UserListDetailResponse, error) {
+	list, err := s.getList(viewer.ProfileID, listID)
+	if err != nil {
+		return nil, err
+	}
+	if err := model.ReorderUserListItems(s.DB, list, req.ContentIDs); err != nil {
+		return nil, err
+	}
+	return s.GetList(viewer, list.ID)
+}
+
+// Share 목록 공유 링크 생성 (이미 공유 중이면 기존 링크 유지)
+func (s *UserListService) Share(profileID, listID int64) (*model.UserList, error) {
+	list, err := s.getList(profileID, listID)
+	if err != nil {
+		return nil, err
+	}
+	if list.ShareToken != "" {
+		return list, nil
+	}
+
+	token, err := helper.GenerateRandomToken(userListShareTokenBytes)
+	if err != nil {
+		return nil, err
+	}
+	if err := model.SetUserListShareToken(s.DB, list.ID, token); err != nil {
+		return nil, err
+	}
+	return s.getList(profileID, list.ID)
+}
+
+// Unshare 목록 공유 해제 (기존 링크는 더 이상 열리지 않음)
+func (s *UserListService) Unshare(profileID, listID int64) (*model.UserList, error) {
+	list, err := s.getList(profileID, listID)
+	if err != nil {
+		return nil, err
+	}
+	if err := model.SetUserListShareToken(s.DB, list.ID, ""); err != nil {
+		return nil, err
+	}
+	return s.getList(profileID, list.ID)
+}
+
+// GetShared 공유 링크로 목록 조회 (읽기 전용, 시청자의 시청 등급/지역에 맞는 콘텐츠만)
+func (s *UserListService) GetShared(viewer model.Viewer, token string) (*model.SharedUserListResponse, error) {
+	list, ownerName, err := model.GetSharedUserList(s.DB, token)
+	if err == sql.ErrNoRows {
+		return nil, ErrUserListNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := model.GetUserListItems(s.DB, viewer, list)
+	if err != nil {
+		return nil, err
+	}
+	return &model.SharedUserListResponse{
+		Name:      list.Name,
+		OwnerName: ownerName,
+		UpdatedAt: list.UpdatedAt,
+		Items:     items,
+	}, nil
+}
@@ -3,6 +3,7 @@ package service
 import (
 	"database/sql"
 	"errors"
+	"log"
 
 	"backend/model"
 
@@ -48,6 +49,11 @@ func (s *UserService) Register(req *model.RegisterRequest) (*model.User, error)
 		return nil, err
 	}
 
+	// 기본 프로필 생성 (실패해도 로그인 시 다시 생성됨)
+	if _, err := model.CreateProfile(s.DB.DB, user.ID, &model.ProfileRequest{Name: user.Name}, true); err != nil {
+		log.Printf("기본 프로필 생성 실패: %v", err)
+	}
+
 	return user, nil
 }
 
@@ -69,7 +75,7 @@ func (s *UserService) GetUserByID(id int64) (*model.User, error) {
 	return user, nil
 }
 
-// UpdateUserInfo 사용자 정보 업데이트
+// UpdateUserInfo 사용자 정보 업데이트 (비밀번호 변경 시 모든 세션 폐기)
 func (s *UserService) UpdateUserInfo(id int64, req *model.UpdateProfileRequest) error {
 	// 현재 비밀번호 확인을 위해 사용자 조회
 	user, err := model.GetUserByID(s.DB.DB, id)
@@ -88,6 +94,11 @@ func (s *UserService) UpdateUserInfo(id int64, req *model.UpdateProfileRequest)
 		return err
 	}
 
+	// 비밀번호 변경 시 기존 로그인 세션 모두 폐기
+	if req.NewPassword != "" {
+		return model.RevokeUserRefreshTokens(s.DB.DB, id)
+	}
+
 	return nil
 }
 
@@ -0,0 +1,116 @@
+package test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"backend/model"
+	"backend/service"
+)
+
+// 탈퇴 예약 및 취소 테스트
+func TestAccountDeletionRequest(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	hash, err := model.HashPassword("Secret-pass1")
+	assert.NoError(t, err)
+	userRows := func() *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"id", "email", "password_hash", "name", "created_at", "updated_at", "is_active", "email_verified"}).
+			AddRow(1, "user@example.com", hash, "홍길동", time.Now(), time.Now(), true, true)
+	}
+	deletionService := service.NewAccountDeletionService(db, dataExportTestConfig(t))
+
+	// 비밀번호가 틀리면 거부
+	mock.ExpectQuery(`FROM Users WHERE id = \?`).WillReturnRows(userRows())
+	_, err = deletionService.RequestDeletion(1, &model.DeleteAccountRequest{CurrentPassword: "wrong"})
+	assert.ErrorIs(t, err, service.ErrInvalidPassword)
+
+	// 유예 기간 뒤로 예약하고 모든 세션 종료
+	mock.ExpectQuery(`FROM Users WHERE id = \?`).WillReturnRows(userRows())
+	mock.ExpectExec(`UPDATE Users SET deletion_scheduled_at = \? WHERE id = \? AND deleted_at IS NULL`).
+		WithArgs(sqlmock.AnyArg(), int64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectBegin()
+	mock.ExpectExec(`INSERT IGNORE INTO RevokedTokens`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`UPDATE RefreshTokens SET revoked_at`).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE UserSessions SET revoked_at`).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	scheduledAt, err := deletionService.RequestDeletion(1, &model.DeleteAccountRequest{CurrentPassword: "Secret-pass1"})
+	assert.NoError(t, err)
+	assert.WithinDuration(t, time.Now().AddDate(0, 0, 30), scheduledAt, time.Minute)
+
+	// 다시 로그인하면 취소
+	mock.ExpectExec(`UPDATE Users SET deletion_scheduled_at = NULL`).
+		WithArgs(int64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	cancelled, err := deletionService.CancelDeletion(1)
+	assert.NoError(t, err)
+	assert.True(t, cancelled)
+
+	mock.ExpectExec(`UPDATE Users SET deletion_scheduled_at = NULL`).WillReturnResult(sqlmock.NewResult(0, 0))
+	cancelled, err = deletionService.CancelDeletion(1)
+	assert.NoError(t, err)
+	assert.False(t, cancelled)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// 유예 기간이 끝난 계정의 익명화 테스트
+func TestAccountDeletionPurge(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	cfg := dataExportTestConfig(t)
+	exportPath := filepath.Join(cfg.DataExportDir, "export-7-1.zip")
+	assert.NoError(t, os.WriteFile(exportPath, []byte("zip"), 0o600))
+
+	mock.ExpectQuery(`SELECT id FROM Users WHERE deletion_scheduled_at <= \? AND deleted_at IS NULL`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+	mock.ExpectQuery(`FROM DataExports WHERE user_id = \?`).
+		WithArgs(int64(7)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "status", "file_path", "file_size", "created_at", "completed_at", "expires_at"}).
+			AddRow(1, 7, model.DataExportCompleted, exportPath, 3, time.Now(), time.Now(), time.Now().Add(time.Hour)))
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE Users SET\s+email = \?, password_hash = '', name = \?, is_active = FALSE`).
+		WithArgs("deleted-7@deleted.invalid", model.DeletedUserName, sqlmock.AnyArg(), sqlmock.AnyArg(), int64(7)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE Profiles SET name = \?, avatar_url = ''`).
+		WithArgs(model.DeletedProfileName, int64(7)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	// 시청 기록은 통계용으로 남기고 위치/시각만 흐리게 처리
+	mock.ExpectExec(`UPDATE ViewingHistories vh JOIN Profiles p`).WillReturnResult(sqlmock.NewResult(0, 5))
+	mock.ExpectExec(`DELETE w FROM Wishlists w`).WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectExec(`DELETE l FROM UserLists l`).WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(`DELETE r FROM ContentReminders r JOIN Profiles p`).
+		WithArgs(int64(7)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`DELETE e FROM PopularityEvents e JOIN Profiles p`).
+		WithArgs(int64(7)).
+		WillReturnResult(sqlmock.NewResult(0, 4))
+	// 남은 구독은 즉시 종료하고 해지 처리
+	mock.ExpectExec(`UPDATE Subscriptions SET ends_at = LEAST\(ends_at, \?\), cancelled_at = COALESCE\(cancelled_at, \?\)\s+WHERE user_id = \? AND ends_at > \?`).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), int64(7), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	for _, table := range []string{"UserSessions", "RefreshTokens", "UserTokens", "RecoveryCodes", "UserIdentities", "AccountLockouts", "DataExports", "Reviews"} {
+		mock.ExpectExec(`DELETE FROM ` + table + ` WHERE user_id = \?`).
+			WithArgs(int64(7)).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+	}
+	mock.ExpectCommit()
+
+	purged, err := service.NewAccountDeletionService(db, cfg).PurgeDueAccounts()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, purged)
+	assert.NoFileExists(t, exportPath)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
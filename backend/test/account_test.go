@@ -0,0 +1,173 @@
+package test
+
+import (
+	"database/sql"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"backend/config"
+	"backend/helper"
+	"backend/model"
+	"backend/service"
+)
+
+// 계정 메일 테스트용 설정 (메일은 임시 파일에 기록)
+func accountTestConfig(t *testing.T) *config.Config {
+	return &config.Config{
+		Mail: config.MailConfig{
+			Driver:      config.MailDriverFile,
+			From:        "MiniFlix <no-reply@miniflix.io>",
+			FilePath:    filepath.Join(t.TempDir(), "mail.log"),
+			LinkBaseURL: "http://localhost:3000/",
+		},
+		PasswordResetExpireMinutes:   60,
+		EmailVerificationExpireHours: 48,
+	}
+}
+
+// accountUserRows 사용자 조회 결과
+func accountUserRows() *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"id", "email", "password_hash", "name", "created_at", "updated_at", "is_active", "email_verified"}).
+		AddRow(1, "user@example.com", "hash", "홍길동", time.Now(), time.Now(), true, false)
+}
+
+// 비밀번호 재설정 메일 발송 및 재설정 테스트
+func TestPasswordResetFlow(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	cfg := accountTestConfig(t)
+	accountService := service.NewAccountService(db, helper.NewMailer(cfg.Mail), cfg)
+
+	// 재설정 메일 발송 (영어)
+	mock.ExpectQuery(`FROM Users WHERE email = \?`).
+		WithArgs("user@example.com").
+		WillReturnRows(accountUserRows())
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE UserTokens SET used_at = \? WHERE user_id = \? AND purpose = \? AND used_at IS NULL`).
+		WithArgs(sqlmock.AnyArg(), int64(1), model.UserTokenPasswordReset).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`INSERT INTO UserTokens`).
+		WithArgs(int64(1), model.UserTokenPasswordReset, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	assert.NoError(t, accountService.RequestPasswordReset("user@example.com", "en-US,en;q=0.9"))
+
+	mail, err := os.ReadFile(cfg.Mail.FilePath)
+	assert.NoError(t, err)
+	assert.Contains(t, string(mail), "To: user@example.com")
+	assert.Contains(t, string(mail), "Hi 홍길동")
+	assert.Contains(t, string(mail), "expires in 1 hour")
+
+	match := regexp.MustCompile(`http://localhost:3000/reset-password\?token=([A-Za-z0-9_-]+)`).FindStringSubmatch(string(mail))
+	if !assert.Len(t, match, 2) {
+		return
+	}
+	token := match[1]
+
+	// 정책에 맞지 않는 비밀번호는 토큰을 사용하지 않고 거부
+	cfg.PasswordPolicy = config.PasswordPolicyConfig{MinLength: 8, MaxLength: 72, MinCharClasses: 2}
+	mock.ExpectQuery(`SELECT user_id FROM UserTokens`).WillReturnRows(sqlmock.NewRows([]string{"user_id"}).AddRow(1))
+	mock.ExpectQuery(`FROM Users WHERE id = \?`).WillReturnRows(accountUserRows())
+	var policyErr *service.PasswordPolicyError
+	assert.ErrorAs(t, accountService.ResetPassword(token, "short"), &policyErr)
+
+	// 메일의 토큰으로 비밀번호 재설정 (정책 검사 후 토큰 사용)
+	mock.ExpectQuery(`SELECT user_id FROM UserTokens\s+WHERE token_hash = \? AND purpose = \? AND used_at IS NULL`).
+		WithArgs(helper.HashToken(token), model.UserTokenPasswordReset, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id"}).AddRow(1))
+	mock.ExpectQuery(`FROM Users WHERE id = \?`).WillReturnRows(accountUserRows())
+	mock.ExpectQuery(`SELECT id, user_id FROM UserTokens\s+WHERE token_hash = \? AND purpose = \? AND used_at IS NULL`).
+		WithArgs(helper.HashToken(token), model.UserTokenPasswordReset, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id"}).AddRow(1, 1))
+	mock.ExpectExec(`UPDATE UserTokens SET used_at = \? WHERE id = \? AND used_at IS NULL`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE Users SET password_hash = \?`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE Users SET email_verified_at = IFNULL\(email_verified_at, \?\)`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectBegin()
+	mock.ExpectExec(`INSERT IGNORE INTO RevokedTokens`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`UPDATE RefreshTokens SET revoked_at`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`UPDATE UserSessions SET revoked_at`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	assert.NoError(t, accountService.ResetPassword(token, "newPassword1"))
+
+	// 이미 사용된 토큰은 거부
+	mock.ExpectQuery(`SELECT user_id FROM UserTokens`).WillReturnError(sql.ErrNoRows)
+	assert.ErrorIs(t, accountService.ResetPassword(token, "another"), service.ErrInvalidAccountToken)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// 가입되지 않은 이메일의 비밀번호 재설정 요청 테스트 (메일 미발송, 오류 없음)
+func TestPasswordResetUnknownEmail(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	cfg := accountTestConfig(t)
+	mock.ExpectQuery(`FROM Users WHERE email = \?`).WillReturnError(sql.ErrNoRows)
+
+	accountService := service.NewAccountService(db, helper.NewMailer(cfg.Mail), cfg)
+	assert.NoError(t, accountService.RequestPasswordReset("nobody@example.com", ""))
+
+	_, err = os.Stat(cfg.Mail.FilePath)
+	assert.True(t, os.IsNotExist(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// 한국어 이메일 인증 메일 발송 테스트
+func TestSendVerificationEmailKorean(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	cfg := accountTestConfig(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE UserTokens SET used_at`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`INSERT INTO UserTokens`).
+		WithArgs(int64(1), model.UserTokenEmailVerification, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	accountService := service.NewAccountService(db, helper.NewMailer(cfg.Mail), cfg)
+	user := &model.User{ID: 1, Email: "user@example.com", Name: "홍길동"}
+	assert.NoError(t, accountService.SendVerificationEmail(user, "ko-KR"))
+
+	mail, err := os.ReadFile(cfg.Mail.FilePath)
+	assert.NoError(t, err)
+	assert.Contains(t, string(mail), "홍길동님, MiniFlix에 가입해 주셔서 감사합니다.")
+	assert.Contains(t, string(mail), "http://localhost:3000/verify-email?token=")
+	assert.Contains(t, string(mail), "48시간 동안 유효합니다")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// 이메일 미인증 계정 스트리밍 제한 테스트
+func TestRequireVerifiedEmail(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	cfg := accountTestConfig(t)
+	accountService := service.NewAccountService(db, helper.NewMailer(cfg.Mail), cfg)
+
+	// 설정하지 않으면 제한 없음
+	assert.NoError(t, accountService.RequireVerifiedEmail(1))
+
+	cfg.RequireEmailVerificationStream = true
+	mock.ExpectQuery(`SELECT email_verified_at IS NOT NULL FROM Users WHERE id = \?`).
+		WithArgs(int64(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"verified"}).AddRow(false))
+	assert.ErrorIs(t, accountService.RequireVerifiedEmail(1), service.ErrEmailNotVerified)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
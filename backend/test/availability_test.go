@@ -0,0 +1,191 @@
+package test
+
+import (
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"backend/config"
+	"backend/helper"
+	"backend/middleware"
+	"backend/model"
+)
+
+// geoIPTestCSV 테스트용 IP 대역별 국가 코드
+const geoIPTestCSV = `# 테스트용 IP 국가 데이터베이스
+start_ip,end_ip,country
+192.0.2.0,192.0.2.255,KR
+198.51.100.0/24,us
+203.0.113.0,203.0.113.127,JP
+2001:db8::/32,DE
+10.0.0.0,10.0.0.255,잘못된코드
+`
+
+// IP 대역별 국가 코드 조회 테스트
+func TestGeoIPDatabaseCountry(t *testing.T) {
+	db, err := helper.ParseGeoIPCSV(strings.NewReader(geoIPTestCSV))
+	assert.NoError(t, err)
+
+	testCases := []struct {
+		ip      string
+		country string
+	}{
+		{"192.0.2.0", "KR"},
+		{"192.0.2.255", "KR"},
+		{"198.51.100.42", "US"},
+		{"203.0.113.127", "JP"},
+		{"203.0.113.128", ""},
+		{"::ffff:192.0.2.10", "KR"},
+		{"2001:db8::1", "DE"},
+		{"10.0.0.1", ""},
+		{"8.8.8.8", ""},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.country, db.Country(netip.MustParseAddr(tc.ip)), tc.ip)
+	}
+}
+
+// 신뢰하는 프록시에 따른 접속 국가 확인 테스트
+func TestGeoResolverCountry(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "geoip.csv")
+	assert.NoError(t, os.WriteFile(path, []byte(geoIPTestCSV), 0o644))
+
+	resolver := helper.NewGeoResolver(config.GeoConfig{
+		DatabaseFile:   path,
+		DefaultCountry: "KR",
+		TrustedProxies: []string{"172.16.0.0/12", "10.0.0.1"},
+		ClientIPHeader: "X-Forwarded-For",
+		CountryHeader:  "CF-IPCountry",
+	})
+
+	testCases := []struct {
+		name       string
+		remoteAddr string
+		headers    map[string]string
+		country    string
+	}{
+		{"직접 접속", "198.51.100.7:5000", nil, "US"},
+		{"신뢰하지 않는 주소의 헤더는 무시", "198.51.100.7:5000", map[string]string{"X-Forwarded-For": "203.0.113.5", "CF-IPCountry": "JP"}, "US"},
+		{"신뢰하는 프록시의 클라이언트 IP 헤더", "10.0.0.1:5000", map[string]string{"X-Forwarded-For": "203.0.113.5"}, "JP"},
+		{"프록시 체인은 오른쪽의 신뢰하지 않는 첫 주소 사용", "172.16.0.2:5000", map[string]string{"X-Forwarded-For": "198.51.100.1, 203.0.113.5, 172.16.0.3"}, "JP"},
+		{"신뢰하는 프록시의 국가 헤더 우선", "10.0.0.1:5000", map[string]string{"X-Forwarded-For": "203.0.113.5", "CF-IPCountry": "us"}, "US"},
+		{"확인할 수 없으면 기본 국가", "8.8.8.8:5000", nil, "KR"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/api/contents", nil)
+			req.RemoteAddr = tc.remoteAddr
+			for key, value := range tc.headers {
+				req.Header.Set(key, value)
+			}
+			assert.Equal(t, tc.country, resolver.Country(req))
+		})
+	}
+}
+
+// 접속 정보 미들웨어가 신뢰하는 프록시의 헤더만 반영해 클라이언트 IP를 저장하는지 테스트 (IP별 로그인 제한 우회 방지)
+func TestGeoMiddlewareClientIP(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	cfg := &config.Config{Geo: config.GeoConfig{
+		DefaultCountry: "KR",
+		TrustedProxies: []string{"10.0.0.1"},
+		ClientIPHeader: "X-Forwarded-For",
+	}}
+	router := gin.New()
+	router.Use(middleware.GeoMiddleware(cfg))
+	router.GET("/ip", func(c *gin.Context) {
+		c.String(http.StatusOK, c.GetString(middleware.ClientIPKey))
+	})
+
+	testCases := []struct {
+		name       string
+		remoteAddr string
+		forwarded  string
+		clientIP   string
+	}{
+		{"신뢰하지 않는 주소의 헤더는 무시", "198.51.100.7:5000", "203.0.113.5", "198.51.100.7"},
+		{"신뢰하는 프록시의 헤더 사용", "10.0.0.1:5000", "203.0.113.5", "203.0.113.5"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/ip", nil)
+			req.RemoteAddr = tc.remoteAddr
+			req.Header.Set("X-Forwarded-For", tc.forwarded)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			assert.Equal(t, tc.clientIP, w.Body.String())
+		})
+	}
+}
+
+// 이용 가능 기간 설정 검증 테스트
+func TestSetContentAvailabilityValidate(t *testing.T) {
+	now := time.Now()
+	later := now.Add(24 * time.Hour)
+
+	valid := model.SetContentAvailabilityRequest{Windows: []model.ContentAvailability{
+		{Region: "KR", AvailableFrom: &now, AvailableUntil: &later},
+		{Region: model.RegionWorldwide},
+	}}
+	assert.NoError(t, valid.Validate())
+
+	invalidRegion := model.SetContentAvailabilityRequest{Windows: []model.ContentAvailability{{Region: "kor"}}}
+	assert.ErrorIs(t, invalidRegion.Validate(), model.ErrInvalidAvailability)
+
+	invalidPeriod := model.SetContentAvailabilityRequest{Windows: []model.ContentAvailability{
+		{Region: "US", AvailableFrom: &later, AvailableUntil: &now},
+	}}
+	assert.ErrorIs(t, invalidPeriod.Validate(), model.ErrInvalidAvailability)
+}
+
+// 목록 조회 시 접속 국가에서 이용할 수 없는 콘텐츠 제외 테스트
+func TestContentListFiltersByRegion(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`SELECT COUNT\(\*\)\s+FROM Contents c\s+WHERE .*maturity_level.* AND \(NOT EXISTS \(SELECT 1 FROM ContentAvailability ca.*ca.region IN \(\?, '\*'\)`).
+		WithArgs(int64(0), "JP").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+	mock.ExpectQuery(`SELECT\s+c.id, c.title, c.thumbnail_url, c.release_year\s+FROM\s+Contents c\s+WHERE .*ContentAvailability ca`).
+		WithArgs(int64(0), "JP", 10, 0).
+		WillReturnRows(contentListRows())
+	expectContentListDetails(mock, 0)
+
+	pageInfo, err := model.GetContentList(db, model.Viewer{Country: "JP"}, 0, 10, "")
+	assert.NoError(t, err)
+	assert.Len(t, pageInfo.Content, 3)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// 콘텐츠 이용 가능 여부 확인 테스트
+func TestIsContentAvailable(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`FROM Contents c\s+WHERE c.id = \?`).
+		WithArgs("US", int64(3)).
+		WillReturnRows(sqlmock.NewRows([]string{"available"}).AddRow(false))
+	available, err := model.IsContentAvailable(db, 3, "US")
+	assert.NoError(t, err)
+	assert.False(t, available)
+
+	mock.ExpectQuery(`FROM Contents c\s+WHERE c.id = \?`).
+		WithArgs("KR", int64(99)).
+		WillReturnError(sql.ErrNoRows)
+	_, err = model.IsContentAvailable(db, 99, "KR")
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
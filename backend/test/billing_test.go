@@ -0,0 +1,313 @@
+package test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"backend/config"
+	"backend/helper"
+	"backend/model"
+	"backend/route"
+	"backend/service"
+)
+
+// billingTestService 모의 결제 제공자를 사용하는 BillingService 생성
+func billingTestService(t *testing.T) (*service.BillingService, *helper.FakeBillingProvider, sqlmock.Sqlmock) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	cfg := &config.Config{Billing: config.BillingConfig{
+		Provider:                config.BillingProviderFake,
+		WebhookSecret:           "test-webhook-secret",
+		WebhookToleranceSeconds: 300,
+		Currency:                "KRW",
+	}}
+	provider, err := helper.NewBillingProvider(cfg.Billing)
+	assert.NoError(t, err)
+	return service.NewBillingService(db, provider, cfg), provider.(*helper.FakeBillingProvider), mock
+}
+
+// checkoutSessionRows 결제 세션 잠금 조회 결과 행
+func checkoutSessionRows(amount int, status string) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"id", "user_id", "plan_id", "amount", "currency", "status"}).
+		AddRow(5, 1, 2, amount, "KRW", status)
+}
+
+// 웹훅 서명 검증 테스트
+func TestVerifyWebhookSignature(t *testing.T) {
+	payload := []byte(`{"id":"evt_1"}`)
+	now := time.Now()
+	signature := helper.SignWebhookPayload("secret", payload, now)
+
+	assert.NoError(t, helper.VerifyWebhookSignature("secret", payload, signature, 5*time.Minute, now))
+
+	// 키 교체 중 이전 키 서명이 함께 와도 하나가 맞으면 통과
+	rotated := helper.SignWebhookPayload("old-secret", payload, now) + "," + strings.Split(signature, ",")[1]
+	assert.NoError(t, helper.VerifyWebhookSignature("secret", payload, rotated, 5*time.Minute, now))
+
+	// 본문 변조, 다른 키, 오래된 서명, 형식 오류는 거부
+	assert.ErrorIs(t, helper.VerifyWebhookSignature("secret", []byte(`{"id":"evt_2"}`), signature, 5*time.Minute, now), helper.ErrInvalidWebhookSignature)
+	assert.ErrorIs(t, helper.VerifyWebhookSignature("other", payload, signature, 5*time.Minute, now), helper.ErrInvalidWebhookSignature)
+	assert.ErrorIs(t, helper.VerifyWebhookSignature("secret", payload, signature, 5*time.Minute, now.Add(10*time.Minute)), helper.ErrInvalidWebhookSignature)
+	assert.ErrorIs(t, helper.VerifyWebhookSignature("secret", payload, "garbage", 5*time.Minute, now), helper.ErrInvalidWebhookSignature)
+}
+
+// 결제 성공 웹훅으로 구독 생성 및 중복 이벤트 무시 테스트
+func TestBillingWebhookPaymentSucceeded(t *testing.T) {
+	billingService, provider, mock := billingTestService(t)
+
+	payload, signature, err := provider.Event(helper.BillingEvent{
+		ID:        "evt_1",
+		Type:      helper.BillingEventPaymentSucceeded,
+		SessionID: "cs_fake_1",
+		PaymentID: "pay_fake_1",
+		Amount:    13500,
+		Currency:  "KRW",
+	})
+	assert.NoError(t, err)
+
+	// 이용 중인 구독이 있으면 그 종료일부터 한 달
+	currentEnd := time.Now().AddDate(0, 0, 5)
+	mock.ExpectBegin()
+	mock.ExpectExec(`INSERT IGNORE INTO BillingEvents`).
+		WithArgs("fake", "evt_1", helper.BillingEventPaymentSucceeded, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`FROM CheckoutSessions\s+WHERE provider = \? AND provider_session_id = \?\s+FOR UPDATE`).
+		WithArgs("fake", "cs_fake_1").
+		WillReturnRows(checkoutSessionRows(13500, model.CheckoutSessionOpen))
+	mock.ExpectQuery(`SELECT id FROM Users WHERE id = \? FOR UPDATE`).
+		WithArgs(int64(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectQuery(`SELECT MAX\(ends_at\) FROM Subscriptions`).
+		WillReturnRows(sqlmock.NewRows([]string{"max"}).AddRow(currentEnd))
+	mock.ExpectExec(`INSERT INTO Subscriptions`).
+		WithArgs(int64(1), int64(2), currentEnd, currentEnd.AddDate(0, 1, 0), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(9, 1))
+	mock.ExpectExec(`INSERT INTO Invoices`).
+		WithArgs(int64(1), int64(5), int64(9), "fake", "pay_fake_1", 13500, "KRW", model.InvoicePaid,
+			currentEnd, currentEnd.AddDate(0, 1, 0), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(`UPDATE CheckoutSessions SET status = \?, completed_at = \?`).
+		WithArgs(model.CheckoutSessionCompleted, sqlmock.AnyArg(), int64(5)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	processed, err := billingService.HandleWebhook(payload, signature)
+	assert.NoError(t, err)
+	assert.True(t, processed)
+
+	// 같은 이벤트가 다시 오면 아무것도 바꾸지 않음
+	mock.ExpectBegin()
+	mock.ExpectExec(`INSERT IGNORE INTO BillingEvents`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	processed, err = billingService.HandleWebhook(payload, signature)
+	assert.NoError(t, err)
+	assert.False(t, processed)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// 결제 금액이 다르거나 서명이 틀린 웹훅 거부 테스트
+func TestBillingWebhookRejected(t *testing.T) {
+	billingService, provider, mock := billingTestService(t)
+
+	payload, signature, err := provider.Event(helper.BillingEvent{
+		Type:      helper.BillingEventPaymentSucceeded,
+		SessionID: "cs_fake_1",
+		PaymentID: "pay_fake_1",
+		Amount:    100,
+		Currency:  "KRW",
+	})
+	assert.NoError(t, err)
+
+	// 서명이 틀리면 DB를 건드리지 않음
+	_, err = billingService.HandleWebhook(payload, helper.SignWebhookPayload("wrong-secret", payload, time.Now()))
+	assert.ErrorIs(t, err, helper.ErrInvalidWebhookSignature)
+
+	// 금액 불일치는 이벤트 기록까지 되돌림
+	mock.ExpectBegin()
+	mock.ExpectExec(`INSERT IGNORE INTO BillingEvents`).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`FROM CheckoutSessions`).WillReturnRows(checkoutSessionRows(13500, model.CheckoutSessionOpen))
+	mock.ExpectRollback()
+
+	_, err = billingService.HandleWebhook(payload, signature)
+	assert.ErrorIs(t, err, model.ErrPaymentMismatch)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// 결제 실패 및 환불 웹훅 테스트
+func TestBillingWebhookFailedAndRefunded(t *testing.T) {
+	billingService, provider, mock := billingTestService(t)
+
+	// 결제 실패는 세션을 실패 처리하고 실패 청구서 기록
+	payload, signature, err := provider.Event(helper.BillingEvent{
+		Type:          helper.BillingEventPaymentFailed,
+		SessionID:     "cs_fake_1",
+		PaymentID:     "pay_fake_2",
+		Amount:        13500,
+		Currency:      "KRW",
+		FailureReason: "카드 한도 초과",
+	})
+	assert.NoError(t, err)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`INSERT IGNORE INTO BillingEvents`).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`FROM CheckoutSessions`).WillReturnRows(checkoutSessionRows(13500, model.CheckoutSessionOpen))
+	mock.ExpectExec(`UPDATE CheckoutSessions SET status = \?`).
+		WithArgs(model.CheckoutSessionFailed, int64(5)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`INSERT INTO Invoices`).
+		WithArgs(int64(1), int64(5), "fake", "pay_fake_2", 13500, "KRW", model.InvoiceFailed, "카드 한도 초과", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(2, 1))
+	mock.ExpectCommit()
+
+	processed, err := billingService.HandleWebhook(payload, signature)
+	assert.NoError(t, err)
+	assert.True(t, processed)
+
+	// 이용 중인 구독의 환불은 청구서를 환불 처리하고 구독을 즉시 종료
+	payload, signature, err = provider.Event(helper.BillingEvent{
+		Type:      helper.BillingEventPaymentRefunded,
+		PaymentID: "pay_fake_1",
+		Amount:    13500,
+		Currency:  "KRW",
+	})
+	assert.NoError(t, err)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`INSERT IGNORE INTO BillingEvents`).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`FROM Invoices\s+WHERE provider = \? AND provider_payment_id = \?\s+FOR UPDATE`).
+		WithArgs("fake", "pay_fake_1").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "subscription_id", "status"}).AddRow(1, 9, model.InvoicePaid))
+	mock.ExpectExec(`UPDATE Invoices SET status = \?, refunded_at = \?`).
+		WithArgs(model.InvoiceRefunded, sqlmock.AnyArg(), int64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	currentEnd := time.Now().AddDate(0, 0, 10)
+	expectRefundedSubscription(mock, time.Now().AddDate(0, 0, -20), currentEnd)
+	mock.ExpectExec(`UPDATE Subscriptions SET ends_at = \?, cancelled_at = COALESCE\(cancelled_at, \?\) WHERE id = \?`).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), int64(9)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE Subscriptions\s+SET starts_at = DATE_SUB\(starts_at, INTERVAL \? SECOND\)`).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), int64(1), int64(9), currentEnd).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	processed, err = billingService.HandleWebhook(payload, signature)
+	assert.NoError(t, err)
+	assert.True(t, processed)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// 시작 전 구독의 환불은 기간을 0으로 만들고 뒤에 이어지는 구독을 앞당기는지 테스트
+func TestBillingWebhookRefundQueuedSubscription(t *testing.T) {
+	billingService, provider, mock := billingTestService(t)
+
+	payload, signature, err := provider.Event(helper.BillingEvent{
+		Type:      helper.BillingEventPaymentRefunded,
+		PaymentID: "pay_fake_1",
+		Amount:    13500,
+		Currency:  "KRW",
+	})
+	assert.NoError(t, err)
+
+	startsAt := time.Now().AddDate(0, 0, 5).Truncate(time.Second)
+	endsAt := startsAt.AddDate(0, 1, 0)
+	mock.ExpectBegin()
+	mock.ExpectExec(`INSERT IGNORE INTO BillingEvents`).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`FROM Invoices\s+WHERE provider = \? AND provider_payment_id = \?\s+FOR UPDATE`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "subscription_id", "status"}).AddRow(1, 9, model.InvoicePaid))
+	mock.ExpectExec(`UPDATE Invoices SET status = \?, refunded_at = \?`).WillReturnResult(sqlmock.NewResult(0, 1))
+	expectRefundedSubscription(mock, startsAt, endsAt)
+	// 시작 전 구독은 시작일에 끝나도록 기간을 0으로
+	mock.ExpectExec(`UPDATE Subscriptions SET ends_at = \?, cancelled_at = COALESCE\(cancelled_at, \?\) WHERE id = \?`).
+		WithArgs(startsAt, sqlmock.AnyArg(), int64(9)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	// 뒤에 이어지는 구독은 환불된 기간만큼 앞당김
+	seconds := int64(endsAt.Sub(startsAt) / time.Second)
+	mock.ExpectExec(`UPDATE Subscriptions\s+SET starts_at = DATE_SUB\(starts_at, INTERVAL \? SECOND\), ends_at = DATE_SUB\(ends_at, INTERVAL \? SECOND\)\s+WHERE user_id = \? AND id <> \? AND starts_at >= \?`).
+		WithArgs(seconds, seconds, int64(1), int64(9), endsAt).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	processed, err := billingService.HandleWebhook(payload, signature)
+	assert.NoError(t, err)
+	assert.True(t, processed)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// expectRefundedSubscription 환불된 구독의 계정 잠금과 기간 조회 기대값 설정
+func expectRefundedSubscription(mock sqlmock.Sqlmock, startsAt, endsAt time.Time) {
+	mock.ExpectQuery(`SELECT user_id FROM Subscriptions WHERE id = \?`).
+		WithArgs(int64(9)).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id"}).AddRow(1))
+	mock.ExpectQuery(`SELECT id FROM Users WHERE id = \? FOR UPDATE`).
+		WithArgs(int64(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectQuery(`SELECT starts_at, ends_at FROM Subscriptions WHERE id = \? FOR UPDATE`).
+		WithArgs(int64(9)).
+		WillReturnRows(sqlmock.NewRows([]string{"starts_at", "ends_at"}).AddRow(startsAt, endsAt))
+}
+
+// 모의 결제 결과 처리 API는 billing.allow_simulation을 켠 경우에만 등록되는지 테스트
+func TestSimulatePaymentRouteRequiresFlag(t *testing.T) {
+	hasSimulateRoute := func(cfg *config.Config) bool {
+		return hasBillingRoute(cfg, "/api/billing/checkout/:id/simulate")
+	}
+
+	cfg := &config.Config{Environment: "development", Billing: config.BillingConfig{Provider: config.BillingProviderFake}}
+	assert.False(t, hasSimulateRoute(cfg))
+
+	cfg.Billing.AllowSimulation = true
+	assert.True(t, hasSimulateRoute(cfg))
+
+	cfg.Environment = "production"
+	assert.False(t, hasSimulateRoute(cfg))
+}
+
+// 웹훅 서명 키가 없으면 웹훅 라우트를 등록하지 않는지 테스트
+func TestBillingWebhookRouteRequiresSecret(t *testing.T) {
+	cfg := &config.Config{Environment: "production", Billing: config.BillingConfig{Provider: config.BillingProviderFake}}
+	assert.False(t, hasBillingRoute(cfg, "/api/billing/webhook"))
+
+	cfg.Billing.WebhookSecret = "test-webhook-secret"
+	assert.True(t, hasBillingRoute(cfg, "/api/billing/webhook"))
+}
+
+// 개발/테스트 환경에서만 웹훅 서명 키가 없을 때 임의 키를 사용하는지 테스트
+func TestDevelopmentWebhookSecret(t *testing.T) {
+	t.Setenv("BILLING_WEBHOOK_SECRET", "")
+
+	t.Setenv("APP_ENV", "test")
+	t.Setenv("MOCK_DB", "true")
+	first := config.LoadConfig().Billing.WebhookSecret
+	second := config.LoadConfig().Billing.WebhookSecret
+	assert.Len(t, first, 64)
+	assert.NotEqual(t, first, second)
+
+	t.Setenv("APP_ENV", "production")
+	t.Setenv("MOCK_DB", "")
+	assert.Empty(t, config.LoadConfig().Billing.WebhookSecret)
+}
+
+// hasBillingRoute 결제 라우트 설정 후 path가 등록되었는지 확인
+func hasBillingRoute(cfg *config.Config, path string) bool {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	route.SetupBillingRoutes(router.Group("/api"), cfg)
+	for _, r := range router.Routes() {
+		if r.Path == path {
+			return true
+		}
+	}
+	return false
+}
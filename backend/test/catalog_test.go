@@ -0,0 +1,302 @@
+package test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"backend/model"
+	"backend/service"
+)
+
+// CSV 카탈로그 읽기 테스트 (숫자 형식 오류는 줄 번호와 함께 보고)
+func TestParseCatalogCSV(t *testing.T) {
+	csv := "external_id,title,description,thumbnail_url,video_url,duration,release_year,maturity_level,genres\n" +
+		"ext:1,Movie One,\"Multi\nline\",/t/1.jpg,/v/1.mp4,5400,2020,12,액션|드라마\n" +
+		"ext:2,Movie Two,,/t/2.jpg,/v/2.mp4,long,2021,0,\n"
+
+	records, lineErrors, err := service.ParseCatalog(strings.NewReader(csv), service.CatalogFormatCSV)
+	assert.NoError(t, err)
+	if assert.Len(t, records, 1) {
+		assert.Equal(t, 2, records[0].Line)
+		assert.Equal(t, "Multi\nline", records[0].Entry.Description)
+		assert.Equal(t, []string{"액션", "드라마"}, records[0].Entry.Genres)
+		assert.Equal(t, 12, records[0].Entry.MaturityLevel)
+	}
+	if assert.Len(t, lineErrors, 1) {
+		assert.Equal(t, 4, lineErrors[0].Line)
+		assert.Contains(t, lineErrors[0].Error(), "줄 4: duration")
+	}
+
+	_, _, err = service.ParseCatalog(strings.NewReader("title\nMovie\n"), service.CatalogFormatCSV)
+	assert.Error(t, err)
+}
+
+// TMDB 목록 응답 읽기 테스트 (영상 주소와 길이가 없는 항목은 줄 단위 오류)
+func TestParseCatalogTMDB(t *testing.T) {
+	data := `{
+  "page": 1,
+  "results": [
+    {
+      "adult": false,
+      "backdrop_path": "/zfbjgQE1uSd9wiPTX4VzsLi0rGG.jpg",
+      "genre_ids": [18, 80],
+      "id": 278,
+      "original_language": "en",
+      "original_title": "The Shawshank Redemption",
+      "overview": "Imprisoned in the 1940s...",
+      "popularity": 130.421,
+      "poster_path": "/9cqNxx0GxF0bflZmeSMuL5tnGzr.jpg",
+      "release_date": "1994-09-23",
+      "title": "The Shawshank Redemption",
+      "video": false,
+      "vote_average": 8.7,
+      "vote_count": 27000,
+      "video_url": "https://cdn.example.com/videos/278.m3u8",
+      "duration": 8520
+    },
+    {
+      "adult": false,
+      "backdrop_path": "/tmU7GeKVybMWFButWEGl2M4GeiP.jpg",
+      "genre_ids": [18, 80],
+      "id": 238,
+      "original_language": "en",
+      "original_title": "The Godfather",
+      "overview": "Spanning the years 1945 to 1955...",
+      "popularity": 110.512,
+      "poster_path": "/3bhkrj58Vtu7enYsRolD1fZdja1.jpg",
+      "release_date": "1972-03-14",
+      "title": "The Godfather",
+      "video": false,
+      "vote_average": 8.7,
+      "vote_count": 20000
+    },
+    {
+      "adult": false,
+      "backdrop_path": "/kGzFbGhp99zva6oZODW5atUtnqi.jpg",
+      "genre_ids": [18, 80],
+      "id": 240,
+      "original_language": "en",
+      "original_title": "The Godfather Part II",
+      "overview": "In the continuing saga...",
+      "popularity": 70.123,
+      "poster_path": "/hek3koDUyRQk7FIhPXsa6mT2Zc3.jpg",
+      "release_date": "1974-12-20",
+      "title": "The Godfather Part II",
+      "video": false,
+      "vote_average": 8.6,
+      "vote_count": 12000,
+      "video_url": "https://cdn.example.com/videos/240.m3u8"
+    }
+  ],
+  "total_pages": 500,
+  "total_results": 10000
+}`
+
+	records, lineErrors, err := service.ParseCatalog(strings.NewReader(data), service.CatalogFormatTMDB)
+	assert.NoError(t, err)
+	if assert.Len(t, records, 1) {
+		entry := records[0].Entry
+		assert.Equal(t, 4, records[0].Line)
+		assert.Equal(t, "tmdb:278", entry.ExternalID)
+		assert.Equal(t, "https://image.tmdb.org/t/p/w500/9cqNxx0GxF0bflZmeSMuL5tnGzr.jpg", entry.ThumbnailURL)
+		assert.Equal(t, "https://cdn.example.com/videos/278.m3u8", entry.VideoURL)
+		assert.Equal(t, 8520, entry.Duration)
+		assert.Equal(t, 1994, entry.ReleaseYear)
+		assert.Equal(t, []string{"드라마", "범죄"}, entry.Genres)
+	}
+	if assert.Len(t, lineErrors, 2) {
+		assert.Equal(t, 22, lineErrors[0].Line)
+		assert.Contains(t, lineErrors[0].Error(), "video_url")
+		assert.Equal(t, 38, lineErrors[1].Line)
+		assert.Contains(t, lineErrors[1].Error(), "duration")
+	}
+}
+
+// TMDB 상세 응답 읽기 테스트 (runtime으로 영상 길이 계산, 알 수 없는 장르는 오류)
+func TestParseCatalogTMDBDetail(t *testing.T) {
+	data := `[
+  {
+    "id": 278,
+    "title": "The Shawshank Redemption",
+    "runtime": 142,
+    "genres": [{"id": 18, "name": "Drama"}],
+    "video_url": "https://cdn.example.com/videos/278.m3u8"
+  },
+  {
+    "id": 1,
+    "title": "Unknown Genre",
+    "runtime": 90,
+    "genre_ids": [123456],
+    "video_url": "https://cdn.example.com/videos/1.m3u8"
+  }
+]`
+
+	records, lineErrors, err := service.ParseCatalog(strings.NewReader(data), service.CatalogFormatTMDB)
+	assert.NoError(t, err)
+	if assert.Len(t, records, 1) {
+		assert.Equal(t, 142*60, records[0].Entry.Duration)
+		assert.Equal(t, []string{"드라마"}, records[0].Entry.Genres)
+	}
+	if assert.Len(t, lineErrors, 1) {
+		assert.Equal(t, 9, lineErrors[0].Line)
+		assert.Contains(t, lineErrors[0].Error(), "123456")
+	}
+}
+
+// 카탈로그 검증 테스트 (필드 오류와 외부 ID 중복을 줄 단위로 보고)
+func TestCatalogPlanValidation(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	valid := catalogTestEntry("ext:1")
+	invalid := catalogTestEntry("ext:2")
+	invalid.Title = ""
+	invalid.MaturityLevel = 7
+
+	_, lineErrors, err := service.NewCatalogService(db, 0).Plan([]service.CatalogRecord{
+		{Line: 2, Entry: valid},
+		{Line: 3, Entry: invalid},
+		{Line: 4, Entry: valid},
+	})
+	assert.ErrorIs(t, err, service.ErrInvalidCatalog)
+	if assert.Len(t, lineErrors, 2) {
+		assert.Equal(t, 3, lineErrors[0].Line)
+		assert.Contains(t, lineErrors[0].Error(), "title")
+		assert.Contains(t, lineErrors[0].Error(), "maturity_level")
+		assert.Equal(t, 4, lineErrors[1].Line)
+		assert.Contains(t, lineErrors[1].Error(), "2번째 줄과 중복")
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// 가져오기 계획(dry-run) 테스트 (추가/변경/변경 없음, 새 장르)
+func TestCatalogPlanDiff(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	unchanged := catalogTestEntry("ext:1")
+	updated := catalogTestEntry("ext:2")
+	updated.Title = "New Title"
+	created := catalogTestEntry("ext:3")
+	created.Genres = []string{"액션", "서부"}
+
+	mock.ExpectQuery(`FROM\s+Contents c\s+WHERE c.external_id IN \(\?, \?, \?\)`).
+		WithArgs("ext:1", "ext:2", "ext:3").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "external_id", "title", "description", "thumbnail_url", "video_url", "duration", "release_year", "maturity_level"}).
+			AddRow(10, "ext:1", "Movie", "", "/t.jpg", "/v.mp4", 5400, 2020, 0).
+			AddRow(11, "ext:2", "Movie", "", "/t.jpg", "/v.mp4", 5400, 2020, 0))
+	mock.ExpectQuery(`SELECT\s+cg.content_id, g.name`).
+		WithArgs(int64(10), int64(11)).
+		WillReturnRows(sqlmock.NewRows([]string{"content_id", "name"}).AddRow(10, "액션").AddRow(11, "액션"))
+	mock.ExpectQuery(`SELECT name FROM Genres WHERE name IN \(\?, \?\)`).
+		WithArgs("액션", "서부").
+		WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("액션"))
+
+	plan, lineErrors, err := service.NewCatalogService(db, 0).Plan([]service.CatalogRecord{
+		{Line: 2, Entry: unchanged},
+		{Line: 3, Entry: updated},
+		{Line: 4, Entry: created},
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, lineErrors)
+	if assert.Len(t, plan.Changes, 3) {
+		assert.Equal(t, service.CatalogActionUnchanged, plan.Changes[0].Action)
+		assert.Equal(t, service.CatalogActionUpdate, plan.Changes[1].Action)
+		assert.Equal(t, []string{"title"}, plan.Changes[1].Fields)
+		assert.Equal(t, service.CatalogActionCreate, plan.Changes[2].Action)
+	}
+	assert.Equal(t, []string{"서부"}, plan.NewGenres)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// 가져오기 저장 테스트 (변경 없는 항목은 건너뛰고 배치 단위 트랜잭션으로 저장)
+func TestCatalogApply(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	updated := catalogTestEntry("ext:2")
+	updated.Title = "New Title"
+	created := catalogTestEntry("ext:3")
+
+	mock.ExpectQuery(`FROM\s+Contents c\s+WHERE c.external_id IN \(\?\)`).
+		WithArgs("ext:2").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "external_id", "title", "description", "thumbnail_url", "video_url", "duration", "release_year", "maturity_level"}).
+			AddRow(11, "ext:2", "Movie", "", "/t.jpg", "/v.mp4", 5400, 2020, 0))
+	mock.ExpectQuery(`SELECT\s+cg.content_id, g.name`).
+		WillReturnRows(sqlmock.NewRows([]string{"content_id", "name"}).AddRow(11, "액션"))
+	mock.ExpectQuery(`FROM\s+Contents c\s+WHERE c.external_id IN \(\?\)`).
+		WithArgs("ext:3").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "external_id", "title", "description", "thumbnail_url", "video_url", "duration", "release_year", "maturity_level"}))
+	mock.ExpectQuery(`SELECT name FROM Genres`).
+		WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("액션"))
+
+	catalogService := service.NewCatalogService(db, 1)
+	plan, _, err := catalogService.Plan([]service.CatalogRecord{{Line: 2, Entry: updated}, {Line: 3, Entry: created}})
+	assert.NoError(t, err)
+
+	// 배치 1: 기존 콘텐츠 변경 및 장르 교체
+	mock.ExpectBegin()
+	mock.ExpectExec(`INSERT IGNORE INTO Genres \(name\) VALUES \(\?\)`).WithArgs("액션").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(`SELECT id, name FROM Genres WHERE name IN \(\?\)`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(1, "액션"))
+	mock.ExpectExec(`UPDATE Contents\s+SET title = \?`).
+		WithArgs("New Title", "", "/t.jpg", "/v.mp4", 5400, 2020, 0, int64(11)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`DELETE FROM ContentGenres WHERE content_id = \?`).WithArgs(int64(11)).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`INSERT INTO ContentGenres`).WithArgs(int64(11), int64(1)).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// 배치 2: 새 콘텐츠 추가
+	mock.ExpectBegin()
+	mock.ExpectExec(`INSERT IGNORE INTO Genres`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(`SELECT id, name FROM Genres`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(1, "액션"))
+	mock.ExpectExec(`INSERT INTO Contents \(external_id, title`).
+		WithArgs("ext:3", "Movie", "", "/t.jpg", "/v.mp4", 5400, 2020, 0).
+		WillReturnResult(sqlmock.NewResult(12, 1))
+	mock.ExpectExec(`INSERT INTO ContentGenres`).WithArgs(int64(12), int64(1)).WillReturnResult(sqlmock.NewResult(2, 1))
+	mock.ExpectCommit()
+
+	applied, err := catalogService.Apply(plan)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, applied)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// 카탈로그 내보내기 후 다시 읽기 테스트
+func TestWriteCatalogRoundTrip(t *testing.T) {
+	entries := []model.CatalogEntry{catalogTestEntry("ext:1")}
+	entries[0].Description = "쉼표, 따옴표 \"포함\""
+
+	for _, format := range []string{service.CatalogFormatCSV, service.CatalogFormatJSON} {
+		var buf bytes.Buffer
+		assert.NoError(t, service.WriteCatalog(&buf, format, entries))
+
+		records, lineErrors, err := service.ParseCatalog(&buf, format)
+		assert.NoError(t, err)
+		assert.Empty(t, lineErrors)
+		if assert.Len(t, records, 1) {
+			assert.Equal(t, entries[0], records[0].Entry)
+		}
+	}
+}
+
+// catalogTestEntry 테스트용 카탈로그 항목
+func catalogTestEntry(externalID string) model.CatalogEntry {
+	return model.CatalogEntry{
+		ExternalID:   externalID,
+		Title:        "Movie",
+		ThumbnailURL: "/t.jpg",
+		VideoURL:     "/v.mp4",
+		Duration:     5400,
+		ReleaseYear:  2020,
+		Genres:       []string{"액션"},
+	}
+}
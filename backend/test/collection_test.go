@@ -0,0 +1,165 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"backend/config"
+	"backend/model"
+	"backend/service"
+)
+
+// collectionRows 컬렉션 조회 결과 행
+func collectionRows() *sqlmock.Rows {
+	return sqlmock.NewRows([]string{
+		"id", "slug", "title", "description", "hero_image_url", "position", "starts_at", "ends_at", "item_count",
+	})
+}
+
+// 컬렉션 요청 검증 테스트
+func TestCollectionRequestValidate(t *testing.T) {
+	now := time.Now()
+	later := now.Add(time.Hour)
+
+	tests := []struct {
+		name    string
+		req     model.CollectionRequest
+		wantErr bool
+	}{
+		{"정상", model.CollectionRequest{Slug: "oscar-winners", Title: "아카데미 수상작"}, false},
+		{"노출 기간 지정", model.CollectionRequest{Slug: "summer-2026", Title: "여름 특선", StartsAt: &now, EndsAt: &later}, false},
+		{"대문자 슬러그", model.CollectionRequest{Slug: "Oscar", Title: "아카데미 수상작"}, true},
+		{"하이픈으로 끝나는 슬러그", model.CollectionRequest{Slug: "oscar-", Title: "아카데미 수상작"}, true},
+		{"종료가 시작보다 이름", model.CollectionRequest{Slug: "summer", Title: "여름 특선", StartsAt: &later, EndsAt: &now}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.req.Validate()
+			if tt.wantErr {
+				assert.ErrorIs(t, err, model.ErrInvalidCollection)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+// 컬렉션 콘텐츠 조회 시 장르와 찜 여부가 채워지는지 테스트
+func TestGetCollectionContents(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`FROM\s+CollectionItems ci\s+JOIN\s+Contents c ON c.id = ci.content_id[\s\S]*ORDER BY\s+ci.position ASC`).
+		WithArgs(int64(1), int64(7), "KR", 20).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "thumbnail_url", "release_year"}).
+			AddRow(10, "Parasite", "/thumbnails/10.jpg", 2019).
+			AddRow(2, "The Godfather", "/thumbnails/2.jpg", 1972))
+	mock.ExpectQuery(`SELECT\s+cg.content_id, g.name`).
+		WillReturnRows(sqlmock.NewRows([]string{"content_id", "name"}).
+			AddRow(10, "드라마").
+			AddRow(2, "범죄"))
+	mock.ExpectQuery(`SELECT\s+content_id\s+FROM\s+Wishlists`).
+		WithArgs(int64(7), int64(10), int64(2)).
+		WillReturnRows(sqlmock.NewRows([]string{"content_id"}).AddRow(2))
+	mock.ExpectQuery(`LEFT JOIN\s+ContentRatings r`).
+		WithArgs(int64(7), int64(10), int64(2)).
+		WillReturnRows(contentRatingRows().
+			AddRow(10, 5, 0, 4, 18, nil, nil, nil).
+			AddRow(2, 3, 1, 2, 9, -1, 2, time.Now()))
+
+	items, err := model.GetCollectionContents(db, 1, model.Viewer{ProfileID: 7, Country: "KR"}, 20)
+	assert.NoError(t, err)
+	assert.Len(t, items, 2)
+
+	// 컬렉션 순서 유지
+	assert.Equal(t, int64(10), items[0].ID)
+	assert.Equal(t, []string{"드라마"}, items[0].Genres)
+	assert.False(t, items[0].IsWishlisted)
+	assert.Equal(t, int64(2), items[1].ID)
+	assert.True(t, items[1].IsWishlisted)
+	assert.Equal(t, 4.5, items[0].Rating.AverageStars)
+	assert.Nil(t, items[0].MyRating)
+	assert.Equal(t, model.ThumbDown, items[1].MyRating.Thumb)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// 컬렉션 콘텐츠 교체 테스트
+func TestReplaceCollectionItems(t *testing.T) {
+	t.Run("중복 제거 후 순서대로 저장", func(t *testing.T) {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(`SELECT COUNT\(\*\) FROM Contents WHERE id IN \(\?, \?\)`).
+			WithArgs(int64(3), int64(1)).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+		mock.ExpectBegin()
+		mock.ExpectExec(`DELETE FROM CollectionItems WHERE collection_id = \?`).
+			WithArgs(int64(5)).WillReturnResult(sqlmock.NewResult(0, 4))
+		mock.ExpectExec(`INSERT INTO CollectionItems`).
+			WithArgs(int64(5), int64(3), 0).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`INSERT INTO CollectionItems`).
+			WithArgs(int64(5), int64(1), 1).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		contentIDs, err := model.ReplaceCollectionItems(db, 5, []int64{3, 1, 3})
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{3, 1}, contentIDs)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("존재하지 않는 콘텐츠", func(t *testing.T) {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(`SELECT COUNT\(\*\) FROM Contents WHERE id IN`).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+		_, err = model.ReplaceCollectionItems(db, 5, []int64{3, 999})
+		assert.ErrorIs(t, err, model.ErrCollectionContentMissing)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+// 홈 화면 컬렉션 행 테스트 (볼 수 있는 콘텐츠가 없는 컬렉션은 건너뜀)
+func TestBuildHomeCollectionRows(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	homeConfig := config.HomeConfig{
+		AnonymousRows: []config.HomeRowConfig{
+			{Type: config.HomeRowCollections, Title: "%s", Limit: 10, Count: 1},
+		},
+	}
+
+	mock.ExpectQuery(`FROM Collections col\s+WHERE`).
+		WillReturnRows(collectionRows().
+			AddRow(1, "adult-only", "성인 전용", "", "", 0, nil, nil, 3).
+			AddRow(2, "anime-classics", "애니메이션 명작", "", "/heroes/anime.jpg", 1, nil, nil, 2).
+			AddRow(3, "oscar-winners", "아카데미 수상작", "", "", 2, nil, nil, 4))
+	mock.ExpectQuery(`FROM\s+CollectionItems ci`).WithArgs(int64(1), int64(0), "KR", 10).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "thumbnail_url", "release_year"}))
+	mock.ExpectQuery(`FROM\s+CollectionItems ci`).WithArgs(int64(2), int64(0), "KR", 10).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "thumbnail_url", "release_year"}).
+			AddRow(6, "Spirited Away", "/thumbnails/6.jpg", 2001))
+	mock.ExpectQuery(`SELECT\s+cg.content_id, g.name`).
+		WillReturnRows(sqlmock.NewRows([]string{"content_id", "name"}).AddRow(6, "애니메이션"))
+	mock.ExpectQuery(`LEFT JOIN\s+ContentRatings r`).
+		WillReturnRows(contentRatingRows().AddRow(6, 0, 0, 0, 0, nil, nil, nil))
+
+	home, err := service.NewHomeService(db, homeConfig).BuildHome(model.Viewer{Country: "KR"})
+	assert.NoError(t, err)
+	assert.Len(t, home.Rows, 1)
+	assert.Equal(t, "collections:anime-classics", home.Rows[0].Key)
+	assert.Equal(t, "애니메이션 명작", home.Rows[0].Title)
+	assert.Equal(t, "/heroes/anime.jpg", home.Rows[0].HeroImageURL)
+	assert.Len(t, home.Rows[0].Items, 1)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,218 @@
+package test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"backend/model"
+)
+
+// expectContentListDetails 장르/찜/평가 정보 일괄 조회 쿼리 기대값 설정
+// 목록 크기와 관계없이 장르 1회, 찜 1회(로그인 시), 평가 1회만 조회되어야 함
+func expectContentListDetails(mock sqlmock.Sqlmock, profileID int64) {
+	expectContentGenresAndWishlist(mock, profileID)
+	expectContentRatings(mock, profileID)
+}
+
+// expectContentGenresAndWishlist 장르/찜 정보 일괄 조회 쿼리 기대값 설정 (찜은 profileID가 있을 때만)
+func expectContentGenresAndWishlist(mock sqlmock.Sqlmock, profileID int64) {
+	mock.ExpectQuery(`SELECT\s+cg.content_id, g.name\s+FROM\s+Genres g.*IN \(\?, \?, \?\)`).
+		WithArgs(int64(1), int64(2), int64(3)).
+		WillReturnRows(sqlmock.NewRows([]string{"content_id", "name"}).
+			AddRow(1, "액션").
+			AddRow(1, "스릴러").
+			AddRow(2, "코미디").
+			AddRow(3, "드라마"))
+
+	if profileID > 0 {
+		mock.ExpectQuery(`SELECT\s+content_id\s+FROM\s+Wishlists\s+WHERE\s+profile_id = \? AND content_id IN \(\?, \?, \?\)`).
+			WithArgs(profileID, int64(1), int64(2), int64(3)).
+			WillReturnRows(sqlmock.NewRows([]string{"content_id"}).AddRow(2))
+	}
+}
+
+// expectContentRatings 평가 집계/내 평가 일괄 조회 쿼리 기대값 설정 (로그인 시 1번 콘텐츠에 내 평가 있음)
+func expectContentRatings(mock sqlmock.Sqlmock, profileID int64) {
+	ratingRows := contentRatingRows().
+		AddRow(1, 10, 2, 8, 34, nil, nil, nil).
+		AddRow(2, 0, 0, 0, 0, nil, nil, nil).
+		AddRow(3, 1, 0, 1, 5, nil, nil, nil)
+	if profileID > 0 {
+		ratingRows = contentRatingRows().
+			AddRow(1, 10, 2, 8, 34, 1, 5, time.Now()).
+			AddRow(2, 0, 0, 0, 0, nil, nil, nil).
+			AddRow(3, 1, 0, 1, 5, nil, nil, nil)
+	}
+	mock.ExpectQuery(`FROM\s+Contents c\s+LEFT JOIN\s+ContentRatings r ON r.content_id = c.id AND r.profile_id = \?\s+WHERE\s+c.id IN \(\?, \?, \?\)`).
+		WithArgs(profileID, int64(1), int64(2), int64(3)).
+		WillReturnRows(ratingRows)
+}
+
+// contentRatingRows 콘텐츠 평가 집계 일괄 조회 결과 컬럼
+func contentRatingRows() *sqlmock.Rows {
+	return sqlmock.NewRows([]string{
+		"id", "thumbs_up_count", "thumbs_down_count", "star_rating_count", "star_rating_sum", "thumb", "stars", "updated_at",
+	})
+}
+
+// contentListRows 콘텐츠 목록 기본 조회 결과
+func contentListRows() *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"id", "title", "thumbnail_url", "release_year"}).
+		AddRow(1, "영화 1", "/thumbnails/1.jpg", 2023).
+		AddRow(2, "영화 2", "/thumbnails/2.jpg", 2022).
+		AddRow(3, "영화 3", "/thumbnails/3.jpg", 2021)
+}
+
+// 콘텐츠 목록 조회 쿼리 수 테스트 (N+1 쿼리 제거 확인)
+func TestContentListQueryCount(t *testing.T) {
+	testCases := []struct {
+		name            string
+		profileID       int64
+		ratingProfileID int64 // 평가 조회 프로필 (profileID와 다를 때만 지정)
+		setup           func(mock sqlmock.Sqlmock)
+		call            func(db *sql.DB, profileID int64) ([]model.ContentListResponse, error)
+	}{
+		{
+			name:      "콘텐츠 목록 조회",
+			profileID: 1,
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT COUNT\(\*\)\s+FROM Contents c`).
+					WithArgs(int64(1), "KR").
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+				mock.ExpectQuery(`SELECT\s+c.id, c.title, c.thumbnail_url, c.release_year\s+FROM\s+Contents c`).
+					WithArgs(int64(1), "KR", 10, 0).
+					WillReturnRows(contentListRows())
+			},
+			call: func(db *sql.DB, profileID int64) ([]model.ContentListResponse, error) {
+				pageInfo, err := model.GetContentList(db, model.Viewer{ProfileID: profileID, Country: "KR"}, 0, 10, "")
+				if err != nil {
+					return nil, err
+				}
+				return pageInfo.Content.([]model.ContentListResponse), nil
+			},
+		},
+		{
+			name:      "콘텐츠 검색",
+			profileID: 1,
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT COUNT\(\*\)\s+FROM Contents c\s+WHERE .*maturity_level.* AND \(c.title LIKE \? OR EXISTS \(SELECT 1 FROM ContentTranslations ct WHERE ct.content_id = c.id AND ct.title LIKE \?\)\)`).
+					WithArgs(int64(1), "KR", "%영화%", "%영화%").
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+				mock.ExpectQuery(`SELECT\s+c.id, c.title, c.thumbnail_url, c.release_year\s+FROM\s+Contents c\s+WHERE .*maturity_level.* AND \(c.title LIKE \? OR EXISTS`).
+					WithArgs(int64(1), "KR", "%영화%", "%영화%", 10, 0).
+					WillReturnRows(contentListRows())
+			},
+			call: func(db *sql.DB, profileID int64) ([]model.ContentListResponse, error) {
+				pageInfo, err := model.SearchContents(db, "영화", model.Viewer{ProfileID: profileID, Country: "KR"}, 0, 10, "")
+				if err != nil {
+					return nil, err
+				}
+				return pageInfo.Content.([]model.ContentListResponse), nil
+			},
+		},
+		{
+			name:      "장르별 콘텐츠 조회 (비로그인)",
+			profileID: 0,
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT COUNT\(\*\)\s+FROM Contents c JOIN ContentGenres cg ON c.id = cg.content_id\s+WHERE .*maturity_level.* AND cg.genre_id = \?`).
+					WithArgs(int64(0), "KR", int64(1)).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+				mock.ExpectQuery(`SELECT\s+c.id, c.title, c.thumbnail_url, c.release_year\s+FROM\s+Contents c JOIN ContentGenres cg`).
+					WithArgs(int64(0), "KR", int64(1), 10, 0).
+					WillReturnRows(contentListRows())
+			},
+			call: func(db *sql.DB, profileID int64) ([]model.ContentListResponse, error) {
+				pageInfo, err := model.GetContentsByGenre(db, 1, model.Viewer{ProfileID: profileID, Country: "KR"}, 0, 10, "")
+				if err != nil {
+					return nil, err
+				}
+				return pageInfo.Content.([]model.ContentListResponse), nil
+			},
+		},
+		{
+			name:            "찜 목록 조회",
+			profileID:       0, // 찜 목록은 모두 찜 상태이므로 찜 여부 조회를 생략
+			ratingProfileID: 1,
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT\s+c.id, c.title, c.thumbnail_url, c.release_year\s+FROM\s+Contents c\s+JOIN\s+Wishlists w`).
+					WithArgs(int64(1), int64(1), "KR").
+					WillReturnRows(contentListRows())
+			},
+			call: func(db *sql.DB, profileID int64) ([]model.ContentListResponse, error) {
+				return model.GetWishlist(db, model.Viewer{ProfileID: 1, Country: "KR"})
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+			assert.NoError(t, err)
+			defer db.Close()
+
+			tc.setup(mock)
+			ratingProfileID := tc.profileID
+			if tc.ratingProfileID > 0 {
+				ratingProfileID = tc.ratingProfileID
+			}
+			expectContentGenresAndWishlist(mock, tc.profileID)
+			expectContentRatings(mock, ratingProfileID)
+
+			contentList, err := tc.call(db, tc.profileID)
+			assert.NoError(t, err)
+			assert.Len(t, contentList, 3)
+
+			// 장르가 콘텐츠별로 올바르게 분배되었는지 확인
+			assert.Equal(t, []string{"액션", "스릴러"}, contentList[0].Genres)
+			assert.Equal(t, []string{"코미디"}, contentList[1].Genres)
+			assert.Equal(t, []string{"드라마"}, contentList[2].Genres)
+
+			// 평가 집계 확인
+			assert.Equal(t, 4.3, contentList[0].Rating.AverageStars)
+			assert.Equal(t, 10, contentList[0].Rating.ThumbsUp)
+			assert.Equal(t, ratingProfileID > 0, contentList[0].MyRating != nil)
+
+			// 찜 상태 확인
+			if tc.profileID > 0 {
+				assert.False(t, contentList[0].IsWishlisted)
+				assert.True(t, contentList[1].IsWishlisted)
+			}
+
+			// 기대한 쿼리 외에 추가 쿼리가 실행되지 않았는지 확인
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+// 커서 페이징 찜 목록 조회 쿼리 수 테스트
+func TestWishlistByCursorQueryCount(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	now := time.Now()
+	mock.ExpectQuery(`SELECT\s+c.id, c.title, c.thumbnail_url, c.release_year, w.id, w.created_at`).
+		WithArgs(int64(1), int64(1), "KR", 3).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "thumbnail_url", "release_year", "wid", "created_at"}).
+			AddRow(1, "영화 1", "/thumbnails/1.jpg", 2023, 30, now).
+			AddRow(2, "영화 2", "/thumbnails/2.jpg", 2022, 20, now.Add(-time.Minute)).
+			AddRow(3, "영화 3", "/thumbnails/3.jpg", 2021, 10, now.Add(-2*time.Minute)))
+	mock.ExpectQuery(`SELECT\s+cg.content_id, g.name\s+FROM\s+Genres g.*IN \(\?, \?\)`).
+		WithArgs(int64(1), int64(2)).
+		WillReturnRows(sqlmock.NewRows([]string{"content_id", "name"}).AddRow(1, "액션"))
+	mock.ExpectQuery(`LEFT JOIN\s+ContentRatings r.*IN \(\?, \?\)`).
+		WithArgs(int64(1), int64(1), int64(2)).
+		WillReturnRows(contentRatingRows())
+
+	page, err := model.GetWishlistByCursor(db, model.Viewer{ProfileID: 1, Country: "KR"}, "", 2)
+	assert.NoError(t, err)
+	assert.True(t, page.HasNext)
+	assert.False(t, page.HasPrev)
+	assert.NotEmpty(t, page.NextCursor)
+	assert.Len(t, page.Content, 2)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
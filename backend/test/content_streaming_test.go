@@ -57,7 +57,7 @@ func setupTestRouterWithMock(cfg *config.Config, contentService *MockContentServ
 // createAuthHeader JWT 토큰으로 인증 헤더 생성
 func createAuthHeader(userID int64, cfg *config.Config) string {
 	// 테스트용 토큰 생성
-	token, _ := helper.GenerateToken(userID, "테스트사용자", "test@example.com", cfg)
+	token, _ := helper.GenerateToken(userID, userID, "테스트사용자", "test@example.com", cfg)
 	return fmt.Sprintf("Bearer %s", token)
 }
 
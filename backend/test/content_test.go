@@ -45,7 +45,7 @@ func TestGetContentList(t *testing.T) {
 			setupAuth: func(r *http.Request) {
 				// 테스트용 토큰 설정
 				cfg := config.LoadConfig()
-				token, _ := helper.GenerateToken(1, "user@example.com", "테스트사용자", cfg)
+				token, _ := helper.GenerateToken(1, 1, "user@example.com", "테스트사용자", cfg)
 				r.Header.Set("Authorization", "Bearer "+token)
 			},
 		},
@@ -234,7 +234,7 @@ func TestGetContentsByGenre(t *testing.T) {
 			setupAuth: func(r *http.Request) {
 				// 테스트용 토큰 설정
 				cfg := config.LoadConfig()
-				token, _ := helper.GenerateToken(1, "user@example.com", "테스트사용자", cfg)
+				token, _ := helper.GenerateToken(1, 1, "user@example.com", "테스트사용자", cfg)
 				r.Header.Set("Authorization", "Bearer "+token)
 			},
 		},
@@ -0,0 +1,154 @@
+package test
+
+import (
+	"archive/zip"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"backend/config"
+	"backend/model"
+	"backend/service"
+)
+
+// dataExportTestConfig 테스트용 내보내기/탈퇴 설정
+func dataExportTestConfig(t *testing.T) *config.Config {
+	return &config.Config{
+		DataExportDir:            t.TempDir(),
+		DataExportExpireHours:    72,
+		AccountDeletionGraceDays: 30,
+	}
+}
+
+// readZipEntry ZIP 파일 안의 파일 내용 읽기
+func readZipEntry(t *testing.T, archive *zip.ReadCloser, name string) string {
+	for _, file := range archive.File {
+		if file.Name != name {
+			continue
+		}
+		reader, err := file.Open()
+		assert.NoError(t, err)
+		defer reader.Close()
+		data, err := io.ReadAll(reader)
+		assert.NoError(t, err)
+		return string(data)
+	}
+	t.Fatalf("ZIP에 %s 파일이 없습니다", name)
+	return ""
+}
+
+// 내보내기 요청 및 ZIP 생성 테스트
+func TestDataExportBuild(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	cfg := dataExportTestConfig(t)
+	exportService := service.NewDataExportService(db, cfg)
+	now := time.Now()
+
+	// 생성 중인 요청이 없으면 새 요청 생성
+	mock.ExpectQuery(`FROM DataExports WHERE user_id = \? AND status = \?`).
+		WithArgs(int64(1), model.DataExportPending, sqlmock.AnyArg()).
+		WillReturnError(sql.ErrNoRows)
+	mock.ExpectExec(`INSERT INTO DataExports`).
+		WithArgs(int64(1), model.DataExportPending, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(5, 1))
+
+	export, created, err := exportService.RequestExport(1)
+	assert.NoError(t, err)
+	assert.True(t, created)
+	assert.Equal(t, int64(5), export.ID)
+	assert.WithinDuration(t, now.Add(72*time.Hour), export.ExpiresAt, time.Minute)
+
+	// ZIP 생성에 필요한 데이터 조회
+	mock.ExpectQuery(`FROM Users WHERE id = \?`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "email", "password_hash", "name", "created_at", "updated_at", "is_active", "email_verified"}).
+			AddRow(1, "user@example.com", "hash", "홍길동", now, now, true, true))
+	mock.ExpectQuery(`FROM Profiles\s+WHERE user_id = \?`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "name", "avatar_url", "language", "maturity_level", "is_default", "is_kids", "created_at", "updated_at"}).
+			AddRow(10, 1, "홍길동", "", "ko", 19, true, false, now, now).
+			AddRow(11, 1, "아이", "", "ko", 12, false, true, now, now))
+	mock.ExpectQuery(`FROM\s+ViewingHistories vh`).
+		WithArgs(int64(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"profile_id", "profile_name", "content_id", "title", "watch_duration", "last_position", "is_completed", "watched_at"}).
+			AddRow(10, "홍길동", 3, "기생충, 감독판", 3600, 120, false, now))
+	mock.ExpectQuery(`FROM\s+Wishlists w`).
+		WithArgs(int64(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"profile_id", "profile_name", "content_id", "title", "created_at"}).
+			AddRow(11, "아이", 4, "뽀로로", now))
+	mock.ExpectQuery(`FROM UserSessions\s+WHERE user_id = \?`).
+		WithArgs(int64(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"device_name", "user_agent", "ip_address", "created_at", "last_seen_at", "revoked_at"}).
+			AddRow("내 노트북", "Mozilla/5.0", "10.0.0.1", now, now, nil).
+			AddRow("휴대폰", "Mozilla/5.0", "10.0.0.2", now, now, now))
+	mock.ExpectExec(`UPDATE DataExports SET status = \?, file_path = \?, file_size = \?`).
+		WithArgs(model.DataExportCompleted, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), int64(5)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	assert.NoError(t, exportService.Build(export))
+	assert.NoError(t, mock.ExpectationsWereMet())
+
+	// ZIP 내용 확인
+	entries, err := os.ReadDir(cfg.DataExportDir)
+	assert.NoError(t, err)
+	if !assert.Len(t, entries, 1) {
+		return
+	}
+	archive, err := zip.OpenReader(cfg.DataExportDir + "/" + entries[0].Name())
+	assert.NoError(t, err)
+	defer archive.Close()
+
+	names := []string{}
+	for _, file := range archive.File {
+		names = append(names, file.Name)
+	}
+	assert.ElementsMatch(t, []string{
+		"account.json", "account.csv", "profiles.json", "profiles.csv",
+		"viewing_history.json", "viewing_history.csv", "wishlist.json", "wishlist.csv",
+		"sessions.json", "sessions.csv",
+	}, names)
+
+	var account model.UserResponse
+	assert.NoError(t, json.Unmarshal([]byte(readZipEntry(t, archive, "account.json")), &account))
+	assert.Equal(t, "user@example.com", account.Email)
+
+	records, err := csv.NewReader(strings.NewReader(strings.TrimPrefix(readZipEntry(t, archive, "viewing_history.csv"), "\ufeff"))).ReadAll()
+	assert.NoError(t, err)
+	assert.Len(t, records, 2)
+	assert.Equal(t, "profile_id", records[0][0])
+	assert.Equal(t, "기생충, 감독판", records[1][3])
+
+	var sessions []model.ExportedSession
+	assert.NoError(t, json.Unmarshal([]byte(readZipEntry(t, archive, "sessions.json")), &sessions))
+	assert.Len(t, sessions, 2)
+	assert.Nil(t, sessions[0].RevokedAt)
+	assert.NotNil(t, sessions[1].RevokedAt)
+}
+
+// 생성 중인 요청이 있으면 새 요청을 만들지 않음
+func TestDataExportReusesPendingRequest(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	now := time.Now()
+	mock.ExpectQuery(`FROM DataExports WHERE user_id = \? AND status = \?`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "status", "file_path", "file_size", "created_at", "completed_at", "expires_at"}).
+			AddRow(4, 1, model.DataExportPending, "", 0, now, nil, now.Add(time.Hour)))
+
+	export, created, err := service.NewDataExportService(db, dataExportTestConfig(t)).RequestExport(1)
+	assert.NoError(t, err)
+	assert.False(t, created)
+	assert.Equal(t, int64(4), export.ID)
+	assert.Nil(t, export.CompletedAt)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
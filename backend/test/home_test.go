@@ -0,0 +1,196 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"backend/config"
+	"backend/model"
+	"backend/service"
+)
+
+// 홈 화면 기본 구성 테스트
+func TestDefaultHomeConfig(t *testing.T) {
+	SetupTestDatabase(t)
+	defer TeardownTestDatabase(t)
+
+	cfg := config.LoadConfig()
+
+	// 비로그인 사용자에게는 개인화 행이 없어야 함
+	for _, row := range cfg.Home.AnonymousRows {
+		assert.NotEqual(t, config.HomeRowContinueWatching, row.Type)
+		assert.NotEqual(t, config.HomeRowMyList, row.Type)
+		assert.NotEqual(t, config.HomeRowBecauseYouWatched, row.Type)
+	}
+
+	// 로그인 사용자는 이어보기 행으로 시작
+	assert.NotEmpty(t, cfg.Home.AuthenticatedRows)
+	assert.Equal(t, config.HomeRowContinueWatching, cfg.Home.AuthenticatedRows[0].Type)
+}
+
+// 로그인 여부에 따른 홈 화면 구성 테스트
+func TestBuildHome(t *testing.T) {
+	homeConfig := config.HomeConfig{
+		AnonymousRows: []config.HomeRowConfig{
+			{Type: config.HomeRowNewReleases, Title: "새로 올라온 콘텐츠", Limit: 5},
+		},
+		AuthenticatedRows: []config.HomeRowConfig{
+			{Type: config.HomeRowContinueWatching, Title: "시청 중인 콘텐츠", Limit: 5},
+			{Type: config.HomeRowNewReleases, Title: "새로 올라온 콘텐츠", Limit: 5},
+		},
+	}
+
+	newReleaseRows := func() *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"id", "title", "thumbnail_url", "release_year"}).
+			AddRow(1, "영화 1", "/thumbnails/1.jpg", 2024)
+	}
+
+	t.Run("비로그인 사용자", func(t *testing.T) {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(`ORDER BY\s+c.created_at DESC`).WithArgs(int64(0), "KR", 5).WillReturnRows(newReleaseRows())
+		mock.ExpectQuery(`SELECT\s+cg.content_id, g.name`).
+			WillReturnRows(sqlmock.NewRows([]string{"content_id", "name"}).AddRow(1, "액션"))
+		mock.ExpectQuery(`LEFT JOIN\s+ContentRatings r`).
+			WillReturnRows(contentRatingRows())
+
+		home, err := service.NewHomeService(db, homeConfig).BuildHome(model.Viewer{Country: "KR"})
+		assert.NoError(t, err)
+		assert.False(t, home.IsAuthenticated)
+		assert.Len(t, home.Rows, 1)
+		assert.Equal(t, config.HomeRowNewReleases, home.Rows[0].Type)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("로그인 사용자", func(t *testing.T) {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(`FROM\s+ViewingHistories vh`).WithArgs(int64(7), int64(7), "KR", 5).
+			WillReturnRows(sqlmock.NewRows([]string{
+				"id", "content_id", "watch_duration", "last_position", "watched_at", "is_completed",
+				"title", "thumbnail_url", "duration",
+			}).AddRow(1, 2, 60, 60, time.Now(), false, "영화 2", "/thumbnails/2.jpg", 120))
+		mock.ExpectQuery(`ORDER BY\s+c.created_at DESC`).WithArgs(int64(7), "KR", 5).WillReturnRows(newReleaseRows())
+		mock.ExpectQuery(`SELECT\s+cg.content_id, g.name`).
+			WillReturnRows(sqlmock.NewRows([]string{"content_id", "name"}))
+		mock.ExpectQuery(`SELECT\s+content_id\s+FROM\s+Wishlists`).
+			WillReturnRows(sqlmock.NewRows([]string{"content_id"}))
+		mock.ExpectQuery(`LEFT JOIN\s+ContentRatings r`).
+			WillReturnRows(contentRatingRows())
+
+		home, err := service.NewHomeService(db, homeConfig).BuildHome(model.Viewer{ProfileID: 7, Country: "KR"})
+		assert.NoError(t, err)
+		assert.True(t, home.IsAuthenticated)
+		assert.Len(t, home.Rows, 2)
+		assert.Equal(t, config.HomeRowContinueWatching, home.Rows[0].Type)
+		assert.Equal(t, config.HomeRowNewReleases, home.Rows[1].Type)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+// 행 제목 치환 테스트 (%s만 이름으로 대체하고 제목의 다른 %는 유지)
+func TestHomeRowTitle(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	homeConfig := config.HomeConfig{
+		AnonymousRows: []config.HomeRowConfig{
+			{Type: config.HomeRowTopGenres, Title: "%s 만족도 100% 작품", Limit: 5, Count: 1},
+			{Type: config.HomeRowNewReleases, Title: "%d% 할인 중인 신작", Limit: 5},
+		},
+	}
+
+	mock.ExpectQuery(`FROM\s+Genres g\s+JOIN\s+ContentGenres cg`).WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "description"}).AddRow(3, "스릴러", ""))
+	mock.ExpectQuery(`SELECT COUNT\(\*\)`).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery(`LEFT JOIN ContentPopularity p`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "thumbnail_url", "release_year"}).
+			AddRow(1, "영화 1", "/thumbnails/1.jpg", 2024))
+	mock.ExpectQuery(`SELECT\s+cg.content_id, g.name`).
+		WillReturnRows(sqlmock.NewRows([]string{"content_id", "name"}))
+	mock.ExpectQuery(`LEFT JOIN\s+ContentRatings r`).
+		WillReturnRows(contentRatingRows())
+	mock.ExpectQuery(`ORDER BY\s+c.created_at DESC`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "thumbnail_url", "release_year"}).
+			AddRow(2, "영화 2", "/thumbnails/2.jpg", 2024))
+	mock.ExpectQuery(`SELECT\s+cg.content_id, g.name`).
+		WillReturnRows(sqlmock.NewRows([]string{"content_id", "name"}))
+	mock.ExpectQuery(`LEFT JOIN\s+ContentRatings r`).
+		WillReturnRows(contentRatingRows())
+
+	home, err := service.NewHomeService(db, homeConfig).BuildHome(model.Viewer{Country: "KR"})
+	assert.NoError(t, err)
+	assert.Len(t, home.Rows, 2)
+	assert.Equal(t, "스릴러 만족도 100% 작품", home.Rows[0].Title)
+
+	// %s가 없는 제목은 그대로 (fmt 서식 오류 문자열이 섞이지 않음)
+	assert.Equal(t, "%d% 할인 중인 신작", home.Rows[1].Title)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// 최근 시청 콘텐츠 기반 행은 지금 볼 수 있는 콘텐츠만 기준으로 사용하는지 테스트 (공개/시청 등급/국가 조건)
+func TestBecauseYouWatchedUsesCatalogCondition(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	homeConfig := config.HomeConfig{
+		AuthenticatedRows: []config.HomeRowConfig{
+			{Type: config.HomeRowBecauseYouWatched, Title: "%s 시청 후 추천", Limit: 5, Count: 1},
+		},
+	}
+
+	mock.ExpectQuery(`FROM\s+ViewingHistories vh\s+JOIN\s+Contents c[\s\S]*vh.profile_id = \?\s+AND \(c.publish_at IS NULL`).
+		WithArgs(int64(7), int64(7), "KR", 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title"}))
+
+	home, err := service.NewHomeService(db, homeConfig).BuildHome(model.Viewer{ProfileID: 7, Country: "KR"})
+	assert.NoError(t, err)
+	assert.Empty(t, home.Rows)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// 행 제목의 장르 이름을 선호 언어로 번역하는지 테스트
+func TestHomeRowTitleLocalized(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+	mock.MatchExpectationsInOrder(false)
+
+	homeConfig := config.HomeConfig{
+		AnonymousRows: []config.HomeRowConfig{
+			{Type: config.HomeRowTopGenres, Title: "Top %s", Limit: 5, Count: 1},
+		},
+	}
+
+	mock.ExpectQuery(`FROM\s+Genres g\s+JOIN\s+ContentGenres cg`).WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "description"}).AddRow(3, "스릴러", ""))
+	mock.ExpectQuery(`FROM\s+GenreTranslations gt`).WithArgs("스릴러", "en").
+		WillReturnRows(sqlmock.NewRows([]string{"name", "locale", "translated_name", "description"}).
+			AddRow("스릴러", "en", "Thriller", ""))
+	mock.ExpectQuery(`SELECT COUNT\(\*\)`).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery(`LEFT JOIN ContentPopularity p`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "thumbnail_url", "release_year"}).
+			AddRow(1, "영화 1", "/thumbnails/1.jpg", 2024))
+	mock.ExpectQuery(`SELECT\s+cg.content_id, g.name`).
+		WillReturnRows(sqlmock.NewRows([]string{"content_id", "name"}))
+	mock.ExpectQuery(`FROM\s+ContentTranslations`).
+		WillReturnRows(sqlmock.NewRows([]string{"content_id", "locale", "title", "description"}))
+	mock.ExpectQuery(`LEFT JOIN\s+ContentRatings r`).
+		WillReturnRows(contentRatingRows())
+
+	home, err := service.NewHomeService(db, homeConfig).BuildHome(model.Viewer{Country: "KR", Locales: []string{"en"}})
+	assert.NoError(t, err)
+	if assert.Len(t, home.Rows, 1) {
+		assert.Equal(t, "Top Thriller", home.Rows[0].Title)
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
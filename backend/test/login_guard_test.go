@@ -0,0 +1,319 @@
+package test
+
+import (
+	"bufio"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"backend/config"
+	"backend/helper"
+	"backend/service"
+)
+
+// loginProtectionTestConfig 테스트용 로그인 보호 설정 (2회까지 허용, 5회 실패 시 잠금)
+func loginProtectionTestConfig() config.LoginProtectionConfig {
+	return config.LoginProtectionConfig{
+		FreeAttempts:         2,
+		IPFreeAttempts:       3,
+		BackoffBaseSeconds:   1,
+		BackoffMaxSeconds:    4,
+		AccountLockThreshold: 5,
+		AccountLockMinutes:   15,
+		FailureWindowMinutes: 15,
+
+		ParentalPINMaxAttempts:        5,
+		TwoFactorMaxAttempts:          5,
+		TwoFactorChallengeMaxAttempts: 3,
+	}
+}
+
+// 연속 실패 시 대기 시간 증가 및 계정 잠금 테스트
+func TestLoginGuardBackoffAndLock(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	guard := service.NewLoginGuardService(db, helper.NewMemoryAttemptStore(), loginProtectionTestConfig())
+	guard.Config.IPFreeAttempts = 100
+
+	assert.Nil(t, guard.RecordFailure("User@Example.com", "10.0.0.1"))
+	assert.Nil(t, guard.RecordFailure("user@example.com", "10.0.0.1"))
+	assert.NoError(t, guard.Check("user@example.com", "10.0.0.1"))
+
+	// 허용 횟수 이후 실패할 때마다 대기 시간 두 배
+	blocked := guard.RecordFailure("user@example.com", "10.0.0.1")
+	assert.Equal(t, time.Second, blocked.RetryAfter)
+	assert.False(t, blocked.Locked)
+	assert.Equal(t, 2*time.Second, guard.RecordFailure("user@example.com", "10.0.0.1").RetryAfter)
+
+	var checkErr *service.LoginBlockedError
+	assert.True(t, errors.As(guard.Check("USER@example.com", "10.0.0.2"), &checkErr))
+	assert.False(t, checkErr.Locked)
+
+	// 잠금 기준 도달 시 감사 기록 저장
+	mock.ExpectQuery(`FROM Users WHERE email = \?`).
+		WithArgs("user@example.com").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "email", "password_hash", "name", "created_at", "updated_at", "is_active", "email_verified"}).
+			AddRow(7, "user@example.com", "hash", "사용자", time.Now(), time.Now(), true, true))
+	mock.ExpectExec(`INSERT INTO AccountLockouts`).
+		WithArgs(int64(7), "user@example.com", "10.0.0.1", 5, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	blocked = guard.RecordFailure("user@example.com", "10.0.0.1")
+	assert.True(t, blocked.Locked)
+	assert.Equal(t, 15*time.Minute, blocked.RetryAfter)
+
+	assert.True(t, errors.As(guard.Check("user@example.com", "10.0.0.3"), &checkErr))
+	assert.True(t, checkErr.Locked)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// IP별 대기 및 로그인 성공 시 계정 기록 초기화 테스트
+func TestLoginGuardIPBackoff(t *testing.T) {
+	guard := service.NewLoginGuardService(nil, helper.NewMemoryAttemptStore(), loginProtectionTestConfig())
+
+	// 여러 계정에 대한 같은 IP의 실패
+	for i := 0; i < 3; i++ {
+		assert.Nil(t, guard.RecordFailure(fmt.Sprintf("user%d@example.com", i), "10.0.0.9"))
+	}
+	blocked := guard.RecordFailure("user9@example.com", "10.0.0.9")
+	assert.Equal(t, time.Second, blocked.RetryAfter)
+	assert.Error(t, guard.Check("new@example.com", "10.0.0.9"))
+	assert.NoError(t, guard.Check("new@example.com", "10.0.0.10"))
+
+	// 성공하면 계정 실패 횟수는 초기화
+	guard.RecordFailure("me@example.com", "10.0.0.11")
+	guard.RecordFailure("me@example.com", "10.0.0.11")
+	guard.RecordSuccess("me@example.com")
+	assert.Nil(t, guard.RecordFailure("me@example.com", "10.0.0.11"))
+}
+
+// 관리자 잠금 해제 테스트
+func TestLoginGuardUnlock(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	store := helper.NewMemoryAttemptStore()
+	assert.NoError(t, store.Block("login:lock:account:user@example.com", time.Hour))
+	guard := service.NewLoginGuardService(db, store, loginProtectionTestConfig())
+	assert.Error(t, guard.Check("user@example.com", "10.0.0.1"))
+
+	mock.ExpectQuery(`FROM Users WHERE id = \?`).
+		WithArgs(int64(7)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "email", "password_hash", "name", "created_at", "updated_at", "is_active", "email_verified"}).
+			AddRow(7, "User@example.com", "hash", "사용자", time.Now(), time.Now(), true, true))
+	mock.ExpectExec(`UPDATE AccountLockouts SET unlocked_at = \?, unlocked_by = \?`).
+		WithArgs(sqlmock.AnyArg(), int64(1), int64(7), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	assert.NoError(t, guard.Unlock(7, 1))
+	assert.NoError(t, guard.Check("user@example.com", "10.0.0.1"))
+
+	mock.ExpectQuery(`FROM Users WHERE id = \?`).WillReturnError(sql.ErrNoRows)
+	assert.ErrorIs(t, guard.Unlock(8, 1), service.ErrUserNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// 2단계 인증 대기 토큰별 시도 횟수 제한과 계정별 코드 확인 잠금 테스트
+func TestTwoFactorAttemptLimits(t *testing.T) {
+	cfg := loginProtectionTestConfig()
+	cfg.TwoFactorMaxAttempts = 3
+	cfg.TwoFactorChallengeMaxAttempts = 2
+	guard := service.NewLoginGuardService(nil, helper.NewMemoryAttemptStore(), cfg)
+
+	// 대기 토큰 하나로는 2번까지만 시도 가능
+	for i := 0; i < 2; i++ {
+		assert.NoError(t, guard.BeginTwoFactorAttempt(7, "challenge-1"))
+		assert.Nil(t, guard.RecordTwoFactorFailure(7, "challenge-1"))
+	}
+	assert.ErrorIs(t, guard.BeginTwoFactorAttempt(7, "challenge-1"), service.ErrTwoFactorChallengeClosed)
+
+	// 새 대기 토큰으로 시도해도 계정의 연속 실패가 누적되어 잠금
+	assert.NoError(t, guard.BeginTwoFactorAttempt(7, "challenge-2"))
+	exceeded := guard.RecordTwoFactorFailure(7, "challenge-2")
+	if assert.NotNil(t, exceeded) {
+		assert.Equal(t, 15*time.Minute, exceeded.RetryAfter)
+	}
+	var locked *service.AttemptsExceededError
+	assert.ErrorAs(t, guard.BeginTwoFactorAttempt(7, "challenge-3"), &locked)
+
+	// 다른 계정에는 영향 없음
+	assert.NoError(t, guard.BeginTwoFactorAttempt(8, "challenge-4"))
+}
+
+// 로그인을 완료한 대기 토큰은 다시 사용할 수 없는지 테스트
+func TestTwoFactorChallengeSingleUse(t *testing.T) {
+	guard := service.NewLoginGuardService(nil, helper.NewMemoryAttemptStore(), loginProtectionTestConfig())
+
+	assert.NoError(t, guard.BeginTwoFactorAttempt(7, "challenge-1"))
+	assert.Nil(t, guard.RecordTwoFactorFailure(7, "challenge-1"))
+	assert.NoError(t, guard.BeginTwoFactorAttempt(7, "challenge-1"))
+	guard.RecordTwoFactorSuccess(7, "challenge-1")
+
+	assert.ErrorIs(t, guard.BeginTwoFactorAttempt(7, "challenge-1"), service.ErrTwoFactorChallengeClosed)
+	assert.NoError(t, guard.BeginTwoFactorAttempt(7, "challenge-2"))
+}
+
+// fakeRedisServer INCR/PEXPIRE/SET PX/PTTL/DEL/AUTH만 지원하는 테스트용 RESP 서버
+type fakeRedisServer struct {
+	listener net.Listener
+	mu       sync.Mutex
+	values   map[string]int64
+	expires  map[string]time.Time
+	commands []string
+}
+
+// newFakeRedisServer 로컬 포트에서 테스트용 RESP 서버 시작
+func newFakeRedisServer(t *testing.T) *fakeRedisServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+
+	s := &fakeRedisServer{listener: listener, values: map[string]int64{}, expires: map[string]time.Time{}}
+	t.Cleanup(func() { listener.Close() })
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+	return s
+}
+
+// serve 연결의 명령을 차례로 처리
+func (s *fakeRedisServer) serve(conn net.Conn) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+	for {
+		args, err := readRESPCommand(reader)
+		if err != nil {
+			return
+		}
+		io.WriteString(conn, s.execute(args))
+	}
+}
+
+// execute 명령 실행 후 RESP 응답 반환
+func (s *fakeRedisServer) execute(args []string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.commands = append(s.commands, strings.Join(args, " "))
+	now := time.Now()
+	for key, expiresAt := range s.expires {
+		if !now.Before(expiresAt) {
+			delete(s.values, key)
+			delete(s.expires, key)
+		}
+	}
+
+	switch strings.ToUpper(args[0]) {
+	case "AUTH":
+		if args[1] != "pass" {
+			return "-WRONGPASS invalid password\r\n"
+		}
+		return "+OK\r\n"
+	case "INCR":
+		s.values[args[1]]++
+		return fmt.Sprintf(":%d\r\n", s.values[args[1]])
+	case "PEXPIRE":
+		ms, _ := strconv.ParseInt(args[2], 10, 64)
+		s.expires[args[1]] = now.Add(time.Duration(ms) * time.Millisecond)
+		return ":1\r\n"
+	case "SET":
+		s.values[args[1]] = 1
+		ms, _ := strconv.ParseInt(args[4], 10, 64)
+		s.expires[args[1]] = now.Add(time.Duration(ms) * time.Millisecond)
+		return "+OK\r\n"
+	case "PTTL":
+		expiresAt, ok := s.expires[args[1]]
+		if !ok {
+			return ":-2\r\n"
+		}
+		return fmt.Sprintf(":%d\r\n", time.Until(expiresAt).Milliseconds())
+	case "DEL":
+		for _, key := range args[1:] {
+			delete(s.values, key)
+			delete(s.expires, key)
+		}
+		return fmt.Sprintf(":%d\r\n", len(args)-1)
+	}
+	return "-ERR unknown command\r\n"
+}
+
+// readRESPCommand RESP 배열 형식의 명령 읽기
+func readRESPCommand(r *bufio.Reader) ([]string, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	count, err := strconv.Atoi(strings.TrimSpace(line[1:]))
+	if err != nil {
+		return nil, err
+	}
+
+	args := make([]string, count)
+	for i := range args {
+		header, err := r.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		size, err := strconv.Atoi(strings.TrimSpace(header[1:]))
+		if err != nil {
+			return nil, err
+		}
+		data := make([]byte, size+2)
+		if _, err := io.ReadFull(r, data); err != nil {
+			return nil, err
+		}
+		args[i] = string(data[:size])
+	}
+	return args, nil
+}
+
+// Redis 호환 저장소 테스트
+func TestRedisAttemptStore(t *testing.T) {
+	server := newFakeRedisServer(t)
+	store := helper.NewRedisAttemptStore(server.listener.Addr().String(), "pass", 0)
+
+	count, err := store.Increment("login:fail:account:a@example.com", time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+	count, err = store.Increment("login:fail:account:a@example.com", time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+
+	assert.NoError(t, store.Block("login:lock:account:a@example.com", time.Minute))
+	remaining, err := store.BlockedFor("login:lock:account:a@example.com")
+	assert.NoError(t, err)
+	assert.True(t, remaining > 59*time.Second && remaining <= time.Minute)
+
+	assert.NoError(t, store.Reset("login:lock:account:a@example.com", "login:fail:account:a@example.com"))
+	remaining, err = store.BlockedFor("login:lock:account:a@example.com")
+	assert.NoError(t, err)
+	assert.Zero(t, remaining)
+
+	server.mu.Lock()
+	assert.Equal(t, "AUTH pass", server.commands[0])
+	assert.Equal(t, "INCR miniflix:login:fail:account:a@example.com", server.commands[1])
+	assert.Equal(t, "PEXPIRE miniflix:login:fail:account:a@example.com 60000", server.commands[2])
+	server.mu.Unlock()
+
+	// 잘못된 비밀번호는 서버 오류 응답으로 전달
+	_, err = helper.NewRedisAttemptStore(server.listener.Addr().String(), "wrong", 0).BlockedFor("x")
+	var redisErr helper.RedisError
+	assert.True(t, errors.As(err, &redisErr))
+}
@@ -0,0 +1,248 @@
+package test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+
+	"backend/config"
+	"backend/helper"
+	"backend/model"
+	"backend/service"
+)
+
+// mockOIDCProvider 테스트용 로컬 OpenID Connect 제공자
+type mockOIDCProvider struct {
+	server   *httptest.Server
+	key      *rsa.PrivateKey
+	clientID string
+	claims   jwt.MapClaims     // 토큰 엔드포인트가 발급할 ID 토큰 추가 클레임
+	userInfo map[string]string // userinfo 응답 (nil이면 404)
+}
+
+// newMockOIDCProvider 디스커버리/JWKS/토큰/userinfo 엔드포인트를 제공하는 모의 제공자 시작
+func newMockOIDCProvider(t *testing.T) *mockOIDCProvider {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+
+	p := &mockOIDCProvider{key: key, clientID: "miniflix-client", claims: jwt.MapClaims{}}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 p.server.URL,
+			"authorization_endpoint": p.server.URL + "/authorize",
+			"token_endpoint":         p.server.URL + "/token",
+			"userinfo_endpoint":      p.server.URL + "/userinfo",
+			"jwks_uri":               p.server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"keys": []map[string]string{{
+				"kty": "RSA",
+				"kid": "test-key",
+				"use": "sig",
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			}},
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if r.PostFormValue("code") != "valid-code" || r.PostFormValue("client_secret") != "secret" {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]string{
+			"access_token": "provider-access-token",
+			"token_type":   "Bearer",
+			"id_token":     p.signIDToken(t),
+		})
+	})
+	mux.HandleFunc("/userinfo", func(w http.ResponseWriter, r *http.Request) {
+		if p.userInfo == nil || r.Header.Get("Authorization") != "Bearer provider-access-token" {
+			http.NotFound(w, r)
+			return
+		}
+		json.NewEncoder(w).Encode(p.userInfo)
+	})
+
+	p.server = httptest.NewServer(mux)
+	t.Cleanup(p.server.Close)
+	return p
+}
+
+// signIDToken 기본 클레임에 추가 클레임을 더해 ID 토큰 서명
+func (p *mockOIDCProvider) signIDToken(t *testing.T) string {
+	now := time.Now()
+	claims := jwt.MapClaims{
+		"iss": p.server.URL,
+		"aud": p.clientID,
+		"sub": "provider-user-1",
+		"iat": now.Unix(),
+		"exp": now.Add(time.Hour).Unix(),
+	}
+	for k, v := range p.claims {
+		claims[k] = v
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = "test-key"
+	signed, err := token.SignedString(p.key)
+	assert.NoError(t, err)
+	return signed
+}
+
+// config 모의 제공자를 사용하는 설정
+func (p *mockOIDCProvider) config() *config.Config {
+	return &config.Config{
+		JWTSecret: "test-secret",
+		OIDCProviders: []config.OIDCProviderConfig{{
+			Name:         "mock",
+			DisplayName:  "Mock",
+			Issuer:       p.server.URL,
+			ClientID:     p.clientID,
+			ClientSecret: "secret",
+			RedirectURL:  "http://localhost:3000/oauth/mock/callback",
+		}},
+	}
+}
+
+// authorize 로그인을 시작하고 콜백 요청과 ID 토큰에 넣을 nonce 반환
+func (p *mockOIDCProvider) authorize(t *testing.T, oidcService *service.OIDCService) (*model.OIDCCallbackRequest, string) {
+	response, err := oidcService.Authorize(context.Background(), "mock")
+	assert.NoError(t, err)
+
+	authURL, err := url.Parse(response.AuthorizationURL)
+	assert.NoError(t, err)
+	query := authURL.Query()
+
+	return &model.OIDCCallbackRequest{
+		Code:       "valid-code",
+		State:      query.Get("state"),
+		StateToken: response.StateToken,
+	}, query.Get("nonce")
+}
+
+// 소셜 로그인 시작 URL 테스트
+func TestOIDCAuthorize(t *testing.T) {
+	provider := newMockOIDCProvider(t)
+	cfg := provider.config()
+	oidcService := service.NewOIDCService(nil, cfg)
+
+	response, err := oidcService.Authorize(context.Background(), "mock")
+	assert.NoError(t, err)
+
+	authURL, err := url.Parse(response.AuthorizationURL)
+	assert.NoError(t, err)
+	assert.Equal(t, provider.server.URL+"/authorize", authURL.Scheme+"://"+authURL.Host+authURL.Path)
+
+	query := authURL.Query()
+	assert.Equal(t, "code", query.Get("response_type"))
+	assert.Equal(t, "miniflix-client", query.Get("client_id"))
+	assert.Equal(t, "openid email profile", query.Get("scope"))
+
+	state, err := helper.ValidateOIDCStateToken(response.StateToken, cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, "mock", state.Provider)
+	assert.Equal(t, query.Get("state"), state.State)
+	assert.Equal(t, query.Get("nonce"), state.Nonce)
+
+	_, err = oidcService.Authorize(context.Background(), "unknown")
+	assert.ErrorIs(t, err, service.ErrOIDCProviderNotFound)
+	assert.Equal(t, []model.OIDCProviderResponse{{Name: "mock", DisplayName: "Mock"}}, oidcService.Providers())
+}
+
+// 인증된 이메일로 기존 계정 연결 테스트
+func TestOIDCAuthenticateLinksVerifiedEmail(t *testing.T) {
+	provider := newMockOIDCProvider(t)
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	oidcService := service.NewOIDCService(db, provider.config())
+	req, nonce := provider.authorize(t, oidcService)
+	provider.claims = jwt.MapClaims{"nonce": nonce, "email": "user@example.com", "email_verified": true, "name": "홍길동"}
+
+	mock.ExpectQuery(`FROM UserIdentities\s+WHERE provider = \? AND subject = \?`).
+		WithArgs("mock", "provider-user-1").
+		WillReturnError(sql.ErrNoRows)
+	mock.ExpectQuery(`FROM Users WHERE email = \?`).
+		WithArgs("user@example.com").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "email", "password_hash", "name", "created_at", "updated_at", "is_active", "email_verified"}).
+			AddRow(3, "user@example.com", "hash", "홍길동", time.Now(), time.Now(), true, true))
+	mock.ExpectExec(`INSERT INTO UserIdentities`).
+		WithArgs(int64(3), "mock", "provider-user-1", "user@example.com", sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	user, err := oidcService.Authenticate(context.Background(), "mock", req)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), user.ID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// 미인증 기존 계정에는 연결하지 않는지 테스트 (ID 토큰에 없는 인증 여부는 userinfo로 확인)
+func TestOIDCAuthenticateRejectsUnverifiedAccount(t *testing.T) {
+	provider := newMockOIDCProvider(t)
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	oidcService := service.NewOIDCService(db, provider.config())
+	req, nonce := provider.authorize(t, oidcService)
+	provider.claims = jwt.MapClaims{"nonce": nonce, "email": "user@example.com"}
+	provider.userInfo = map[string]string{"sub": "provider-user-1", "email": "user@example.com", "email_verified": "true"}
+
+	mock.ExpectQuery(`FROM UserIdentities`).WillReturnError(sql.ErrNoRows)
+	mock.ExpectQuery(`FROM Users WHERE email = \?`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "email", "password_hash", "name", "created_at", "updated_at", "is_active", "email_verified"}).
+			AddRow(3, "user@example.com", "hash", "선점", time.Now(), time.Now(), true, false))
+
+	_, err = oidcService.Authenticate(context.Background(), "mock", req)
+	assert.ErrorIs(t, err, service.ErrOIDCAccountConflict)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// state/nonce 불일치 및 미인증 이메일 거부 테스트
+func TestOIDCAuthenticateValidation(t *testing.T) {
+	provider := newMockOIDCProvider(t)
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	oidcService := service.NewOIDCService(db, provider.config())
+
+	// state 불일치
+	req, nonce := provider.authorize(t, oidcService)
+	req.State = "forged-state"
+	_, err = oidcService.Authenticate(context.Background(), "mock", req)
+	assert.ErrorIs(t, err, service.ErrOIDCInvalidState)
+
+	// 다른 로그인 시도의 nonce
+	req, _ = provider.authorize(t, oidcService)
+	provider.claims = jwt.MapClaims{"nonce": nonce, "email": "user@example.com", "email_verified": true}
+	_, err = oidcService.Authenticate(context.Background(), "mock", req)
+	assert.ErrorIs(t, err, service.ErrOIDCAuthenticationFailed)
+
+	// 제공자가 이메일을 인증하지 않은 계정
+	req, nonce = provider.authorize(t, oidcService)
+	provider.claims = jwt.MapClaims{"nonce": nonce, "email": "user@example.com", "email_verified": false}
+	mock.ExpectQuery(`FROM UserIdentities`).WillReturnError(sql.ErrNoRows)
+	_, err = oidcService.Authenticate(context.Background(), "mock", req)
+	assert.ErrorIs(t, err, service.ErrOIDCEmailNotVerified)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
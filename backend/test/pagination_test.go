@@ -0,0 +1,185 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"backend/model"
+)
+
+// 커서 인코딩/디코딩 테스트
+func TestCursorEncodeDecode(t *testing.T) {
+	watchedAt := time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name   string
+		cursor model.Cursor
+	}{
+		{
+			name:   "콘텐츠 정렬 키 커서",
+			cursor: model.Cursor{Direction: model.CursorNext, Scope: "contents", ReleaseYear: 2019, Title: "Parasite", ID: 10},
+		},
+		{
+			name:   "시간 정렬 키 커서",
+			cursor: model.Cursor{Direction: model.CursorPrev, Scope: "contents", At: &watchedAt, ID: 3},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			encoded := model.EncodeCursor(tc.cursor)
+			assert.NotEmpty(t, encoded)
+
+			decoded, err := model.DecodeCursor(encoded, tc.cursor.Scope)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.cursor.Direction, decoded.Direction)
+			assert.Equal(t, tc.cursor.ReleaseYear, decoded.ReleaseYear)
+			assert.Equal(t, tc.cursor.Title, decoded.Title)
+			assert.Equal(t, tc.cursor.ID, decoded.ID)
+			if tc.cursor.At != nil {
+				assert.True(t, tc.cursor.At.Equal(*decoded.At))
+			}
+		})
+	}
+}
+
+// 잘못된 커서 디코딩 테스트
+func TestDecodeInvalidCursor(t *testing.T) {
+	// 빈 커서는 첫 페이지
+	cursor, err := model.DecodeCursor("", "contents")
+	assert.NoError(t, err)
+	assert.Nil(t, cursor)
+
+	// base64가 아닌 값
+	_, err = model.DecodeCursor("not a cursor!", "contents")
+	assert.ErrorIs(t, err, model.ErrInvalidCursor)
+
+	// 방향이 없는 커서
+	_, err = model.DecodeCursor(model.EncodeCursor(model.Cursor{Scope: "contents", ID: 1}), "contents")
+	assert.ErrorIs(t, err, model.ErrInvalidCursor)
+
+	// 다른 조회 범위에서 만든 커서
+	_, err = model.DecodeCursor(model.EncodeCursor(model.Cursor{Direction: model.CursorNext, Scope: "search", ID: 1}), "contents")
+	assert.ErrorIs(t, err, model.ErrInvalidCursor)
+}
+
+// contentCursorRows 커서 페이징 콘텐츠 조회 결과 컬럼
+func contentCursorRows() *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"id", "title", "thumbnail_url", "release_year"})
+}
+
+// expectCursorPageDetails 커서 페이지 조회 후 장르와 평가 조회 기대값 설정 (비로그인 시청자)
+func expectCursorPageDetails(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery(`SELECT\s+cg.content_id, g.name`).
+		WillReturnRows(sqlmock.NewRows([]string{"content_id", "name"}))
+	mock.ExpectQuery(`LEFT JOIN\s+ContentRatings r`).
+		WillReturnRows(contentRatingRows())
+}
+
+// 콘텐츠 키셋 페이징 테스트 (다음 페이지, 동일 정렬 키 구분, 이전 페이지, 목록 끝)
+func TestContentListKeyset(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	viewer := model.Viewer{Country: "KR"}
+
+	// 첫 페이지: size+1개를 읽어 다음 페이지 여부 판단, 이전 페이지 없음
+	mock.ExpectQuery(`FROM\s+Contents c\s+WHERE [\s\S]*ORDER BY\s+c.release_year DESC, c.title ASC, c.id ASC\s+LIMIT \?`).
+		WithArgs(int64(0), "KR", 3).
+		WillReturnRows(contentCursorRows().
+			AddRow(10, "Parasite", "/thumbnails/10.jpg", 2019).
+			AddRow(11, "Parasite", "/thumbnails/11.jpg", 2019).
+			AddRow(12, "Parasite", "/thumbnails/12.jpg", 2019))
+	expectCursorPageDetails(mock)
+
+	first, err := model.GetContentListByCursor(db, viewer, "", 2)
+	assert.NoError(t, err)
+	assert.Len(t, first.Content, 2)
+	assert.True(t, first.HasNext)
+	assert.False(t, first.HasPrev)
+	assert.Empty(t, first.PrevCursor)
+
+	// 다음 페이지: 개봉 연도와 제목이 같으면 ID로 구분
+	next, err := model.DecodeCursor(first.NextCursor, "contents")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(11), next.ID)
+
+	mock.ExpectQuery(`c.release_year < \? OR \(c.release_year = \? AND \(c.title > \? OR \(c.title = \? AND c.id > \?\)\)\)[\s\S]*ORDER BY\s+c.release_year DESC, c.title ASC, c.id ASC`).
+		WithArgs(int64(0), "KR", 2019, 2019, "Parasite", "Parasite", int64(11), 3).
+		WillReturnRows(contentCursorRows().
+			AddRow(12, "Parasite", "/thumbnails/12.jpg", 2019))
+	expectCursorPageDetails(mock)
+
+	last, err := model.GetContentListByCursor(db, viewer, first.NextCursor, 2)
+	assert.NoError(t, err)
+	contents := last.Content.([]model.ContentListResponse)
+	assert.Len(t, contents, 1)
+	assert.Equal(t, int64(12), contents[0].ID)
+
+	// 목록 끝: 다음 커서 없음, 이전 커서 있음
+	assert.False(t, last.HasNext)
+	assert.Empty(t, last.NextCursor)
+	assert.True(t, last.HasPrev)
+
+	// 이전 페이지: 역순으로 읽은 뒤 원래 순서로 되돌림
+	mock.ExpectQuery(`c.release_year > \? OR \(c.release_year = \? AND \(c.title < \? OR \(c.title = \? AND c.id < \?\)\)\)[\s\S]*ORDER BY\s+c.release_year ASC, c.title DESC, c.id DESC`).
+		WithArgs(int64(0), "KR", 2019, 2019, "Parasite", "Parasite", int64(12), 3).
+		WillReturnRows(contentCursorRows().
+			AddRow(11, "Parasite", "/thumbnails/11.jpg", 2019).
+			AddRow(10, "Parasite", "/thumbnails/10.jpg", 2019))
+	expectCursorPageDetails(mock)
+
+	prev, err := model.GetContentListByCursor(db, viewer, last.PrevCursor, 2)
+	assert.NoError(t, err)
+	contents = prev.Content.([]model.ContentListResponse)
+	assert.Equal(t, int64(10), contents[0].ID)
+	assert.Equal(t, int64(11), contents[1].ID)
+	assert.True(t, prev.HasNext)
+	assert.False(t, prev.HasPrev)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// 조회 조건과 맞지 않는 커서 거부 테스트 (다른 목록, 다른 장르, 시간 정렬 키 없음)
+func TestMismatchedCursorRejected(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	viewer := model.Viewer{ProfileID: 7, Country: "KR"}
+	watchedAt := time.Now()
+
+	// 찜 목록 커서로 전체 콘텐츠 목록 조회
+	wishlistCursor := model.EncodeCursor(model.Cursor{Direction: model.CursorNext, Scope: "wishlist", At: &watchedAt, ID: 3})
+	_, err = model.GetContentListByCursor(db, viewer, wishlistCursor, 10)
+	assert.ErrorIs(t, err, model.ErrInvalidCursor)
+
+	// 장르 1의 커서로 장르 2 조회
+	mock.ExpectQuery(`FROM\s+Contents c JOIN ContentGenres cg`).
+		WithArgs(int64(7), "KR", int64(1), 3).
+		WillReturnRows(contentCursorRows().
+			AddRow(10, "Parasite", "/thumbnails/10.jpg", 2019).
+			AddRow(11, "Memories of Murder", "/thumbnails/11.jpg", 2003).
+			AddRow(12, "Oldboy", "/thumbnails/12.jpg", 2003))
+	mock.ExpectQuery(`SELECT\s+cg.content_id, g.name`).
+		WillReturnRows(sqlmock.NewRows([]string{"content_id", "name"}))
+	mock.ExpectQuery(`SELECT\s+content_id\s+FROM\s+Wishlists`).
+		WillReturnRows(sqlmock.NewRows([]string{"content_id"}))
+	mock.ExpectQuery(`LEFT JOIN\s+ContentRatings r`).
+		WillReturnRows(contentRatingRows())
+
+	genrePage, err := model.GetContentsByGenreByCursor(db, 1, viewer, "", 2)
+	assert.NoError(t, err)
+	_, err = model.GetContentsByGenreByCursor(db, 2, viewer, genrePage.NextCursor, 2)
+	assert.ErrorIs(t, err, model.ErrInvalidCursor)
+
+	// 콘텐츠 목록 커서로 찜 목록 조회
+	_, err = model.GetWishlistByCursor(db, viewer, genrePage.NextCursor, 10)
+	assert.ErrorIs(t, err, model.ErrInvalidCursor)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
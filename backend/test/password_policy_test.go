@@ -0,0 +1,96 @@
+package test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"backend/config"
+	"backend/helper"
+	"backend/service"
+)
+
+// passwordPolicyCodes 정책 위반 코드 목록 (통과 시 nil)
+func passwordPolicyCodes(t *testing.T, err error) []string {
+	if err == nil {
+		return nil
+	}
+	var policyErr *service.PasswordPolicyError
+	if !assert.True(t, errors.As(err, &policyErr)) {
+		return nil
+	}
+	codes := make([]string, 0, len(policyErr.Fields))
+	for _, field := range policyErr.Fields {
+		assert.Equal(t, "password", field.Field)
+		codes = append(codes, field.Code)
+	}
+	return codes
+}
+
+// 길이, 문자 종류, 개인정보 포함 규칙 테스트
+func TestPasswordPolicyRules(t *testing.T) {
+	policy := &service.PasswordPolicy{Config: config.PasswordPolicyConfig{MinLength: 8, MaxLength: 72, MinCharClasses: 2}}
+
+	tests := []struct {
+		name     string
+		password string
+		expected []string
+	}{
+		{"정책 충족", "Movie-night42", nil},
+		{"짧은 비밀번호", "Ab1", []string{service.PasswordTooShort}},
+		{"한 종류 문자", "abcdefghij", []string{service.PasswordInsufficientClasses}},
+		{"bcrypt 한도 초과", strings.Repeat("가나다1", 8), []string{service.PasswordTooLong}},
+		{"이메일 아이디 포함", "Hong1234!", []string{service.PasswordContainsPersonalInfo}},
+		{"이름 포함 (대소문자 무시)", "gildong-2024", []string{service.PasswordContainsPersonalInfo}},
+		{"여러 위반", "hong", []string{service.PasswordTooShort, service.PasswordInsufficientClasses, service.PasswordContainsPersonalInfo}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := policy.Validate("password", tt.password, "hong@example.com", "Gil Dong")
+			assert.Equal(t, tt.expected, passwordPolicyCodes(t, err))
+		})
+	}
+
+	// 개인정보 허용 설정
+	policy.Config.AllowPersonalInfo = true
+	assert.NoError(t, policy.Validate("password", "Hong1234!", "hong@example.com", "Gil Dong"))
+}
+
+// 유출 비밀번호 목록 검사 테스트
+func TestPasswordPolicyBreached(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "breached.txt")
+	// SHA-1("password1"), SHA-1("P@ssw0rd")
+	content := "# 테스트 목록\n" +
+		"E38AD214943DAAD1D64C102FAEC29DE4AFE9DA3D:2413945\n" +
+		"21bd12dc183f740ee76f27b78eb39c8ad972a757\n"
+	assert.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+	source := &helper.FileBreachedPasswordSource{Path: path}
+	count, err := helper.PasswordBreachCount(source, "password1")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2413945), count)
+	count, err = helper.PasswordBreachCount(source, "P@ssw0rd")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+	count, err = helper.PasswordBreachCount(source, "Movie-night42")
+	assert.NoError(t, err)
+	assert.Zero(t, count)
+
+	policy := &service.PasswordPolicy{
+		Config:   config.PasswordPolicyConfig{MinLength: 8, MaxLength: 72, MinCharClasses: 2},
+		Breached: source,
+	}
+	assert.Equal(t, []string{service.PasswordBreached}, passwordPolicyCodes(t, policy.Validate("password", "P@ssw0rd", "user@example.com", "사용자")))
+	assert.NoError(t, policy.Validate("password", "Movie-night42", "user@example.com", "사용자"))
+
+	// 목록 파일이 없으면 검사 생략
+	missing := &helper.FileBreachedPasswordSource{Path: filepath.Join(t.TempDir(), "missing.txt")}
+	count, err = helper.PasswordBreachCount(missing, "password1")
+	assert.NoError(t, err)
+	assert.Zero(t, count)
+}
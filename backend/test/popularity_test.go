@@ -0,0 +1,105 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"backend/model"
+)
+
+// 인기도 시간 감쇠 계산 테스트
+func TestDecayPopularityScore(t *testing.T) {
+	now := time.Now()
+
+	// 반감기가 지나면 점수가 절반으로 감소
+	assert.InDelta(t, 5.0, model.DecayPopularityScore(10, now, now.Add(model.PopularityHalfLife)), 1e-9)
+	assert.InDelta(t, 2.5, model.DecayPopularityScore(10, now, now.Add(2*model.PopularityHalfLife)), 1e-9)
+
+	// 경과 시간이 없거나 음수이면 그대로 유지
+	assert.Equal(t, 10.0, model.DecayPopularityScore(10, now, now))
+	assert.Equal(t, 10.0, model.DecayPopularityScore(10, now, now.Add(-time.Hour)))
+}
+
+// 정렬 옵션 검증 테스트
+func TestIsValidContentSort(t *testing.T) {
+	assert.True(t, model.IsValidContentSort(""))
+	assert.True(t, model.IsValidContentSort(model.ContentSortLatest))
+	assert.True(t, model.IsValidContentSort(model.ContentSortPopularity))
+	assert.False(t, model.IsValidContentSort("rating"))
+}
+
+// 인기도 정렬 콘텐츠 목록 조회 테스트
+func TestContentListPopularitySort(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`SELECT COUNT\(\*\)\s+FROM Contents c`).
+		WithArgs(int64(0), "").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+	mock.ExpectQuery(`FROM\s+Contents c LEFT JOIN ContentPopularity p ON p.content_id = c.id\s+WHERE .*\s+ORDER BY\s+IFNULL\(p.score \* EXP\(.*\), 0\) DESC`).
+		WithArgs(int64(0), "", 10, 0).
+		WillReturnRows(contentListRows())
+	expectContentListDetails(mock, 0)
+
+	pageInfo, err := model.GetContentList(db, model.Viewer{}, 0, 10, model.ContentSortPopularity)
+	assert.NoError(t, err)
+	assert.Len(t, pageInfo.Content, 3)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// 인기 콘텐츠 조회 테스트
+func TestGetTrendingContents(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`JOIN\s+ContentPopularity p ON p.content_id = c.id\s+WHERE\s+p.score > 0 AND .*maturity_level.*\s+ORDER BY\s+IFNULL`).
+		WithArgs(int64(0), "US", 5).
+		WillReturnRows(contentListRows())
+	expectContentListDetails(mock, 0)
+
+	contents, err := model.GetTrendingContents(db, model.Viewer{Country: "US"}, 5)
+	assert.NoError(t, err)
+	assert.Len(t, contents, 3)
+	assert.Equal(t, int64(1), contents[0].ID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// 같은 프로필의 반복 이벤트는 인기도에 다시 반영하지 않는지 테스트
+func TestRecordProfilePopularityEvent(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	now := time.Now()
+
+	// 찜 추가는 프로필/콘텐츠별 처음 한 번만 반영
+	mock.ExpectExec(`INSERT IGNORE INTO PopularityEvents`).
+		WithArgs(int64(7), int64(3), "wishlist_add", now).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`INSERT INTO ContentPopularity`).
+		WithArgs(int64(3), model.PopularityWeightWishlistAdd, now, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	recorded, err := model.RecordProfilePopularityEvent(db, 7, 3, model.PopularityEventWishlistAdd, now)
+	assert.NoError(t, err)
+	assert.True(t, recorded)
+
+	mock.ExpectExec(`INSERT IGNORE INTO PopularityEvents`).WillReturnResult(sqlmock.NewResult(0, 0))
+	recorded, err = model.RecordProfilePopularityEvent(db, 7, 3, model.PopularityEventWishlistAdd, now)
+	assert.NoError(t, err)
+	assert.False(t, recorded)
+
+	// 재생 시작은 마지막 반영이 반복 간격보다 오래된 경우에만 다시 반영
+	mock.ExpectExec(`INSERT INTO PopularityEvents[\s\S]*ON DUPLICATE KEY UPDATE\s+recorded_at = IF\(recorded_at <= \?`).
+		WithArgs(int64(7), int64(3), "stream_start", now, now.Add(-model.PopularityRepeatWindow)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	recorded, err = model.RecordProfilePopularityEvent(db, 7, 3, model.PopularityEventStreamStart, now)
+	assert.NoError(t, err)
+	assert.False(t, recorded)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
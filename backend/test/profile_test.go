@@ -0,0 +1,195 @@
+package test
+
+import (
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"backend/config"
+	"backend/helper"
+	"backend/middleware"
+	"backend/model"
+	"backend/service"
+)
+
+// profileRows 프로필 조회 결과
+func profileRows(id, userID int64, isDefault bool) *sqlmock.Rows {
+	now := time.Now()
+	return sqlmock.NewRows([]string{"id", "user_id", "name", "avatar_url", "language", "maturity_level", "is_default", "is_kids", "created_at", "updated_at"}).
+		AddRow(id, userID, "프로필", "", "ko", 19, isDefault, false, now, now)
+}
+
+// 최대 프로필 수 초과 시 생성 거부 테스트
+func TestCreateProfileLimit(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`SELECT COUNT\(\*\) FROM Profiles WHERE user_id = \?`).
+		WithArgs(int64(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+
+	profileService := service.NewProfileService(db, 2)
+	_, err = profileService.CreateProfile(1, &model.ProfileRequest{Name: "아이"})
+	assert.ErrorIs(t, err, service.ErrProfileLimitExceeded)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// 프로필 생성 시 기본값 적용 테스트
+func TestCreateProfileDefaults(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`SELECT COUNT\(\*\) FROM Profiles`).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectExec(`INSERT INTO Profiles`).
+		WithArgs(int64(1), "아이", "", model.DefaultProfileLanguage, model.MaturityLevelAdult, false, false, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(7, 1))
+
+	profileService := service.NewProfileService(db, 5)
+	profile, err := profileService.CreateProfile(1, &model.ProfileRequest{Name: "아이"})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), profile.ID)
+	assert.False(t, profile.IsDefault)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// 기본 프로필 및 사용 중인 프로필 삭제 거부 테스트
+func TestDeleteProfileRestrictions(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	profileService := service.NewProfileService(db, 5)
+
+	mock.ExpectQuery(`FROM Profiles\s+WHERE id = \? AND user_id = \?`).
+		WithArgs(int64(1), int64(1)).
+		WillReturnRows(profileRows(1, 1, true))
+	assert.ErrorIs(t, profileService.DeleteProfile(1, 1, 2), service.ErrDefaultProfileDelete)
+
+	mock.ExpectQuery(`FROM Profiles\s+WHERE id = \? AND user_id = \?`).
+		WithArgs(int64(2), int64(1)).
+		WillReturnRows(profileRows(2, 1, false))
+	assert.ErrorIs(t, profileService.DeleteProfile(1, 2, 2), service.ErrCurrentProfileDelete)
+
+	// 다른 계정의 프로필
+	mock.ExpectQuery(`FROM Profiles\s+WHERE id = \? AND user_id = \?`).
+		WithArgs(int64(3), int64(1)).
+		WillReturnError(sql.ErrNoRows)
+	assert.ErrorIs(t, profileService.DeleteProfile(1, 3, 2), service.ErrProfileNotFound)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// 프로필 도입 이전 계정의 기본 프로필 생성 테스트
+func TestEnsureDefaultProfile(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`FROM Profiles\s+WHERE user_id = \? AND is_default = true`).
+		WithArgs(int64(1)).
+		WillReturnError(sql.ErrNoRows)
+	mock.ExpectExec(`INSERT INTO Profiles`).
+		WithArgs(int64(1), "홍길동", "", "ko", 19, true, false, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(3, 1))
+
+	profileService := service.NewProfileService(db, 5)
+	profile, err := profileService.EnsureDefaultProfile(&model.User{ID: 1, Name: "홍길동"})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), profile.ID)
+	assert.True(t, profile.IsDefault)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// 프로필 전용 토큰의 프로필 ID가 컨텍스트에 설정되는지 테스트
+func TestAuthMiddlewareSetsProfileID(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	t.Setenv("MOCK_DB", "true")
+	cfg := &config.Config{Environment: "test", JWTSecret: "test-secret", JWTExpireHours: 1}
+
+	r := gin.New()
+	r.GET("/me", middleware.AuthMiddleware(cfg), func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"user_id": c.GetInt64("userID"), "profile_id": c.GetInt64("profileID")})
+	})
+
+	token, err := helper.GenerateToken(1, 5, "테스트사용자", "test@example.com", cfg)
+	assert.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/me", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"user_id": 1, "profile_id": 5}`, w.Body.String())
+}
+
+// 현재 프로필이 삭제되었거나 다른 계정의 프로필이면 보호자 권한 작업 거부 테스트
+func TestAuthorizeFromMissingProfile(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`FROM Profiles\s+WHERE id = \? AND user_id = \?`).
+		WithArgs(int64(9), int64(1)).
+		WillReturnError(sql.ErrNoRows)
+
+	profileService := service.NewProfileService(db, 5)
+	err = profileService.AuthorizeFromKidsProfile(1, 9, "")
+	assert.ErrorIs(t, err, service.ErrProfileNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// 보호자 PIN 연속 실패 시 잠금 테스트 (잠금 중에는 올바른 PIN도 거부)
+func TestParentalPINLockout(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	pinHash, err := model.HashPassword("1234")
+	assert.NoError(t, err)
+
+	guardConfig := loginProtectionTestConfig()
+	guardConfig.ParentalPINMaxAttempts = 3
+	profileService := service.NewProfileService(db, 5)
+	profileService.Guard = service.NewLoginGuardService(db, helper.NewMemoryAttemptStore(), guardConfig)
+
+	expectPINHash := func() {
+		mock.ExpectQuery(`SELECT parental_pin_hash FROM Users`).
+			WithArgs(int64(1)).
+			WillReturnRows(sqlmock.NewRows([]string{"parental_pin_hash"}).AddRow(pinHash))
+	}
+
+	// 성공하면 실패 횟수 초기화
+	for _, pin := range []string{"0000", "1111", "1234"} {
+		expectPINHash()
+		profileService.VerifyParentalPIN(1, pin)
+	}
+
+	for i := 0; i < 2; i++ {
+		expectPINHash()
+		assert.ErrorIs(t, profileService.VerifyParentalPIN(1, "0000"), service.ErrInvalidParentalPIN)
+	}
+	expectPINHash()
+	var exceeded *service.AttemptsExceededError
+	assert.ErrorAs(t, profileService.VerifyParentalPIN(1, "0000"), &exceeded)
+	assert.Equal(t, 15*time.Minute, exceeded.RetryAfter)
+
+	expectPINHash()
+	assert.ErrorAs(t, profileService.VerifyParentalPIN(1, "1234"), &exceeded)
+
+	// 다른 계정에는 영향 없음
+	mock.ExpectQuery(`SELECT parental_pin_hash FROM Users`).
+		WithArgs(int64(2)).
+		WillReturnRows(sqlmock.NewRows([]string{"parental_pin_hash"}).AddRow(pinHash))
+	assert.NoError(t, profileService.VerifyParentalPIN(2, "1234"))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
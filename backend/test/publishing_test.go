@@ -0,0 +1,264 @@
+package test
+
+import (
+	"database/sql/driver"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"backend/helper"
+	"backend/model"
+	"backend/service"
+)
+
+// 공개 일정 검증 테스트
+func TestContentScheduleValidate(t *testing.T) {
+	publishAt := time.Now().Add(24 * time.Hour)
+	unpublishAt := publishAt.AddDate(1, 0, 0)
+
+	assert.NoError(t, (&model.ContentSchedule{}).Validate())
+	assert.NoError(t, (&model.ContentSchedule{PublishAt: &publishAt, UnpublishAt: &unpublishAt}).Validate())
+	assert.ErrorIs(t, (&model.ContentSchedule{PublishAt: &unpublishAt, UnpublishAt: &publishAt}).Validate(), model.ErrInvalidContentSchedule)
+}
+
+// 목록 조회 시 공개 기간이 아닌 콘텐츠 제외 테스트
+func TestContentListFiltersUnpublished(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`SELECT COUNT\(\*\)\s+FROM Contents c\s+WHERE \(c.publish_at IS NULL OR c.publish_at <= NOW\(\)\) AND \(c.unpublish_at IS NULL OR c.unpublish_at > NOW\(\)\)`).
+		WithArgs(int64(0), "KR").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+	mock.ExpectQuery(`SELECT\s+c.id, c.title, c.thumbnail_url, c.release_year\s+FROM\s+Contents c\s+WHERE \(c.publish_at IS NULL`).
+		WithArgs(int64(0), "KR", 10, 0).
+		WillReturnRows(contentListRows())
+	expectContentListDetails(mock, 0)
+
+	pageInfo, err := model.GetContentList(db, model.Viewer{Country: "KR"}, 0, 10, "")
+	assert.NoError(t, err)
+	assert.Len(t, pageInfo.Content, 3)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// 공개 예정 콘텐츠 조회 테스트
+func TestGetComingSoonContents(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	publishAt := time.Now().Add(72 * time.Hour)
+	mock.ExpectQuery(`WHERE\s+c.publish_at > NOW\(\).*ca.available_from <= c.publish_at.*ORDER BY\s+c.publish_at ASC`).
+		WithArgs(int64(5), "KR", 20).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "thumbnail_url", "release_year", "publish_at"}).
+			AddRow(1, "영화 1", "/thumbnails/1.jpg", 2026, publishAt).
+			AddRow(2, "영화 2", "/thumbnails/2.jpg", 2026, publishAt.Add(24*time.Hour)))
+	mock.ExpectQuery(`SELECT\s+cg.content_id, g.name`).
+		WillReturnRows(sqlmock.NewRows([]string{"content_id", "name"}).AddRow(1, "액션"))
+	mock.ExpectQuery(`SELECT\s+content_id\s+FROM\s+Wishlists`).
+		WillReturnRows(sqlmock.NewRows([]string{"content_id"}))
+	mock.ExpectQuery(`LEFT JOIN\s+ContentRatings r`).
+		WillReturnRows(contentRatingRows())
+	mock.ExpectQuery(`FROM\s+ContentReminders\s+WHERE\s+profile_id = \? AND notified_at IS NULL AND content_id IN \(\?, \?\)`).
+		WithArgs(int64(5), int64(1), int64(2)).
+		WillReturnRows(sqlmock.NewRows([]string{"content_id"}).AddRow(2))
+
+	contents, err := model.GetComingSoonContents(db, model.Viewer{ProfileID: 5, Country: "KR"}, 20)
+	assert.NoError(t, err)
+	if assert.Len(t, contents, 2) {
+		assert.Equal(t, "영화 1", contents[0].Title)
+		assert.Equal(t, []string{"액션"}, contents[0].Genres)
+		assert.True(t, publishAt.Equal(contents[0].PublishAt))
+		assert.False(t, contents[0].IsReminderSet)
+		assert.True(t, contents[1].IsReminderSet)
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// 공개 알림 신청 테스트
+func TestSetContentReminder(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	cfg := accountTestConfig(t)
+	reminderService := service.NewContentReminderService(db, helper.NewMailer(cfg.Mail), cfg)
+	viewer := model.Viewer{ProfileID: 10, Country: "KR"}
+	scheduleRows := func(publishAt interface{}) *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"publish_at", "unpublish_at"}).AddRow(publishAt, nil)
+	}
+	expectUpcoming := func(contentID int64, allowed, available bool) {
+		mock.ExpectQuery(`SELECT publish_at, unpublish_at FROM Contents WHERE id = \?`).
+			WithArgs(contentID).
+			WillReturnRows(scheduleRows(time.Now().Add(time.Hour)))
+		mock.ExpectQuery(`SELECT c.maturity_level <= [\s\S]*ca.available_from <= c.publish_at[\s\S]*FROM Contents c\s+WHERE c.id = \?`).
+			WithArgs(int64(10), "KR", contentID).
+			WillReturnRows(sqlmock.NewRows([]string{"allowed", "available"}).AddRow(allowed, available))
+	}
+
+	// 공개 예정 콘텐츠는 신청 가능
+	expectUpcoming(3, true, true)
+	mock.ExpectExec(`INSERT INTO ContentReminders \(profile_id, content_id\)\s+VALUES \(\?, \?\)\s+ON DUPLICATE KEY UPDATE notified_at = NULL, attempts = 0`).
+		WithArgs(int64(10), int64(3)).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	schedule, err := reminderService.SetReminder(viewer, 3)
+	assert.NoError(t, err)
+	assert.NotNil(t, schedule.PublishAt)
+
+	// 프로필 시청 등급을 넘는 콘텐츠는 신청 불가
+	expectUpcoming(5, false, true)
+	_, err = reminderService.SetReminder(viewer, 5)
+	assert.ErrorIs(t, err, service.ErrMaturityRestricted)
+
+	// 공개 시점에 시청자 국가에서 이용할 수 없는 콘텐츠는 신청 불가
+	expectUpcoming(6, true, false)
+	_, err = reminderService.SetReminder(viewer, 6)
+	assert.ErrorIs(t, err, service.ErrContentRegionRestricted)
+
+	// 이미 공개된 콘텐츠는 신청 불가
+	mock.ExpectQuery(`SELECT publish_at, unpublish_at FROM Contents WHERE id = \?`).
+		WithArgs(int64(4)).
+		WillReturnRows(scheduleRows(nil))
+	_, err = reminderService.SetReminder(viewer, 4)
+	assert.ErrorIs(t, err, service.ErrContentAlreadyPublic)
+
+	// 없는 콘텐츠
+	mock.ExpectQuery(`SELECT publish_at, unpublish_at FROM Contents WHERE id = \?`).
+		WithArgs(int64(99)).
+		WillReturnRows(sqlmock.NewRows([]string{"publish_at", "unpublish_at"}))
+	_, err = reminderService.SetReminder(viewer, 99)
+	assert.ErrorIs(t, err, service.ErrContentNotFound)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// dueReminderRows 발송할 공개 알림 조회 결과 컬럼
+func dueReminderRows() *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"id", "content_id", "title", "name", "language", "email", "attempts"})
+}
+
+// expectClaimReminders 발송할 공개 알림을 잠그고 가져가는 기대값 설정
+func expectClaimReminders(mock sqlmock.Sqlmock, rows *sqlmock.Rows, claimedIDs ...int64) {
+	mock.ExpectBegin()
+	mock.ExpectQuery(`FROM\s+ContentReminders r[\s\S]*WHERE\s+r.notified_at IS NULL AND \(c.publish_at IS NULL OR c.publish_at <= NOW\(\)\)`+
+		`[\s\S]*r.attempts < \? AND \(r.next_attempt_at IS NULL OR r.next_attempt_at <= \?\)\s+AND \(r.claimed_at IS NULL OR r.claimed_at <= \?\)`+
+		`[\s\S]*LIMIT \?\s+FOR UPDATE OF r SKIP LOCKED`).
+		WithArgs(5, sqlmock.AnyArg(), sqlmock.AnyArg(), 100).
+		WillReturnRows(rows)
+	if len(claimedIDs) > 0 {
+		args := []driver.Value{sqlmock.AnyArg()}
+		for _, id := range claimedIDs {
+			args = append(args, id)
+		}
+		mock.ExpectExec(`UPDATE ContentReminders SET claimed_at = \? WHERE id IN`).
+			WithArgs(args...).
+			WillReturnResult(sqlmock.NewResult(0, int64(len(claimedIDs))))
+		mock.ExpectCommit()
+	} else {
+		mock.ExpectRollback()
+	}
+}
+
+// 공개된 콘텐츠의 공개 알림 발송 테스트
+func TestSendDueReminders(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	cfg := accountTestConfig(t)
+	reminderService := service.NewContentReminderService(db, helper.NewMailer(cfg.Mail), cfg)
+
+	expectClaimReminders(mock, dueReminderRows().
+		AddRow(1, 3, "새 영화", "홍길동", "ko", "user@example.com", 0).
+		AddRow(2, 3, "새 영화", "Jane", "en", "jane@example.com", 1), 1, 2)
+	mock.ExpectExec(`UPDATE ContentReminders SET notified_at = NOW\(\), claimed_at = NULL WHERE id = \?`).
+		WithArgs(int64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE ContentReminders SET notified_at = NOW\(\), claimed_at = NULL WHERE id = \?`).
+		WithArgs(int64(2)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	sent, err := reminderService.SendDueReminders()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, sent)
+	assert.NoError(t, mock.ExpectationsWereMet())
+
+	mail, err := os.ReadFile(cfg.Mail.FilePath)
+	assert.NoError(t, err)
+	assert.Contains(t, string(mail), "To: user@example.com")
+	assert.Contains(t, string(mail), "「새 영화」")
+	assert.Contains(t, string(mail), "To: jane@example.com")
+	assert.Contains(t, string(mail), "\"새 영화\", which you asked us to remind you about")
+	assert.Contains(t, string(mail), "http://localhost:3000/contents/3")
+}
+
+// failingMailer 지정한 주소로 보내는 메일만 실패하는 테스트용 Mailer
+type failingMailer struct {
+	failTo string
+	sent   []string
+}
+
+// Send 메일 발송 (failTo 주소면 실패)
+func (m *failingMailer) Send(msg helper.MailMessage) error {
+	if msg.To == m.failTo {
+		return errors.New("메일 서버 응답 없음")
+	}
+	m.sent = append(m.sent, msg.To)
+	return nil
+}
+
+// 발송에 실패한 공개 알림은 대기 후 재시도하도록 기록하고 나머지 알림은 계속 발송하는지 테스트
+func TestSendDueRemindersRetriesFailures(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	cfg := accountTestConfig(t)
+	mailer := &failingMailer{failTo: "broken@example.com"}
+	reminderService := service.NewContentReminderService(db, mailer, cfg)
+
+	expectClaimReminders(mock, dueReminderRows().
+		AddRow(1, 3, "새 영화", "홍길동", "ko", "broken@example.com", 2).
+		AddRow(2, 3, "새 영화", "Jane", "en", "jane@example.com", 0), 1, 2)
+	before := time.Now()
+	var retryAt time.Time
+	mock.ExpectExec(`UPDATE ContentReminders SET attempts = attempts \+ 1, next_attempt_at = \?, claimed_at = NULL WHERE id = \?`).
+		WithArgs(timeCapture{&retryAt}, int64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE ContentReminders SET notified_at = NOW\(\), claimed_at = NULL WHERE id = \?`).
+		WithArgs(int64(2)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	sent, err := reminderService.SendDueReminders()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, sent)
+	assert.Equal(t, []string{"jane@example.com"}, mailer.sent)
+
+	// 세 번째 실패이므로 첫 대기 시간(5분)의 네 배 후 재시도
+	assert.WithinDuration(t, before.Add(20*time.Minute), retryAt, time.Minute)
+
+	// 다른 인스턴스가 모두 가져간 경우 발송할 알림 없음
+	expectClaimReminders(mock, dueReminderRows())
+	sent, err = reminderService.SendDueReminders()
+	assert.NoError(t, err)
+	assert.Equal(t, 0, sent)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// timeCapture 쿼리 인자로 전달된 시각을 저장하는 sqlmock 인자 검사기
+type timeCapture struct {
+	at *time.Time
+}
+
+// Match 인자가 time.Time이면 저장
+func (c timeCapture) Match(v driver.Value) bool {
+	at, ok := v.(time.Time)
+	if ok {
+		*c.at = at
+	}
+	return ok
+}
@@ -0,0 +1,72 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"backend/model"
+	"backend/service"
+)
+
+// 등급 체계별 표시 등급 변환 테스트
+func TestParseRating(t *testing.T) {
+	level, err := model.ParseRating(model.RatingSystemMPAA, "PG-13")
+	assert.NoError(t, err)
+	assert.Equal(t, model.MaturityLevel15, level)
+
+	level, err = model.ParseRating("kmrb", "청불")
+	assert.NoError(t, err)
+	assert.Equal(t, model.MaturityLevelAdult, level)
+
+	_, err = model.ParseRating(model.RatingSystemMPAA, "X")
+	assert.ErrorIs(t, err, model.ErrUnknownRating)
+	_, err = model.ParseRating("BBFC", "PG")
+	assert.ErrorIs(t, err, model.ErrUnknownRating)
+
+	assert.Equal(t, map[string]string{model.RatingSystemKMRB: "19", model.RatingSystemMPAA: "R"},
+		model.RatingLabels(model.MaturityLevelAdult))
+}
+
+// 시청 등급 초과 콘텐츠의 보호자 PIN 확인 테스트
+func TestAuthorizeMaturityLevel(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	profileService := service.NewProfileService(db, 5)
+	pinHash, err := model.HashPassword("1234")
+	assert.NoError(t, err)
+
+	now := time.Now()
+	kidsProfile := func() *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"id", "user_id", "name", "avatar_url", "language", "maturity_level", "is_default", "is_kids", "created_at", "updated_at"}).
+			AddRow(2, 1, "아이", "", "ko", 12, false, true, now, now)
+	}
+
+	// 프로필 등급 이하 콘텐츠는 PIN 없이 허용
+	mock.ExpectQuery(`FROM Profiles\s+WHERE id = \? AND user_id = \?`).WillReturnRows(kidsProfile())
+	assert.NoError(t, profileService.AuthorizeMaturityLevel(1, 2, model.MaturityLevel12, ""))
+
+	// PIN 미설정 계정은 제한
+	mock.ExpectQuery(`FROM Profiles\s+WHERE id = \? AND user_id = \?`).WillReturnRows(kidsProfile())
+	mock.ExpectQuery(`SELECT parental_pin_hash FROM Users`).
+		WillReturnRows(sqlmock.NewRows([]string{"parental_pin_hash"}).AddRow(nil))
+	assert.ErrorIs(t, profileService.AuthorizeMaturityLevel(1, 2, model.MaturityLevelAdult, ""), service.ErrMaturityRestricted)
+
+	// 잘못된 PIN
+	mock.ExpectQuery(`FROM Profiles\s+WHERE id = \? AND user_id = \?`).WillReturnRows(kidsProfile())
+	mock.ExpectQuery(`SELECT parental_pin_hash FROM Users`).
+		WillReturnRows(sqlmock.NewRows([]string{"parental_pin_hash"}).AddRow(pinHash))
+	assert.ErrorIs(t, profileService.AuthorizeMaturityLevel(1, 2, model.MaturityLevelAdult, "0000"), service.ErrInvalidParentalPIN)
+
+	// 올바른 PIN이면 허용
+	mock.ExpectQuery(`FROM Profiles\s+WHERE id = \? AND user_id = \?`).WillReturnRows(kidsProfile())
+	mock.ExpectQuery(`SELECT parental_pin_hash FROM Users`).
+		WillReturnRows(sqlmock.NewRows([]string{"parental_pin_hash"}).AddRow(pinHash))
+	assert.NoError(t, profileService.AuthorizeMaturityLevel(1, 2, model.MaturityLevelAdult, "1234"))
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,79 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"backend/model"
+	"backend/service"
+)
+
+// similaritiesOf 특정 콘텐츠의 유사 콘텐츠 목록만 추출
+func similaritiesOf(similarities []model.ContentSimilarity, contentID int64) []model.ContentSimilarity {
+	result := []model.ContentSimilarity{}
+	for _, similarity := range similarities {
+		if similarity.ContentID == contentID {
+			result = append(result, similarity)
+		}
+	}
+	return result
+}
+
+// 동시 발생 기반 유사도 계산 테스트
+func TestComputeSimilaritiesCoOccurrence(t *testing.T) {
+	// 사용자별 시청/찜 콘텐츠
+	interactions := map[int64][]int64{
+		1: {1, 2, 3},
+		2: {1, 2},
+		3: {1, 2, 2}, // 중복 상호작용은 한 번으로 집계
+		4: {3},
+	}
+	contentGenres := map[int64][]int64{
+		1: {1}, 2: {1}, 3: {2}, 4: {2},
+	}
+
+	similarities := service.ComputeSimilarities(interactions, contentGenres, 2)
+
+	// 콘텐츠 1은 콘텐츠 2와 가장 유사 (3명 모두 함께 시청)
+	neighbours := similaritiesOf(similarities, 1)
+	assert.Len(t, neighbours, 2)
+	assert.Equal(t, int64(2), neighbours[0].SimilarContentID)
+	assert.Equal(t, model.SimilaritySourceCoOccurrence, neighbours[0].Source)
+	assert.InDelta(t, 1.0, neighbours[0].Score, 1e-9)
+	assert.Equal(t, int64(3), neighbours[1].SimilarContentID)
+	assert.Greater(t, neighbours[0].Score, neighbours[1].Score)
+}
+
+// 콜드 스타트 콘텐츠의 장르 기반 보충 테스트
+func TestComputeSimilaritiesGenreFallback(t *testing.T) {
+	interactions := map[int64][]int64{
+		1: {1, 2},
+	}
+	contentGenres := map[int64][]int64{
+		1: {1},
+		2: {1},
+		3: {1, 2}, // 상호작용 없는 신규 콘텐츠
+		4: {2},
+		5: {3},
+	}
+
+	similarities := service.ComputeSimilarities(interactions, contentGenres, 3)
+
+	// 신규 콘텐츠 3은 장르가 겹치는 콘텐츠로만 채워짐
+	neighbours := similaritiesOf(similarities, 3)
+	assert.Len(t, neighbours, 3)
+	for _, neighbour := range neighbours {
+		assert.Equal(t, model.SimilaritySourceGenre, neighbour.Source)
+		assert.NotEqual(t, int64(5), neighbour.SimilarContentID) // 장르가 겹치지 않는 콘텐츠 제외
+	}
+
+	// 동시 발생 유사도가 장르 유사도보다 먼저 위치
+	neighbours = similaritiesOf(similarities, 1)
+	assert.Equal(t, int64(2), neighbours[0].SimilarContentID)
+	assert.Equal(t, model.SimilaritySourceCoOccurrence, neighbours[0].Source)
+	for _, neighbour := range neighbours[1:] {
+		assert.Equal(t, model.SimilaritySourceGenre, neighbour.Source)
+		assert.NotEqual(t, int64(2), neighbour.SimilarContentID)
+	}
+}
@@ -0,0 +1,141 @@
+package test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+
+	"backend/config"
+	"backend/helper"
+	"backend/model"
+	"backend/service"
+)
+
+// refreshTokenRows 리프레시 토큰 조회 결과
+func refreshTokenRows(id int64, revokedAt interface{}) *sqlmock.Rows {
+	now := time.Now()
+	return sqlmock.NewRows([]string{"id", "user_id", "profile_id", "family_id", "token_hash", "access_jti",
+		"access_expires_at", "expires_at", "revoked_at", "created_at"}).
+		AddRow(id, 1, 2, "family-1", "hash", "jti-1", now.Add(time.Minute), now.Add(time.Hour), revokedAt, now)
+}
+
+// 토큰 서비스 테스트용 설정
+func authTestConfig() *config.Config {
+	return &config.Config{JWTSecret: "test-secret", AccessTokenExpireMinutes: 15, RefreshTokenExpireDays: 14}
+}
+
+// 리프레시 토큰 교체 테스트
+func TestRefreshTokensRotate(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`FROM RefreshTokens WHERE token_hash = \?`).
+		WithArgs(helper.HashToken("old-token")).
+		WillReturnRows(refreshTokenRows(10, nil))
+	mock.ExpectQuery(`FROM Users WHERE id = \?`).
+		WithArgs(int64(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "email", "password_hash", "name", "created_at", "updated_at", "is_active", "email_verified"}).
+			AddRow(1, "test@example.com", "hash", "테스트사용자", time.Now(), time.Now(), true, true))
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE RefreshTokens SET revoked_at = \? WHERE id = \? AND revoked_at IS NULL`).
+		WithArgs(sqlmock.AnyArg(), int64(10)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`INSERT INTO RefreshTokens`).
+		WithArgs(int64(1), int64(2), "family-1", sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(11, 1))
+	mock.ExpectCommit()
+	mock.ExpectExec(`UPDATE UserSessions SET user_agent = \?, ip_address = \?, last_seen_at = \? WHERE family_id = \?`).
+		WithArgs("MiniFlix/1.0", "10.0.0.1", sqlmock.AnyArg(), "family-1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	cfg := authTestConfig()
+	tokens, err := service.NewAuthService(db, cfg).RefreshTokens("old-token",
+		model.SessionClient{UserAgent: "MiniFlix/1.0", IPAddress: "10.0.0.1"})
+	assert.NoError(t, err)
+	assert.NotEqual(t, "old-token", tokens.RefreshToken)
+	assert.Equal(t, int64(15*60), tokens.ExpiresIn)
+
+	// 새 액세스 토큰은 세션의 프로필 기준
+	claims, err := helper.ValidateToken(tokens.Token, cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), claims.ProfileID)
+	assert.NotEmpty(t, claims.ID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// 이미 교체된 리프레시 토큰 재사용 시 세션 전체 폐기 테스트
+func TestRefreshTokensReuseRevokesFamily(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`FROM RefreshTokens WHERE token_hash = \?`).
+		WillReturnRows(refreshTokenRows(10, time.Now().Add(-time.Minute)))
+	mock.ExpectBegin()
+	mock.ExpectExec(`INSERT IGNORE INTO RevokedTokens \(jti, expires_at\)\s+SELECT access_jti, access_expires_at FROM RefreshTokens\s+WHERE family_id = \?`).
+		WithArgs("family-1", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(`UPDATE RefreshTokens SET revoked_at = \? WHERE family_id = \? AND revoked_at IS NULL`).
+		WithArgs(sqlmock.AnyArg(), "family-1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE UserSessions SET revoked_at = \? WHERE family_id = \?`).
+		WithArgs(sqlmock.AnyArg(), "family-1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	_, err = service.NewAuthService(db, authTestConfig()).RefreshTokens("old-token", model.SessionClient{})
+	assert.ErrorIs(t, err, service.ErrRefreshTokenReused)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// 알 수 없는 리프레시 토큰 거부 테스트
+func TestRefreshTokensUnknown(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`FROM RefreshTokens WHERE token_hash = \?`).WillReturnError(sql.ErrNoRows)
+
+	_, err = service.NewAuthService(db, authTestConfig()).RefreshTokens("unknown", model.SessionClient{})
+	assert.ErrorIs(t, err, service.ErrInvalidRefreshToken)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// 비밀번호 변경 시 모든 세션 폐기 테스트
+func TestUpdateUserInfoPasswordChangeRevokesSessions(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	hash, err := model.HashPassword("password123")
+	assert.NoError(t, err)
+
+	mock.ExpectQuery(`FROM Users WHERE id = \?`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "email", "password_hash", "name", "created_at", "updated_at", "is_active", "email_verified"}).
+			AddRow(1, "test@example.com", hash, "테스트사용자", time.Now(), time.Now(), true, true))
+	mock.ExpectExec(`UPDATE Users SET name = \?, password_hash = \?`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectBegin()
+	mock.ExpectExec(`INSERT IGNORE INTO RevokedTokens .*\s+WHERE user_id = \?`).
+		WithArgs(int64(1), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectExec(`UPDATE RefreshTokens SET revoked_at = \? WHERE user_id = \?`).
+		WithArgs(sqlmock.AnyArg(), int64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectExec(`UPDATE UserSessions SET revoked_at = \? WHERE user_id = \?`).
+		WithArgs(sqlmock.AnyArg(), int64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	userService := service.NewUserService(sqlx.NewDb(db, "mysql"))
+	err = userService.UpdateUserInfo(1, &model.UpdateProfileRequest{
+		Name: "테스트사용자", CurrentPassword: "password123", NewPassword: "newpassword",
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
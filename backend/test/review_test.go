@@ -0,0 +1,356 @@
+package test
+
+import (
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"backend/config"
+	"backend/helper"
+	"backend/model"
+	"backend/service"
+)
+
+// reviewTestConfig 리뷰 필터 테스트용 설정 (금칙어 파일 사용)
+func reviewTestConfig(t *testing.T) *config.Config {
+	path := filepath.Join(t.TempDir(), "banned_words.txt")
+	assert.NoError(t, os.WriteFile(path, []byte("# 테스트 금칙어\n시발\nfuck\n!시발점\n"), 0o600))
+	return &config.Config{Review: config.ReviewConfig{
+		BannedWordsFile:     path,
+		BannedWords:         []string{"멍청이"},
+		SpamWords:           []string{"무료쿠폰", "bit.ly"},
+		MaxLinks:            0,
+		ReportHideThreshold: 3,
+	}}
+}
+
+// reviewRows 리뷰 조회 결과 컬럼
+func reviewRows() *sqlmock.Rows {
+	return sqlmock.NewRows([]string{
+		"id", "content_id", "author_name", "body", "status",
+		"helpful_count", "not_helpful_count", "helpful", "is_mine", "created_at", "updated_at",
+	})
+}
+
+// expectReviewPrecheck 리뷰 작성 전 작성 금지 여부와 콘텐츠 공개 여부 조회 기대값 설정
+func expectReviewPrecheck(mock sqlmock.Sqlmock, userID, contentID int64) {
+	mock.ExpectQuery(`SELECT review_banned_at IS NOT NULL FROM Users WHERE id = \?`).
+		WithArgs(userID).
+		WillReturnRows(sqlmock.NewRows([]string{"banned"}).AddRow(false))
+	mock.ExpectQuery(`FROM Contents c\s+WHERE c.id = \?`).
+		WithArgs(contentID).
+		WillReturnRows(sqlmock.NewRows([]string{"published"}).AddRow(true))
+}
+
+// 금칙어 필터 테스트 (한국어 우회 표기, 예외 단어, 대소문자)
+func TestWordFilter(t *testing.T) {
+	filter := helper.NewWordFilter([]string{"시발", "병신", "fuck", "!시발점"})
+
+	testCases := []struct {
+		text    string
+		matched bool
+	}{
+		{"진짜 시발 재미없네", true},
+		{"시1발 이게 뭐야", true},
+		{"시.발", true},
+		{"F.u.C.k this", true},
+		{"역사의 시발점이 된 작품", false}, // 예외 단어
+		{"다시 발견한 명작", false},     // 단어 사이에 걸친 경우
+		{"시발점은 좋았지만 결말은 시발", true},
+		{"배우들의 연기가 훌륭합니다", false},
+	}
+	for _, tc := range testCases {
+		_, matched := filter.Match(tc.text)
+		assert.Equal(t, tc.matched, matched, tc.text)
+	}
+}
+
+// 리뷰 작성 필터 테스트 (금칙어 거부, 스팸/링크 검토 대기, 정상 공개)
+func TestCreateReviewFiltering(t *testing.T) {
+	cfg := reviewTestConfig(t)
+
+	t.Run("금칙어 포함", func(t *testing.T) {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+		assert.NoError(t, err)
+		defer db.Close()
+
+		expectReviewPrecheck(mock, 7, 3)
+
+		_, err = service.NewReviewService(db, cfg).CreateReview(7, 1, 3, &model.ReviewRequest{Body: "배우가 멍.청.이 같아요"})
+		assert.ErrorIs(t, err, service.ErrReviewProfanity)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	testCases := []struct {
+		name       string
+		body       string
+		status     string
+		flagReason string
+	}{
+		{"정상 리뷰", "역사의 시발점이 된 작품이네요", model.ReviewStatusPublished, ""},
+		{"스팸 의심 단어", "무료-쿠폰 받아가세요", model.ReviewStatusPending, "스팸 의심 단어"},
+		{"링크 포함", "자세한 후기는 https://example.com 에서", model.ReviewStatusPending, "링크 포함"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+			assert.NoError(t, err)
+			defer db.Close()
+
+			expectReviewPrecheck(mock, 7, 3)
+			mock.ExpectExec(`INSERT IGNORE INTO Reviews`).
+				WithArgs(int64(3), int64(7), int64(1), tc.body, tc.status, tc.flagReason, sqlmock.AnyArg(), sqlmock.AnyArg()).
+				WillReturnResult(sqlmock.NewResult(10, 1))
+			mock.ExpectQuery(`FROM\s+Reviews r.*WHERE r.user_id = \? AND r.content_id = \?`).
+				WithArgs(int64(7), int64(7), int64(7), int64(3)).
+				WillReturnRows(reviewRows().AddRow(10, 3, "민수", tc.body, tc.status, 0, 0, nil, true, time.Now(), time.Now()))
+
+			review, err := service.NewReviewService(db, cfg).CreateReview(7, 1, 3, &model.ReviewRequest{Body: tc.body})
+			assert.NoError(t, err)
+			assert.Equal(t, tc.status, review.Status)
+			assert.True(t, review.IsMine)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+// 콘텐츠별 리뷰 중복 작성 테스트
+func TestCreateReviewDuplicate(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	expectReviewPrecheck(mock, 7, 3)
+	mock.ExpectExec(`INSERT IGNORE INTO Reviews`).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	_, err = service.NewReviewService(db, reviewTestConfig(t)).CreateReview(7, 1, 3, &model.ReviewRequest{Body: "두 번째 리뷰"})
+	assert.ErrorIs(t, err, model.ErrReviewExists)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// 리뷰 목록 정렬 테스트
+func TestGetContentReviewsSort(t *testing.T) {
+	testCases := []struct {
+		sort    string
+		orderBy string
+	}{
+		{"", `ORDER BY\s+r.created_at DESC`},
+		{model.ReviewSortHelpful, `ORDER BY\s+\(r.helpful_count - r.not_helpful_count\) DESC`},
+		{model.ReviewSortVotes, `ORDER BY\s+\(r.helpful_count \+ r.not_helpful_count\) DESC`},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.sort, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+			assert.NoError(t, err)
+			defer db.Close()
+
+			mock.ExpectQuery(`SELECT COUNT\(\*\) FROM Reviews r WHERE r.content_id = \? AND r.status = \?`).
+				WithArgs(int64(3), model.ReviewStatusPublished).
+				WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(12))
+			mock.ExpectQuery(`WHERE\s+r.content_id = \? AND r.status = \?\s+`+tc.orderBy).
+				WithArgs(int64(7), int64(7), int64(3), model.ReviewStatusPublished, 10, 10).
+				WillReturnRows(reviewRows().
+					AddRow(1, 3, "민수", "좋아요", model.ReviewStatusPublished, 5, 1, true, false, time.Now(), time.Now()).
+					AddRow(2, 3, "지영", "별로예요", model.ReviewStatusPublished, 0, 2, nil, true, time.Now(), time.Now()))
+
+			pageInfo, err := model.GetContentReviews(db, 3, 7, 1, 10, tc.sort)
+			assert.NoError(t, err)
+			assert.Equal(t, 2, pageInfo.TotalPages)
+			assert.True(t, pageInfo.Last)
+			reviews := pageInfo.Content.([]model.Review)
+			assert.Equal(t, model.ReviewVoteHelpful, reviews[0].MyVote)
+			assert.Empty(t, reviews[1].MyVote)
+			assert.True(t, reviews[1].IsMine)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+// 리뷰 추천 시 추천 수가 기존 추천과의 차이만큼 갱신되는지 테스트
+func TestSetReviewVote(t *testing.T) {
+	t.Run("추천 변경", func(t *testing.T) {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+		assert.NoError(t, err)
+		defer db.Close()
+
+		// 도움이 안 됐어요 → 도움이 됐어요
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT user_id, status, helpful_count, not_helpful_count\s+FROM Reviews\s+WHERE id = \?\s+FOR UPDATE`).
+			WithArgs(int64(10)).
+			WillReturnRows(sqlmock.NewRows([]string{"user_id", "status", "helpful_count", "not_helpful_count"}).
+				AddRow(3, model.ReviewStatusPublished, 4, 2))
+		mock.ExpectQuery(`SELECT helpful FROM ReviewVotes WHERE review_id = \? AND user_id = \? FOR UPDATE`).
+			WithArgs(int64(10), int64(7)).
+			WillReturnRows(sqlmock.NewRows([]string{"helpful"}).AddRow(false))
+		mock.ExpectExec(`INSERT INTO ReviewVotes .* ON DUPLICATE KEY UPDATE`).
+			WithArgs(int64(10), int64(7), true, sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec(`UPDATE Reviews\s+SET helpful_count = helpful_count \+ \?, not_helpful_count = not_helpful_count \+ \?`).
+			WithArgs(1, -1, int64(10)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		response, err := model.SetReviewVote(db, 7, 10, true)
+		assert.NoError(t, err)
+		assert.Equal(t, 5, response.HelpfulCount)
+		assert.Equal(t, 1, response.NotHelpfulCount)
+		assert.Equal(t, model.ReviewVoteHelpful, response.MyVote)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("본인 리뷰", func(t *testing.T) {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`FROM Reviews\s+WHERE id = \?\s+FOR UPDATE`).
+			WillReturnRows(sqlmock.NewRows([]string{"user_id", "status", "helpful_count", "not_helpful_count"}).
+				AddRow(7, model.ReviewStatusPublished, 0, 0))
+		mock.ExpectRollback()
+
+		_, err = model.SetReviewVote(db, 7, 10, true)
+		assert.ErrorIs(t, err, model.ErrOwnReview)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+// 신고 누적 시 검토 대기 전환 테스트
+func TestReportReviewThreshold(t *testing.T) {
+	testCases := []struct {
+		name        string
+		openReports int
+		hidden      bool
+	}{
+		{"기준 미만", 2, false},
+		{"기준 도달", 3, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+			assert.NoError(t, err)
+			defer db.Close()
+
+			mock.ExpectBegin()
+			mock.ExpectQuery(`FROM Reviews\s+WHERE id = \?\s+FOR UPDATE`).
+				WithArgs(int64(10)).
+				WillReturnRows(sqlmock.NewRows([]string{"user_id", "status", "helpful_count", "not_helpful_count"}).
+					AddRow(3, model.ReviewStatusPublished, 0, 0))
+			mock.ExpectExec(`INSERT IGNORE INTO ReviewReports`).
+				WithArgs(int64(10), int64(7), "spoiler", "", sqlmock.AnyArg()).
+				WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectQuery(`SELECT COUNT\(\*\) FROM ReviewReports WHERE review_id = \? AND resolved_at IS NULL`).
+				WithArgs(int64(10)).
+				WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(tc.openReports))
+			if tc.hidden {
+				mock.ExpectExec(`UPDATE Reviews SET status = \?, flag_reason = \? WHERE id = \?`).
+					WithArgs(model.ReviewStatusPending, "신고 누적", int64(10)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			}
+			mock.ExpectCommit()
+
+			hidden, err := model.ReportReview(db, 7, 10, &model.ReviewReportRequest{Reason: "spoiler"}, 3)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.hidden, hidden)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+
+	t.Run("중복 신고", func(t *testing.T) {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`FROM Reviews\s+WHERE id = \?\s+FOR UPDATE`).
+			WillReturnRows(sqlmock.NewRows([]string{"user_id", "status", "helpful_count", "not_helpful_count"}).
+				AddRow(3, model.ReviewStatusPublished, 0, 0))
+		mock.ExpectExec(`INSERT IGNORE INTO ReviewReports`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		_, err = model.ReportReview(db, 7, 10, &model.ReviewReportRequest{Reason: "spam"}, 3)
+		assert.ErrorIs(t, err, model.ErrReviewAlreadyReported)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+// 리뷰 검토 처리 테스트 (숨김, 작성 금지)
+func TestModerateReview(t *testing.T) {
+	t.Run("숨김", func(t *testing.T) {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT user_id FROM Reviews WHERE id = \? FOR UPDATE`).
+			WithArgs(int64(10)).
+			WillReturnRows(sqlmock.NewRows([]string{"user_id"}).AddRow(3))
+		mock.ExpectExec(`UPDATE Reviews SET status = \? WHERE id = \?`).
+			WithArgs(model.ReviewStatusHidden, int64(10)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`UPDATE ReviewReports SET resolved_at = \?, resolution = \? WHERE review_id = \?`).
+			WithArgs(sqlmock.AnyArg(), model.ReviewActionHide, int64(10)).
+			WillReturnResult(sqlmock.NewResult(0, 3))
+		mock.ExpectCommit()
+
+		assert.NoError(t, service.NewReviewService(db, reviewTestConfig(t)).Moderate(10, model.ReviewActionHide))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("작성 금지", func(t *testing.T) {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT user_id FROM Reviews WHERE id = \? FOR UPDATE`).
+			WillReturnRows(sqlmock.NewRows([]string{"user_id"}).AddRow(3))
+		mock.ExpectExec(`UPDATE Users SET review_banned_at = \? WHERE id = \?`).
+			WithArgs(sqlmock.AnyArg(), int64(3)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`UPDATE Reviews SET status = \? WHERE user_id = \?`).
+			WithArgs(model.ReviewStatusHidden, int64(3)).
+			WillReturnResult(sqlmock.NewResult(0, 4))
+		mock.ExpectExec(`UPDATE ReviewReports rr JOIN Reviews r`).
+			WithArgs(sqlmock.AnyArg(), model.ReviewActionBan, int64(3)).
+			WillReturnResult(sqlmock.NewResult(0, 5))
+		mock.ExpectCommit()
+
+		assert.NoError(t, service.NewReviewService(db, reviewTestConfig(t)).Moderate(10, model.ReviewActionBan))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("리뷰 없음", func(t *testing.T) {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT user_id FROM Reviews`).WillReturnError(sql.ErrNoRows)
+		mock.ExpectRollback()
+
+		err = service.NewReviewService(db, reviewTestConfig(t)).Moderate(99, model.ReviewActionApprove)
+		assert.ErrorIs(t, err, service.ErrReviewNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+// 리뷰 작성 금지 계정 테스트
+func TestCreateReviewBanned(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`SELECT review_banned_at IS NOT NULL FROM Users`).
+		WithArgs(int64(7)).
+		WillReturnRows(sqlmock.NewRows([]string{"banned"}).AddRow(true))
+
+	_, err = service.NewReviewService(db, reviewTestConfig(t)).CreateReview(7, 1, 3, &model.ReviewRequest{Body: "좋은 작품"})
+	assert.ErrorIs(t, err, service.ErrReviewBanned)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,91 @@
+package test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"backend/model"
+	"backend/service"
+)
+
+// 로그인 세션 목록 조회 테스트
+func TestListSessions(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	now := time.Now()
+	mock.ExpectQuery(`FROM\s+UserSessions s\s+WHERE\s+s.user_id = \? AND s.revoked_at IS NULL`).
+		WithArgs("jti-1", int64(1), sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "family_id", "device_name", "user_agent", "ip_address", "created_at", "last_seen_at", "current"}).
+			AddRow(2, 1, "family-2", "거실 TV", "SmartTV/1.0", "10.0.0.2", now, now, false).
+			AddRow(1, 1, "family-1", "", "Mozilla/5.0", "10.0.0.1", now, now.Add(-time.Hour), true))
+
+	sessions, err := service.NewAuthService(db, authTestConfig()).ListSessions(1, "jti-1")
+	assert.NoError(t, err)
+	assert.Len(t, sessions, 2)
+	assert.Equal(t, "거실 TV", sessions[0].DeviceName)
+	assert.False(t, sessions[0].Current)
+	assert.True(t, sessions[1].Current)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// 로그인 세션 종료 테스트
+func TestRevokeSession(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	authService := service.NewAuthService(db, authTestConfig())
+
+	// 다른 계정의 세션
+	mock.ExpectQuery(`FROM UserSessions\s+WHERE id = \? AND user_id = \? AND revoked_at IS NULL`).
+		WithArgs(int64(9), int64(1)).
+		WillReturnError(sql.ErrNoRows)
+	assert.ErrorIs(t, authService.RevokeSession(1, 9), service.ErrSessionNotFound)
+
+	now := time.Now()
+	mock.ExpectQuery(`FROM UserSessions\s+WHERE id = \? AND user_id = \? AND revoked_at IS NULL`).
+		WithArgs(int64(2), int64(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "family_id", "device_name", "user_agent", "ip_address", "created_at", "last_seen_at"}).
+			AddRow(2, 1, "family-2", "거실 TV", "SmartTV/1.0", "10.0.0.2", now, now))
+	mock.ExpectBegin()
+	mock.ExpectExec(`INSERT IGNORE INTO RevokedTokens .*\s+WHERE family_id = \?`).
+		WithArgs("family-2", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE RefreshTokens SET revoked_at = \? WHERE family_id = \?`).
+		WithArgs(sqlmock.AnyArg(), "family-2").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE UserSessions SET revoked_at = \? WHERE family_id = \?`).
+		WithArgs(sqlmock.AnyArg(), "family-2").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	assert.NoError(t, authService.RevokeSession(1, 2))
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// 로그인 시 세션 기기 정보 저장 테스트
+func TestIssueTokensCreatesSession(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectExec(`INSERT INTO UserSessions`).
+		WithArgs(int64(1), sqlmock.AnyArg(), "거실 TV", "SmartTV/1.0", "10.0.0.2", sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(`INSERT INTO RefreshTokens`).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	user := &model.User{ID: 1, Name: "테스트사용자", Email: "test@example.com"}
+	tokens, err := service.NewAuthService(db, authTestConfig()).IssueTokens(user, 3,
+		model.SessionClient{DeviceName: "거실 TV", UserAgent: "SmartTV/1.0", IPAddress: "10.0.0.2"})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, tokens.Token)
+	assert.NotEmpty(t, tokens.RefreshToken)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,153 @@
+package test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"backend/service"
+)
+
+// subscriptionRows 구독 조회 결과 행 생성 (스탠다드 요금제)
+func subscriptionRows(startsAt, endsAt time.Time) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"id", "user_id", "starts_at", "ends_at", "cancelled_at", "created_at",
+		"plan_id", "code", "name", "max_resolution", "max_concurrent_streams", "download_limit", "monthly_price"}).
+		AddRow(1, 1, startsAt, endsAt, nil, startsAt, 2, "standard", "스탠다드", 1080, 2, 10, 13500)
+}
+
+// expectStreamingContent 스트리밍 콘텐츠와 프로필 계정 조회 기대값 설정
+func expectStreamingContent(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery(`SELECT video_url, duration\s+FROM Contents`).
+		WithArgs(int64(3)).
+		WillReturnRows(sqlmock.NewRows([]string{"video_url", "duration"}).AddRow("https://cdn.example.com/3/master.m3u8", 7200))
+	mock.ExpectQuery(`SELECT user_id FROM Profiles WHERE id = \?`).
+		WithArgs(int64(10)).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id"}).AddRow(1))
+}
+
+// expectStreamCount 계정을 잠근 트랜잭션에서 만료된 재생 세션 정리 후 재생 중인 세션 수 조회 기대값 설정
+func expectStreamCount(mock sqlmock.Sqlmock, count int) {
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT id FROM Users WHERE id = \? FOR UPDATE`).
+		WithArgs(int64(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectExec(`DELETE FROM ActiveStreams WHERE user_id = \? AND last_seen_at <= \?`).
+		WithArgs(int64(1), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(`SELECT COUNT\(\*\) FROM ActiveStreams WHERE user_id = \? AND last_seen_at > \?`).
+		WithArgs(int64(1), sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(count))
+}
+
+// 요금제에 따른 재생 허용 여부 테스트
+func TestStreamingEntitlement(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	contentService := service.NewContentService(db)
+	now := time.Now()
+
+	// 구독한 적이 없으면 거부
+	expectStreamingContent(mock)
+	mock.ExpectQuery(`FROM\s+Subscriptions s`).WillReturnError(sql.ErrNoRows)
+	_, err = contentService.GetStreamingURL(3, 10)
+	assert.ErrorIs(t, err, service.ErrSubscriptionRequired)
+
+	// 만료된 구독이면 거부
+	expectStreamingContent(mock)
+	mock.ExpectQuery(`FROM\s+Subscriptions s`).WillReturnRows(subscriptionRows(now.AddDate(0, -2, 0), now.AddDate(0, -1, 0)))
+	_, err = contentService.GetStreamingURL(3, 10)
+	assert.ErrorIs(t, err, service.ErrSubscriptionExpired)
+
+	// 계정의 재생 세션이 동시 시청 수에 도달하면 거부 (같은 프로필의 다른 기기 재생도 포함)
+	expectStreamingContent(mock)
+	mock.ExpectQuery(`FROM\s+Subscriptions s`).WillReturnRows(subscriptionRows(now.AddDate(0, 0, -1), now.AddDate(0, 1, 0)))
+	expectStreamCount(mock, 2)
+	mock.ExpectRollback()
+	_, err = contentService.GetStreamingURL(3, 10)
+	assert.ErrorIs(t, err, service.ErrStreamLimitExceeded)
+
+	// 허용되면 새 재생 세션을 기록하고 요금제 화질로 제한한 주소 반환
+	expectStreamingContent(mock)
+	mock.ExpectQuery(`FROM\s+Subscriptions s`).WillReturnRows(subscriptionRows(now.AddDate(0, 0, -1), now.AddDate(0, 1, 0)))
+	expectStreamCount(mock, 1)
+	mock.ExpectExec(`INSERT INTO ActiveStreams \(id, profile_id, user_id, content_id, started_at, last_seen_at\)`).
+		WithArgs(sqlmock.AnyArg(), int64(10), int64(1), int64(3), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectQuery(`SELECT last_position\s+FROM ViewingHistories`).
+		WillReturnRows(sqlmock.NewRows([]string{"last_position"}).AddRow(120))
+	mock.ExpectExec(`INSERT INTO ViewingHistories`).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`INSERT INTO PopularityEvents`).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`INSERT INTO ContentPopularity`).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	response, err := contentService.GetStreamingURL(3, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://cdn.example.com/3/master.m3u8?max_resolution=1080", response.StreamingURL)
+	assert.Equal(t, "standard", response.Plan)
+	assert.Equal(t, 1080, response.MaxResolution)
+	assert.Equal(t, 120, response.LastPosition)
+	assert.NotEmpty(t, response.StreamID)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// 시작 예정인 구독을 포함해 가장 늦은 종료일부터 이어서 부여
+func TestGrantSubscriptionExtendsCurrent(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	// 이미 한 번 더 부여되어 이용 중인 구독 뒤에 시작 예정인 구독의 종료일
+	queuedEnd := time.Now().AddDate(0, 0, 40)
+
+	mock.ExpectQuery(`FROM Plans p WHERE p.code = \?`).
+		WithArgs("premium").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "code", "name", "max_resolution", "max_concurrent_streams", "download_limit", "monthly_price"}).
+			AddRow(3, "premium", "프리미엄", 2160, 4, 100, 17000))
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT id FROM Users WHERE id = \? FOR UPDATE`).
+		WithArgs(int64(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectQuery(`SELECT MAX\(ends_at\) FROM Subscriptions WHERE user_id = \?`).
+		WithArgs(int64(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"ends_at"}).AddRow(queuedEnd))
+	mock.ExpectExec(`INSERT INTO Subscriptions`).
+		WithArgs(int64(1), int64(3), queuedEnd, queuedEnd.AddDate(0, 0, 30), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(7, 1))
+	mock.ExpectCommit()
+
+	subscription, err := service.NewSubscriptionService(db).Grant(1, "premium", 30)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), subscription.ID)
+	assert.Equal(t, "premium", subscription.Plan.Code)
+	assert.True(t, subscription.StartsAt.Equal(queuedEnd))
+
+	// 남은 구독이 없으면 지금부터 시작
+	mock.ExpectQuery(`FROM Plans p WHERE p.code = \?`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "code", "name", "max_resolution", "max_concurrent_streams", "download_limit", "monthly_price"}).
+			AddRow(3, "premium", "프리미엄", 2160, 4, 100, 17000))
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT id FROM Users WHERE id = \? FOR UPDATE`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+	mock.ExpectQuery(`SELECT MAX\(ends_at\) FROM Subscriptions WHERE user_id = \?`).
+		WithArgs(int64(2)).
+		WillReturnRows(sqlmock.NewRows([]string{"ends_at"}).AddRow(nil))
+	mock.ExpectExec(`INSERT INTO Subscriptions`).WillReturnResult(sqlmock.NewResult(8, 1))
+	mock.ExpectCommit()
+	before := time.Now()
+	subscription, err = service.NewSubscriptionService(db).Grant(2, "premium", 30)
+	assert.NoError(t, err)
+	assert.False(t, subscription.StartsAt.Before(before))
+
+	// 없는 요금제
+	mock.ExpectQuery(`FROM Plans p WHERE p.code = \?`).WillReturnError(sql.ErrNoRows)
+	_, err = service.NewSubscriptionService(db).Grant(1, "unknown", 30)
+	assert.ErrorIs(t, err, service.ErrPlanNotFound)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,132 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"backend/helper"
+	"backend/model"
+)
+
+// 언어 태그 정규화 테스트
+func TestNormalizeLocale(t *testing.T) {
+	assert.Equal(t, "en-US", helper.NormalizeLocale("en_us"))
+	assert.Equal(t, "zh-Hant-TW", helper.NormalizeLocale("ZH-hant-tw"))
+	assert.Equal(t, "ko", helper.NormalizeLocale(" KO "))
+	assert.Equal(t, "", helper.NormalizeLocale("*"))
+	assert.Equal(t, "", helper.NormalizeLocale("en US"))
+}
+
+// Accept-Language 헤더 파싱 테스트 (선호도 순, q=0과 * 제외)
+func TestParseAcceptLanguage(t *testing.T) {
+	locales := helper.ParseAcceptLanguage("ja;q=0.5, en-US, fr;q=0, en;q=0.8, *;q=0.1")
+	assert.Equal(t, []string{"en-US", "en", "ja"}, locales)
+	assert.Empty(t, helper.ParseAcceptLanguage(""))
+}
+
+// 번역 조회 언어 순서 테스트 (상위 언어 추가, 중복 제거)
+func TestLocaleFallbackChain(t *testing.T) {
+	assert.Equal(t, []string{"en-GB", "en", "ko"}, helper.LocaleFallbackChain("", "en-GB", "ko", "en"))
+	assert.Equal(t, []string{"zh-Hant-TW", "zh-Hant", "zh"}, helper.LocaleFallbackChain("zh-Hant-TW"))
+	assert.Empty(t, helper.LocaleFallbackChain("", "invalid tag"))
+}
+
+// 콘텐츠 목록 번역 테스트 (선호 언어 순서상 가장 앞선 번역 사용, 없으면 원문)
+func TestContentListLocalized(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	locales := []string{"en-US", "en"}
+	mock.ExpectQuery(`SELECT COUNT\(\*\)`).
+		WithArgs(int64(0), "KR").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+	mock.ExpectQuery(`SELECT\s+c.id, c.title, c.thumbnail_url, c.release_year`).
+		WithArgs(int64(0), "KR", 10, 0).
+		WillReturnRows(contentListRows())
+	expectContentListDetails(mock, 0)
+	mock.ExpectQuery(`FROM\s+ContentTranslations\s+WHERE\s+content_id IN \(\?, \?, \?\) AND locale IN \(\?, \?\)`).
+		WithArgs(int64(1), int64(2), int64(3), "en-US", "en").
+		WillReturnRows(sqlmock.NewRows([]string{"content_id", "locale", "title", "description"}).
+			AddRow(1, "en", "Movie One", "").
+			AddRow(1, "en-US", "Movie One (US)", "").
+			AddRow(2, "en", "Movie Two", ""))
+	mock.ExpectQuery(`FROM\s+GenreTranslations gt\s+JOIN\s+Genres g ON g.id = gt.genre_id\s+WHERE\s+g.name IN \(\?, \?, \?, \?\) AND gt.locale IN \(\?, \?\)`).
+		WithArgs("액션", "스릴러", "코미디", "드라마", "en-US", "en").
+		WillReturnRows(sqlmock.NewRows([]string{"name", "locale", "translated_name", "description"}).
+			AddRow("액션", "en", "Action", "").
+			AddRow("드라마", "en", "Drama", ""))
+
+	pageInfo, err := model.GetContentList(db, model.Viewer{Country: "KR", Locales: locales}, 0, 10, "")
+	assert.NoError(t, err)
+	contents := pageInfo.Content.([]model.ContentListResponse)
+	if assert.Len(t, contents, 3) {
+		assert.Equal(t, "Movie One (US)", contents[0].Title)
+		assert.Equal(t, []string{"Action", "스릴러"}, contents[0].Genres)
+		assert.Equal(t, "Movie Two", contents[1].Title)
+		assert.Equal(t, "영화 3", contents[2].Title)
+		assert.Equal(t, []string{"Drama"}, contents[2].Genres)
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// 장르 목록 번역 테스트 (번역된 이름순 정렬, 번역 설명이 없으면 원문 설명)
+func TestGetGenresLocalized(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`SELECT id, name, IFNULL\(description, ''\) FROM Genres ORDER BY name`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "description"}).
+			AddRow(1, "액션", "스릴과 모험이 가득한 영화").
+			AddRow(2, "코미디", "웃음을 주는 영화"))
+	mock.ExpectQuery(`FROM\s+GenreTranslations gt`).
+		WithArgs("액션", "코미디", "en").
+		WillReturnRows(sqlmock.NewRows([]string{"name", "locale", "translated_name", "description"}).
+			AddRow("액션", "en", "Action", "Thrills and adventure").
+			AddRow("코미디", "en", "Comedy", ""))
+
+	genres, err := model.GetGenres(db, []string{"en"})
+	assert.NoError(t, err)
+	if assert.Len(t, genres, 2) {
+		assert.Equal(t, "Action", genres[0].Name)
+		assert.Equal(t, "Thrills and adventure", genres[0].Description)
+		assert.Equal(t, "Comedy", genres[1].Name)
+		assert.Equal(t, "웃음을 주는 영화", genres[1].Description)
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// 번역된 제목 검색 테스트
+func TestSearchContentsMatchesTranslatedTitles(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`SELECT COUNT\(\*\).*EXISTS \(SELECT 1 FROM ContentTranslations ct WHERE ct.content_id = c.id AND ct.title LIKE \?\)`).
+		WithArgs(int64(0), "KR", "%기생충%", "%기생충%").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery(`SELECT\s+c.id, c.title, c.thumbnail_url, c.release_year.*ct.title LIKE \?`).
+		WithArgs(int64(0), "KR", "%기생충%", "%기생충%", 10, 0).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "thumbnail_url", "release_year"}).
+			AddRow(10, "Parasite", "/thumbnails/10.jpg", 2019))
+	mock.ExpectQuery(`SELECT\s+cg.content_id, g.name`).
+		WithArgs(int64(10)).
+		WillReturnRows(sqlmock.NewRows([]string{"content_id", "name"}))
+	mock.ExpectQuery(`LEFT JOIN\s+ContentRatings r`).
+		WillReturnRows(contentRatingRows())
+	mock.ExpectQuery(`FROM\s+ContentTranslations`).
+		WithArgs(int64(10), "ko").
+		WillReturnRows(sqlmock.NewRows([]string{"content_id", "locale", "title", "description"}).
+			AddRow(10, "ko", "기생충", ""))
+
+	pageInfo, err := model.SearchContents(db, "기생충", model.Viewer{Country: "KR", Locales: []string{"ko"}}, 0, 10, "")
+	assert.NoError(t, err)
+	contents := pageInfo.Content.([]model.ContentListResponse)
+	if assert.Len(t, contents, 1) {
+		assert.Equal(t, "기생충", contents[0].Title)
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
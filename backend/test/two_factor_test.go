@@ -0,0 +1,140 @@
+package test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"backend/config"
+	"backend/helper"
+	"backend/service"
+)
+
+// RFC 6238 부록 B 테스트 키 ("12345678901234567890")
+const rfc6238Secret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+// TOTP 코드 계산 테스트 (RFC 6238 테스트 벡터의 하위 6자리)
+func TestTOTPCode(t *testing.T) {
+	for unix, expected := range map[int64]string{
+		59:         "287082",
+		1111111109: "081804",
+		1234567890: "005924",
+		2000000000: "279037",
+	} {
+		code, err := helper.TOTPCode(rfc6238Secret, helper.TOTPStep(time.Unix(unix, 0)))
+		assert.NoError(t, err)
+		assert.Equal(t, expected, code, "T=%d", unix)
+	}
+}
+
+// TOTP 코드 허용 범위 테스트
+func TestValidateTOTP(t *testing.T) {
+	now := time.Unix(1111111109, 0)
+	previous, _ := helper.TOTPCode(rfc6238Secret, helper.TOTPStep(now)-1)
+	stale, _ := helper.TOTPCode(rfc6238Secret, helper.TOTPStep(now)-3)
+
+	step, ok := helper.ValidateTOTP(rfc6238Secret, previous, now)
+	assert.True(t, ok)
+	assert.Equal(t, helper.TOTPStep(now)-1, step)
+
+	_, ok = helper.ValidateTOTP(rfc6238Secret, stale, now)
+	assert.False(t, ok)
+	_, ok = helper.ValidateTOTP(rfc6238Secret, "12345", now)
+	assert.False(t, ok)
+
+	uri := helper.TOTPURI("MiniFlix", "user@example.com", rfc6238Secret)
+	assert.True(t, strings.HasPrefix(uri, "otpauth://totp/MiniFlix:user@example.com?"))
+	assert.Contains(t, uri, "secret="+rfc6238Secret)
+	assert.Contains(t, uri, "issuer=MiniFlix")
+}
+
+// 2단계 인증 대기 토큰은 액세스 토큰으로 사용할 수 없는지 테스트
+func TestTwoFactorChallengeToken(t *testing.T) {
+	cfg := &config.Config{JWTSecret: "test-secret"}
+
+	challenge, err := helper.GenerateTwoFactorChallenge(7, cfg)
+	assert.NoError(t, err)
+
+	userID, challengeID, err := helper.ValidateTwoFactorChallenge(challenge, cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), userID)
+	assert.NotEmpty(t, challengeID)
+
+	_, err = helper.ValidateToken(challenge, cfg)
+	assert.Error(t, err)
+}
+
+// twoFactorStateRows 2단계 인증 상태 조회 결과
+func twoFactorStateRows(enabled bool, lastStep int64) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"totp_secret", "totp_enabled", "totp_last_step"}).
+		AddRow(rfc6238Secret, enabled, lastStep)
+}
+
+// 인증 코드 재사용 방지 및 복구 코드 사용 테스트
+func TestVerifyTwoFactorCode(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	twoFactorService := service.NewTwoFactorService(db)
+	step := helper.TOTPStep(time.Now())
+	code, err := helper.TOTPCode(rfc6238Secret, step)
+	assert.NoError(t, err)
+
+	// 처음 사용하는 코드
+	mock.ExpectQuery(`SELECT totp_secret, totp_enabled, totp_last_step FROM Users`).
+		WillReturnRows(twoFactorStateRows(true, step-5))
+	mock.ExpectExec(`UPDATE Users SET totp_last_step = \? WHERE id = \? AND totp_last_step < \?`).
+		WithArgs(step, int64(1), step).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	assert.NoError(t, twoFactorService.VerifyCode(1, code, ""))
+
+	// 이미 사용된 코드
+	mock.ExpectQuery(`SELECT totp_secret, totp_enabled, totp_last_step FROM Users`).
+		WillReturnRows(twoFactorStateRows(true, step))
+	assert.ErrorIs(t, twoFactorService.VerifyCode(1, code, ""), service.ErrInvalidTwoFactorCode)
+
+	// 복구 코드는 대소문자/하이픈과 관계없이 확인
+	mock.ExpectQuery(`SELECT totp_secret, totp_enabled, totp_last_step FROM Users`).
+		WillReturnRows(twoFactorStateRows(true, step))
+	mock.ExpectExec(`UPDATE RecoveryCodes SET used_at = \? WHERE user_id = \? AND code_hash = \? AND used_at IS NULL`).
+		WithArgs(sqlmock.AnyArg(), int64(1), helper.HashToken("abcdefghij")).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	assert.NoError(t, twoFactorService.VerifyCode(1, "", "ABCDE-FGHIJ"))
+
+	// 2단계 인증이 꺼진 계정
+	mock.ExpectQuery(`SELECT totp_secret, totp_enabled, totp_last_step FROM Users`).
+		WillReturnRows(twoFactorStateRows(false, 0))
+	assert.ErrorIs(t, twoFactorService.VerifyCode(1, code, ""), service.ErrTwoFactorNotEnabled)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// 2단계 인증 활성화 시 복구 코드 발급 테스트
+func TestConfirmTwoFactor(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	code, err := helper.TOTPCode(rfc6238Secret, helper.TOTPStep(time.Now()))
+	assert.NoError(t, err)
+
+	mock.ExpectQuery(`SELECT totp_secret, totp_enabled, totp_last_step FROM Users`).
+		WillReturnRows(twoFactorStateRows(false, 0))
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE Users SET totp_enabled = true`).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`DELETE FROM RecoveryCodes WHERE user_id = \?`).WillReturnResult(sqlmock.NewResult(0, 0))
+	for i := 0; i < 10; i++ {
+		mock.ExpectExec(`INSERT INTO RecoveryCodes`).WillReturnResult(sqlmock.NewResult(int64(i+1), 1))
+	}
+	mock.ExpectCommit()
+
+	codes, err := service.NewTwoFactorService(db).Confirm(1, code)
+	assert.NoError(t, err)
+	assert.Len(t, codes, 10)
+	assert.Regexp(t, `^[a-z2-7]{5}-[a-z2-7]{5}$`, codes[0])
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
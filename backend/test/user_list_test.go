@@ -0,0 +1,206 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"backend/config"
+	"backend/model"
+	"backend/service"
+)
+
+// userListRows 목록 조회 결과 컬럼
+func userListRows() *sqlmock.Rows {
+	return sqlmock.NewRows([]string{
+		"id", "profile_id", "name", "is_default", "position", "share_token", "created_at", "updated_at", "item_count",
+	})
+}
+
+// 목록 생성 테스트 (예약된 이름, 중복 이름, 최대 목록 수)
+func TestCreateUserList(t *testing.T) {
+	cfg := &config.Config{MaxListsPerProfile: 2}
+
+	t.Run("기본 목록 이름은 예약", func(t *testing.T) {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+		assert.NoError(t, err)
+		defer db.Close()
+
+		_, err = service.NewUserListService(db, cfg).CreateList(7, &model.UserListRequest{Name: " " + model.DefaultUserListName + " "})
+		assert.ErrorIs(t, err, model.ErrUserListNameTaken)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("같은 이름의 목록", func(t *testing.T) {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(`SELECT EXISTS\(SELECT 1 FROM UserLists WHERE profile_id = \? AND name = \?`).
+			WithArgs(int64(7), "주말에 볼 영화", int64(0)).
+			WillReturnRows(sqlmock.NewRows([]string{"taken"}).AddRow(true))
+
+		_, err = service.NewUserListService(db, cfg).CreateList(7, &model.UserListRequest{Name: "주말에 볼 영화"})
+		assert.ErrorIs(t, err, model.ErrUserListNameTaken)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("최대 목록 수 초과", func(t *testing.T) {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(`SELECT EXISTS`).
+			WillReturnRows(sqlmock.NewRows([]string{"taken"}).AddRow(false))
+		mock.ExpectQuery(`SELECT COUNT\(\*\), IFNULL\(MAX\(position\) \+ 1, 0\) FROM UserLists`).
+			WithArgs(int64(7)).
+			WillReturnRows(sqlmock.NewRows([]string{"count", "next_position"}).AddRow(2, 2))
+
+		_, err = service.NewUserListService(db, cfg).CreateList(7, &model.UserListRequest{Name: "주말에 볼 영화"})
+		assert.ErrorIs(t, err, model.ErrUserListLimit)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("목록 끝에 생성", func(t *testing.T) {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+		assert.NoError(t, err)
+		defer db.Close()
+
+		now := time.Now()
+		mock.ExpectQuery(`SELECT EXISTS`).
+			WillReturnRows(sqlmock.NewRows([]string{"taken"}).AddRow(false))
+		mock.ExpectQuery(`SELECT COUNT\(\*\), IFNULL\(MAX\(position\) \+ 1, 0\) FROM UserLists`).
+			WillReturnRows(sqlmock.NewRows([]string{"count", "next_position"}).AddRow(1, 1))
+		mock.ExpectExec(`INSERT INTO UserLists`).
+			WithArgs(int64(7), "주말에 볼 영화", 1, sqlmock.AnyArg(), sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(12, 1))
+		mock.ExpectQuery(`FROM\s+UserLists l\s+WHERE l.id = \? AND l.profile_id = \?`).
+			WithArgs(int64(12), int64(7)).
+			WillReturnRows(userListRows().AddRow(12, 7, "주말에 볼 영화", false, 1, "", now, now, 0))
+
+		list, err := service.NewUserListService(db, cfg).CreateList(7, &model.UserListRequest{Name: "주말에 볼 영화"})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(12), list.ID)
+		assert.Equal(t, 1, list.Position)
+		assert.Empty(t, list.SharePath)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+// 기본 목록(찜 목록)에서 다른 목록으로 콘텐츠 이동 테스트
+func TestMoveUserListItemFromDefault(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	now := time.Now()
+	mock.ExpectExec(`INSERT IGNORE INTO UserLists`).
+		WithArgs(int64(7), model.DefaultUserListName, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(`SELECT id FROM UserLists WHERE profile_id = \? AND is_default = TRUE`).
+		WithArgs(int64(7)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+	mock.ExpectQuery(`FROM\s+UserLists l\s+WHERE l.id = \? AND l.profile_id = \?`).
+		WithArgs(int64(3), int64(7)).
+		WillReturnRows(userListRows().AddRow(3, 7, model.DefaultUserListName, true, 0, "", now, now, 4))
+	mock.ExpectQuery(`FROM\s+UserLists l\s+WHERE l.id = \? AND l.profile_id = \?`).
+		WithArgs(int64(12), int64(7)).
+		WillReturnRows(userListRows().AddRow(12, 7, "주말에 볼 영화", false, 1, "", now, now, 2))
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`DELETE FROM Wishlists WHERE profile_id = \? AND content_id = \?`).
+		WithArgs(int64(7), int64(10)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`INSERT IGNORE INTO UserListItems[\s\S]*SELECT \?, \?, IFNULL\(MAX\(position\) \+ 1, 0\), \? FROM UserListItems WHERE list_id = \?`).
+		WithArgs(int64(12), int64(10), sqlmock.AnyArg(), int64(12)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE UserLists SET updated_at = \? WHERE id = \?`).
+		WithArgs(sqlmock.AnyArg(), int64(3)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE UserLists SET updated_at = \? WHERE id = \?`).
+		WithArgs(sqlmock.AnyArg(), int64(12)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	listService := service.NewUserListService(db, &config.Config{})
+	err = listService.MoveItem(7, 0, 10, &model.UserListMoveRequest{TargetListID: 12})
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// 원래 목록에 없는 콘텐츠 이동 시 롤백 테스트
+func TestMoveUserListItemNotInList(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	now := time.Now()
+	mock.ExpectQuery(`FROM\s+UserLists l\s+WHERE l.id = \? AND l.profile_id = \?`).
+		WithArgs(int64(12), int64(7)).
+		WillReturnRows(userListRows().AddRow(12, 7, "주말에 볼 영화", false, 1, "", now, now, 2))
+	mock.ExpectQuery(`FROM\s+UserLists l\s+WHERE l.id = \? AND l.profile_id = \?`).
+		WithArgs(int64(13), int64(7)).
+		WillReturnRows(userListRows().AddRow(13, 7, "다시 볼 영화", false, 2, "", now, now, 0))
+	mock.ExpectBegin()
+	mock.ExpectExec(`DELETE FROM UserListItems WHERE list_id = \? AND content_id = \?`).
+		WithArgs(int64(12), int64(10)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	listService := service.NewUserListService(db, &config.Config{})
+	err = listService.MoveItem(7, 12, 10, &model.UserListMoveRequest{TargetListID: 13})
+	assert.ErrorIs(t, err, service.ErrUserListItemNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// 기본 목록은 찜한 순서로 고정되어 순서 변경이 거부되는지 테스트
+func TestReorderDefaultUserListItems(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	err = model.ReorderUserListItems(db, &model.UserList{ID: 3, ProfileID: 7, IsDefault: true}, []int64{2, 1})
+	assert.ErrorIs(t, err, model.ErrDefaultUserListOrder)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// 다른 사람이 공유 링크로 기본 목록을 조회하는 경우 테스트 (목록 주인의 찜 목록, 시청자 기준 찜 여부)
+func TestGetSharedDefaultUserList(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	now := time.Now()
+	mock.ExpectQuery(`FROM\s+UserLists l\s+WHERE l.share_token = \?`).
+		WithArgs("share-token").
+		WillReturnRows(userListRows().AddRow(3, 7, model.DefaultUserListName, true, 0, "share-token", now, now, 2))
+	mock.ExpectQuery(`SELECT name FROM Profiles WHERE id = \?`).
+		WithArgs(int64(7)).
+		WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("민수"))
+	mock.ExpectQuery(`FROM\s+Wishlists w\s+JOIN\s+Contents c ON c.id = w.content_id[\s\S]*ORDER BY\s+w.created_at DESC`).
+		WithArgs(int64(7), int64(9), "KR").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "thumbnail_url", "release_year"}).
+			AddRow(10, "Parasite", "/thumbnails/10.jpg", 2019))
+	mock.ExpectQuery(`SELECT\s+cg.content_id, g.name`).
+		WillReturnRows(sqlmock.NewRows([]string{"content_id", "name"}).AddRow(10, "드라마"))
+	mock.ExpectQuery(`SELECT\s+content_id\s+FROM\s+Wishlists`).
+		WithArgs(int64(9), int64(10)).
+		WillReturnRows(sqlmock.NewRows([]string{"content_id"}))
+	mock.ExpectQuery(`LEFT JOIN\s+ContentRatings r`).
+		WithArgs(int64(9), int64(10)).
+		WillReturnRows(contentRatingRows().AddRow(10, 5, 0, 4, 18, nil, nil, nil))
+
+	listService := service.NewUserListService(db, &config.Config{})
+	shared, err := listService.GetShared(model.Viewer{ProfileID: 9, Country: "KR"}, "share-token")
+	assert.NoError(t, err)
+	assert.Equal(t, model.DefaultUserListName, shared.Name)
+	assert.Equal(t, "민수", shared.OwnerName)
+	assert.Len(t, shared.Items, 1)
+
+	// 찜 여부는 목록 주인이 아닌 시청자 기준
+	assert.False(t, shared.Items[0].IsWishlisted)
+	assert.Equal(t, []string{"드라마"}, shared.Items[0].Genres)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,142 @@
+package test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"backend/model"
+	"backend/service"
+)
+
+// ratingStatsRows 콘텐츠 평가 집계 컬럼 조회 결과
+func ratingStatsRows(up, down, starCount, starSum int) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"thumbs_up_count", "thumbs_down_count", "star_rating_count", "star_rating_sum"}).
+		AddRow(up, down, starCount, starSum)
+}
+
+// 평가 요청 검증 테스트
+func TestUserRatingRequestValidate(t *testing.T) {
+	assert.NoError(t, (&model.UserRatingRequest{Thumb: model.ThumbUp}).Validate())
+	assert.NoError(t, (&model.UserRatingRequest{Stars: 3}).Validate())
+	assert.NoError(t, (&model.UserRatingRequest{Thumb: model.ThumbDown, Stars: 1}).Validate())
+	assert.ErrorIs(t, (&model.UserRatingRequest{}).Validate(), model.ErrEmptyUserRating)
+}
+
+// 평가 저장 시 기존 평가와의 차이만큼 집계가 갱신되는지 테스트
+func TestSetUserRating(t *testing.T) {
+	t.Run("새 평가", func(t *testing.T) {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT thumb, stars, updated_at\s+FROM ContentRatings\s+WHERE profile_id = \? AND content_id = \?\s+FOR UPDATE`).
+			WithArgs(int64(7), int64(3)).
+			WillReturnError(sql.ErrNoRows)
+		mock.ExpectExec(`INSERT INTO ContentRatings .* ON DUPLICATE KEY UPDATE`).
+			WithArgs(int64(7), int64(3), sql.NullInt64{Int64: 1, Valid: true}, sql.NullInt64{Int64: 5, Valid: true}, sqlmock.AnyArg(), sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`UPDATE Contents\s+SET thumbs_up_count = thumbs_up_count \+ \?`).
+			WithArgs(1, 0, 1, 5, int64(3)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(`SELECT thumbs_up_count, thumbs_down_count, star_rating_count, star_rating_sum\s+FROM Contents`).
+			WithArgs(int64(3)).
+			WillReturnRows(ratingStatsRows(4, 1, 3, 14))
+		mock.ExpectCommit()
+
+		response, err := model.SetUserRating(db, 7, 3, &model.UserRatingRequest{Thumb: model.ThumbUp, Stars: 5})
+		assert.NoError(t, err)
+		assert.Equal(t, model.ThumbUp, response.MyRating.Thumb)
+		assert.Equal(t, 5, response.MyRating.Stars)
+		assert.Equal(t, 4.7, response.Rating.AverageStars)
+		assert.Equal(t, 4, response.Rating.ThumbsUp)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("기존 평가 교체", func(t *testing.T) {
+		db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+		assert.NoError(t, err)
+		defer db.Close()
+
+		// 좋아요 + 별점 4 → 싫어요만
+		mock.ExpectBegin()
+		mock.ExpectQuery(`FROM ContentRatings\s+WHERE profile_id = \? AND content_id = \?\s+FOR UPDATE`).
+			WillReturnRows(sqlmock.NewRows([]string{"thumb", "stars", "updated_at"}).AddRow(1, 4, time.Now()))
+		mock.ExpectExec(`INSERT INTO ContentRatings`).
+			WithArgs(int64(7), int64(3), sql.NullInt64{Int64: -1, Valid: true}, sql.NullInt64{}, sqlmock.AnyArg(), sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec(`UPDATE Contents\s+SET thumbs_up_count`).
+			WithArgs(-1, 1, -1, -4, int64(3)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(`SELECT thumbs_up_count, thumbs_down_count, star_rating_count, star_rating_sum`).
+			WillReturnRows(ratingStatsRows(3, 2, 0, 0))
+		mock.ExpectCommit()
+
+		response, err := model.SetUserRating(db, 7, 3, &model.UserRatingRequest{Thumb: model.ThumbDown})
+		assert.NoError(t, err)
+		assert.Equal(t, 0, response.MyRating.Stars)
+		assert.Equal(t, 0.0, response.Rating.AverageStars)
+		assert.Equal(t, 2, response.Rating.ThumbsDown)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+// 평가 삭제 테스트
+func TestDeleteUserRating(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`FROM ContentRatings\s+WHERE profile_id = \? AND content_id = \?\s+FOR UPDATE`).
+		WillReturnRows(sqlmock.NewRows([]string{"thumb", "stars", "updated_at"}).AddRow(nil, 2, time.Now()))
+	mock.ExpectExec(`DELETE FROM ContentRatings WHERE profile_id = \? AND content_id = \?`).
+		WithArgs(int64(7), int64(3)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE Contents\s+SET thumbs_up_count`).
+		WithArgs(0, 0, -1, -2, int64(3)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`SELECT thumbs_up_count, thumbs_down_count, star_rating_count, star_rating_sum`).
+		WillReturnRows(ratingStatsRows(0, 0, 2, 7))
+	mock.ExpectCommit()
+
+	response, err := service.NewRatingService(db).DeleteRating(7, 3)
+	assert.NoError(t, err)
+	assert.Nil(t, response.MyRating)
+	assert.Equal(t, 3.5, response.Rating.AverageStars)
+
+	// 평가 기록이 없는 경우
+	mock.ExpectBegin()
+	mock.ExpectQuery(`FROM ContentRatings\s+WHERE profile_id = \? AND content_id = \?\s+FOR UPDATE`).
+		WillReturnError(sql.ErrNoRows)
+	mock.ExpectRollback()
+
+	_, err = service.NewRatingService(db).DeleteRating(7, 4)
+	assert.ErrorIs(t, err, service.ErrRatingNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// 공개 전 콘텐츠 평가 거부 테스트
+func TestSetRatingRequiresPublishedContent(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	ratingService := service.NewRatingService(db)
+
+	mock.ExpectQuery(`FROM Contents c\s+WHERE c.id = \?`).WithArgs(int64(3)).
+		WillReturnRows(sqlmock.NewRows([]string{"published"}).AddRow(false))
+	_, err = ratingService.SetRating(7, 3, &model.UserRatingRequest{Stars: 4})
+	assert.ErrorIs(t, err, service.ErrContentNotRateable)
+
+	mock.ExpectQuery(`FROM Contents c\s+WHERE c.id = \?`).WithArgs(int64(999)).
+		WillReturnError(sql.ErrNoRows)
+	_, err = ratingService.SetRating(7, 999, &model.UserRatingRequest{Stars: 4})
+	assert.ErrorIs(t, err, service.ErrContentNotFound)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
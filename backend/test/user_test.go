@@ -48,7 +48,7 @@ func TestGetUserProfile(t *testing.T) {
 	mockService.On("GetUserByID", int64(1)).Return(testUser, nil)
 
 	// JWT 토큰 생성
-	token, _ := helper.GenerateToken(testUser.ID, testUser.Name, testUser.Email, cfg)
+	token, _ := helper.GenerateToken(testUser.ID, testUser.ID, testUser.Name, testUser.Email, cfg)
 
 	// 테스트 라우트 설정
 	group := r.Group("/api/users")
@@ -131,7 +131,7 @@ func TestUpdateUserProfile(t *testing.T) {
 	mockService.On("GetUserByID", int64(1)).Return(updatedUser, nil)
 
 	// JWT 토큰 생성
-	token, _ := helper.GenerateToken(testUser.ID, testUser.Name, testUser.Email, cfg)
+	token, _ := helper.GenerateToken(testUser.ID, testUser.ID, testUser.Name, testUser.Email, cfg)
 
 	// 테스트 라우트 설정
 	group := r.Group("/api/users")
@@ -216,7 +216,7 @@ func TestUpdateUserProfileInvalidPassword(t *testing.T) {
 	)
 
 	// JWT 토큰 생성
-	token, _ := helper.GenerateToken(testUser.ID, testUser.Name, testUser.Email, cfg)
+	token, _ := helper.GenerateToken(testUser.ID, testUser.ID, testUser.Name, testUser.Email, cfg)
 
 	// 테스트 라우트 설정
 	group := r.Group("/api/users")